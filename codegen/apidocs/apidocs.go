@@ -11,12 +11,13 @@ import (
 
 // Generate generates API docs using api-console
 // https://github.com/mulesoft/api-console
+// ramlBytes is the bundled RAML document, the console can't load
+// the included files and the libraries.
 func Generate(ramlBytes []byte, dir string) error {
 	// extract zipped files
 	if err := extract(dir); err != nil {
 		return err
 	}
-	ramlBytes = append([]byte("#%RAML 1.0\n"), ramlBytes...)
 	// write the .raml file
 	return ioutil.WriteFile(filepath.Join(dir, "api.raml"), ramlBytes, 0777)
 }
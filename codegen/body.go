@@ -1,14 +1,31 @@
 package codegen
 
 import (
+	"strings"
+
 	"github.com/Jumpscale/go-raml/raml"
 )
 
 const (
 	reqBodySuffix  = "ReqBody"
 	respBodySuffix = "RespBody"
+
+	// encodings of the bodies
+	encodingJSON = "json"
+	encodingXML  = "xml"
+	encodingForm = "form"
 )
 
+// the media types of the body whose type is generated, by order of preference.
+// The vendor JSON types, e.g. `application/vnd.api+json`,
+// are preferred to the XML and form bodies.
+var bodyMediaTypes = []string{
+	"application/json",
+	"application/xml",
+	"application/x-www-form-urlencoded",
+	"multipart/form-data",
+}
+
 // generate all body struct from an RAML definition
 func generateBodyStructs(apiDef *raml.APIDefinition, dir, packageName, lang string) error {
 	// generate
@@ -71,10 +88,11 @@ func buildBodyFromMethod(structName, methodName, dir, packageName, lang string,
 			return err
 		}
 	case langPython:
-		if !hasJSONBody(&method.Bodies) {
+		mediaType, body, ok := typedBody(method.Bodies)
+		if !ok || len(body.Properties) == 0 || bodyEncoding(mediaType) == encodingXML {
 			return nil
 		}
-		pc := newPythonClass(structName+methodName+reqBodySuffix, "", method.Bodies.ApplicationJSON.Properties)
+		pc := newPythonClass(structName+methodName+reqBodySuffix, "", body.Properties)
 		return pc.generate(dir)
 	}
 
@@ -89,19 +107,55 @@ func buildBodyFromMethod(structName, methodName, dir, packageName, lang string,
 	return nil
 }
 
-// check if this raml.Bodies has JSON body that need to be generated
-func hasJSONBody(body *raml.Bodies) bool {
-	return body.ApplicationJSON != nil && len(body.ApplicationJSON.Properties) > 0
+// typedBody returns the body whose type is generated and its media type.
+// The body declared without media type is a JSON body.
+func typedBody(bodies raml.Bodies) (string, raml.Body, bool) {
+	if len(bodies.ForMIMEType) == 0 {
+		body := raml.Body{
+			Type:       bodies.Type,
+			Properties: bodies.Properties,
+		}
+		return bodyMediaTypes[0], body, body.HasType()
+	}
+
+	candidates := []string{bodyMediaTypes[0]}
+	for _, mt := range bodies.MediaTypes() {
+		if mt != bodyMediaTypes[0] && raml.IsJSONMediaType(mt) {
+			candidates = append(candidates, mt)
+		}
+	}
+	candidates = append(candidates, bodyMediaTypes[1:]...)
+
+	for _, mt := range candidates {
+		if body, ok := bodies.ForMIMEType[mt]; ok && body.HasType() {
+			return mt, body, true
+		}
+	}
+	return "", raml.Body{}, false
+}
+
+// bodyEncoding returns the encoding of a body of a media type
+func bodyEncoding(mediaType string) string {
+	switch {
+	case raml.IsXMLMediaType(mediaType):
+		return encodingXML
+	case strings.HasPrefix(mediaType, "application/x-www-form-urlencoded"),
+		strings.HasPrefix(mediaType, "multipart/form-data"):
+		return encodingForm
+	default:
+		return encodingJSON
+	}
 }
 
 // generate a struct from an RAML request/response body
 func generateStructFromBody(structNamePrefix, dir, packageName string, body *raml.Bodies, isGenerateRequest bool) error {
-	if !hasJSONBody(body) {
+	mediaType, typed, ok := typedBody(*body)
+	if !ok || len(typed.Properties) == 0 {
 		return nil
 	}
 
 	// construct struct from body
-	structDef := newStructDefFromBody(body, structNamePrefix, packageName, isGenerateRequest)
+	structDef := newStructDefFromBody(typed, mediaType, structNamePrefix, packageName, isGenerateRequest)
 
 	// generate
 	return structDef.generate(dir)
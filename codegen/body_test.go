@@ -3,7 +3,9 @@ package codegen
 import (
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/Jumpscale/go-raml/raml"
@@ -62,3 +64,76 @@ func TestGenerateStructBodyFromRaml(t *testing.T) {
 		})
 	})
 }
+
+func TestBodyMediaTypes(t *testing.T) {
+	Convey("bodies of the media types", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		rootFixture := "./fixtures/bodies"
+		check := func(checks []struct{ Result, Expected string }) {
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		}
+
+		Convey("Go server", func() {
+			err := GenerateServer("./fixtures/bodies/api.raml", targetDir, "main", "go", "", "examples.com/ramlcode", true)
+			So(err, ShouldBeNil)
+
+			check([]struct{ Result, Expected string }{
+				{"UsersPostReqBody.go", "go_server/UsersPostReqBody.txt"},
+				{"UsersUserIdAvatarPutReqBody.go", "go_server/UsersUserIdAvatarPutReqBody.txt"},
+				{"LoginPostReqBody.go", "go_server/LoginPostReqBody.txt"},
+				{"ReportsGetRespBody.go", "go_server/ReportsGetRespBody.txt"},
+				{"FeedsGetRespBody.go", "go_server/FeedsGetRespBody.txt"},
+				{"users_api.go", "go_server/users_api.txt"},
+				{"login_api.go", "go_server/login_api.txt"},
+				{"feeds_api.go", "go_server/feeds_api.txt"},
+			})
+		})
+
+		Convey("Go server decodes the files of a multipart form", func() {
+			// the server is generated in this package to use its vendored packages
+			serverDir, err := ioutil.TempDir(".", "multipart")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(serverDir)
+
+			rootImportPath := "github.com/Jumpscale/go-raml/codegen/" + filepath.Base(serverDir)
+			err = GenerateServer("./fixtures/bodies/api.raml", serverDir, "main", "go", "", rootImportPath, true)
+			So(err, ShouldBeNil)
+
+			// the handler test posts the files of an avatar
+			b, err := ioutil.ReadFile(filepath.Join(rootFixture, "go_server/users_api_test.txt"))
+			So(err, ShouldBeNil)
+			test := strings.Replace(string(b), "{{.RootImportPath}}", rootImportPath, -1)
+			err = ioutil.WriteFile(filepath.Join(serverDir, "users_api_test.go"), []byte(test), 0644)
+			So(err, ShouldBeNil)
+
+			cmd := exec.Command("go", "test")
+			cmd.Dir = serverDir
+			out, _ := cmd.CombinedOutput()
+			So(string(out), ShouldStartWith, "PASS")
+		})
+
+		Convey("python server", func() {
+			err := GenerateServer("./fixtures/bodies/api.raml", targetDir, "main", "python", "", "", true)
+			So(err, ShouldBeNil)
+
+			check([]struct{ Result, Expected string }{
+				{"LoginPostReqBody.py", "python_server/LoginPostReqBody.py"},
+				{"login.py", "python_server/login.py"},
+			})
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -10,23 +10,41 @@ import (
 	log "github.com/Sirupsen/logrus"
 )
 
+// raml scalar types which have a wtforms field type
+var pythonScalarTypes = map[string]bool{
+	"string":    true,
+	"file":      true,
+	"number":    true,
+	"integer":   true,
+	"boolean":   true,
+	"date":      true,
+	"date-only": true,
+}
+
 // pythons class's field
 type pythonField struct {
 	Name        string
 	Type        string
 	Required    bool
 	Validators  string
-	ramlType    string // the original raml type
+	Annotations raml.Annotations // annotations applied to the property
+	ramlType    string           // the original raml type
 	isFormField bool
 	isList      bool                // it is a list field
+	isNullable  bool                // None is a valid value of the field
+	isAny       bool                // the field accepts any value, it isn't validated
+	isPolymorph bool                // the form of the field is chosen by the discriminator value
 	validators  map[string][]string // array of validators, only used to build `Validators` field
+	facets      []string            // user-defined facets validated by this field
 }
 
 type pythonClass struct {
-	T           raml.Type
-	Name        string
-	Description []string
-	Fields      map[string]pythonField
+	T             raml.Type
+	Name          string
+	Description   []string
+	Fields        map[string]pythonField
+	Bases         []string          // raml types of the parent classes
+	Discriminator *discriminatorDef // polymorphism of the class, if any
 }
 
 // create a python class representations
@@ -41,8 +59,9 @@ func newPythonClass(name, description string, properties map[string]interface{})
 	for k, v := range properties {
 		p := raml.ToProperty(k, v)
 		field := pythonField{
-			Name:     p.Name,
-			Required: p.Required,
+			Name:        p.Name,
+			Required:    p.Required,
+			Annotations: p.Annotations,
 		}
 		field.setType(p.Type)
 
@@ -57,12 +76,123 @@ func newPythonClass(name, description string, properties map[string]interface{})
 	return pc
 }
 
-func newPythonClassFromType(T raml.Type, name string) pythonClass {
+func newPythonClassFromType(rt *raml.ResolvedType, name string) pythonClass {
+	T := *rt.Decl
 	pc := newPythonClass(name, T.Description, T.Properties)
 	pc.T = T
+
+	// inherited properties come from the parent classes
+	if rt.Kind == raml.KindObject {
+		for _, parent := range rt.Parents {
+			if parent.Kind == raml.KindObject && !parent.IsBuiltin() && !parent.IsAnonymous() {
+				pc.Bases = append(pc.Bases, libTypeName(parent.Name))
+			}
+		}
+	}
+
+	for propName, prop := range rt.Properties {
+		field, ok := pc.Fields[propName]
+		if !ok {
+			continue
+		}
+
+		// a form can't contain itself, e.g. a type translated from a recursive JSON schema
+		if field.isFormField && field.ramlType == name {
+			log.Infof("validator has no support for recursive field %v, ignore it", propName)
+			delete(pc.Fields, propName)
+			continue
+		}
+
+		// form field of a type which declares a discriminator
+		itemType := prop.Type
+		if field.isList && itemType.Kind == raml.KindArray {
+			itemType = itemType.Items
+		}
+		if field.isFormField && isDiscriminatorRoot(itemType) {
+			field.isPolymorph = true
+			pc.Fields[propName] = field
+		}
+
+		// field of user defined scalar type is a field of its built-in type
+		if prop.Type.Kind == raml.KindScalar && !prop.Type.IsBuiltin() && !field.isList &&
+			pythonScalarTypes[prop.Type.Builtin] {
+			field.Type, field.isFormField = "", false
+			field.setType(prop.Type.Builtin)
+			pc.Fields[propName] = field
+		}
+
+		// field of a declared nullable type is a nullable field of its non nil type
+		if nonNil, ok := prop.Type.NonNil(); ok && !field.isNullable && !field.isList {
+			field.setNullable(nonNil)
+			if field.Type == "" {
+				delete(pc.Fields, propName)
+				continue
+			}
+			// the facets of the declared type, validated when the value isn't None
+			field.Validators = ""
+			field.buildValidators(facetsProperty(prop.Type.Facets))
+			pc.Fields[propName] = field
+		}
+
+		// user-defined facets values of the properties
+		if len(prop.Type.FacetValues) == 0 {
+			continue
+		}
+		if field.isList || field.isFormField {
+			log.Infof("validator has no support for facets of field %v, ignore it", propName)
+			continue
+		}
+		field.addFacetValidators(prop.Type.FacetValues)
+		field.Validators = ""
+		field.buildValidatorsString()
+		pc.Fields[propName] = field
+	}
 	return pc
 }
 
+// ValidatorImports returns the wtforms validators used by this class
+// which are not imported by all classes
+func (pc pythonClass) ValidatorImports() []string {
+	var validators []string
+	for _, name := range []string{"AnyOf", "Optional"} {
+		for _, v := range pc.Fields {
+			if !v.isAny && strings.Contains(v.Validators, name+"(") {
+				validators = append(validators, name)
+				break
+			}
+		}
+	}
+	return validators
+}
+
+// FieldImports returns the wtforms fields used by this class
+// which are not imported by all classes
+func (pc pythonClass) FieldImports() []string {
+	for _, v := range pc.Fields {
+		if v.isAny {
+			return []string{"Field"}
+		}
+	}
+	return nil
+}
+
+// FacetImports returns the validators of user-defined facets
+// used by this class
+func (pc pythonClass) FacetImports() []string {
+	facets := map[string]bool{}
+	for _, field := range pc.Fields {
+		for _, name := range field.facets {
+			facets[name] = true
+		}
+	}
+	var names []string
+	for name := range facets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 func (pc *pythonClass) generate(dir string) error {
 	fileName := filepath.Join(dir, pc.Name+".py")
 	return generateFile(pc, "./templates/class_python.tmpl", "class_python", fileName, false)
@@ -97,8 +227,8 @@ func (pf *pythonField) buildValidators(p raml.Property) {
 		pf.addValidator("multiple_of", "mult", *p.MultipleOf)
 	}
 
-	// required
-	if p.Required {
+	// required, a nullable field accepts None
+	if p.Required && !pf.isNullable {
 		pf.addValidator("DataRequired", "message", `""`)
 	}
 
@@ -127,31 +257,113 @@ func (pf *pythonField) buildValidatorsString() {
 	// we actually don't need to sort it to generate correct validators
 	// we need to sort it to generate predictable order which needed during the test
 	sort.Strings(v)
+
+	// None stops the validation chain of a nullable field
+	if pf.isNullable {
+		v = append([]string{"Optional()"}, v...)
+	}
 	pf.Validators = strings.Join(v, ", ")
 }
 
+// BaseClasses returns the parent classes of this class
+func (pc pythonClass) BaseClasses() string {
+	if len(pc.Bases) == 0 {
+		return "Form"
+	}
+	var bases []string
+	for _, base := range pc.Bases {
+		bases = append(bases, base[strings.Index(base, ".")+1:])
+	}
+	return strings.Join(bases, ", ")
+}
+
 // return list of import statements
-func (pc pythonClass) Imports() []string {
+func (pc pythonClass) Imports() ([]string, error) {
 	var imports []string
 
+	for _, base := range pc.Bases {
+		importPath, name, err := pythonLibImportPath(base, "")
+		if err != nil {
+			return nil, err
+		}
+		imports = append(imports, "from "+importPath+" import "+name)
+	}
+
 	for _, v := range pc.Fields {
 		if v.isFormField {
+			importPath, name := v.Type, v.Type
 			if strings.Index(v.ramlType, ".") > 1 { // it is a library
-				importPath, name := pythonLibImportPath(v.ramlType, "")
-				imports = append(imports, "from "+importPath+" import "+name)
-			} else {
-				imports = append(imports, "from "+v.Type+" import "+v.Type)
+				var err error
+				if importPath, name, err = pythonLibImportPath(v.ramlType, ""); err != nil {
+					return nil, err
+				}
+			}
+			if v.isPolymorph {
+				name += ", " + name + discriminatorFormFieldSuffix
 			}
+			imports = append(imports, "from "+importPath+" import "+name)
 		}
 	}
+	if pc.Discriminator != nil {
+		imports = append(imports, "from wtforms.utils import unset_value")
+	}
 	sort.Strings(imports)
-	return imports
+
+	// a class is imported once, even if it is the type of several fields
+	var unique []string
+	for i, imp := range imports {
+		if i == 0 || imp != imports[i-1] {
+			unique = append(unique, imp)
+		}
+	}
+	return unique, nil
 }
 
 // convert from raml Type to python wtforms type
 func (pf *pythonField) setType(t string) {
+	if strings.HasSuffix(t, "{}") { // map
+		log.Info("validator has no support for map, ignore it")
+		return
+	}
+	te, err := raml.ParseTypeExpr(t)
+	if err != nil {
+		log.Infof("%v, ignore it", err)
+		return
+	}
+	pf.setTypeExpr(te)
+}
+
+// set type of a field from a parsed raml type expression
+func (pf *pythonField) setTypeExpr(te *raml.TypeExpr) {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		if te.Items.IsArray() { // bidimensional array
+			log.Info("validator has no support for bidimensional array, ignore it")
+			return
+		}
+		pf.isList = true
+		pf.setTypeExpr(te.Items)
+		return
+	case raml.TypeExprUnion:
+		if nonNil, ok := te.NonNil(); ok && !pf.isList {
+			pf.isNullable = true
+			pf.setTypeExpr(nonNil)
+			return
+		}
+		log.Info("validator has no support for union, ignore it")
+		return
+	}
+
+	t := te.Name
 	pf.ramlType = t
 	switch t {
+	case "any", "nil":
+		if pf.isList {
+			log.Infof("validator has no support for array of %v, ignore it", t)
+			return
+		}
+		// any value is accepted, the field is not validated
+		pf.Type, pf.isAny = "Field", true
 	case "string":
 		pf.Type = "TextField"
 	case "file":
@@ -162,53 +374,69 @@ func (pf *pythonField) setType(t string) {
 		pf.Type = "IntegerField"
 	case "boolean":
 		pf.Type = "BooleanField"
-	case "date":
+	case "date", "date-only":
 		pf.Type = "DateField"
-	}
-
-	if pf.Type != "" { // type already set, no need to go down
-		return
-	}
-
-	// other types that need some processing
-	switch {
-	case strings.HasSuffix(t, "[][]"): // bidimensional array
-		log.Info("validator has no support for bidimensional array, ignore it")
-	case strings.HasSuffix(t, "[]"): // array
-		pf.isList = true
-		pf.setType(t[:len(t)-2])
-	case strings.HasSuffix(t, "{}"): // map
-		log.Info("validator has no support for map, ignore it")
-	case strings.Index(t, "|") > 0:
-		log.Info("validator has no support for union, ignore it")
-	case strings.Index(t, ".") > 1:
-		pf.Type = t[strings.Index(t, ".")+1:]
-		pf.isFormField = true
 	default:
+		if strings.Index(t, ".") > 1 { // library type
+			t = t[strings.Index(t, ".")+1:]
+		}
 		pf.isFormField = true
 		pf.Type = t
 	}
+}
 
+// set the type of a field of a declared nullable type from its non nil type,
+// the field accepts None
+func (pf *pythonField) setNullable(nonNil *raml.ResolvedType) {
+	pf.Type, pf.isFormField, pf.isNullable = "", false, true
+	switch {
+	case nonNil.Kind == raml.KindScalar && pythonScalarTypes[nonNil.Builtin]:
+		pf.setType(nonNil.Builtin)
+	case !nonNil.IsAnonymous():
+		pf.setType(nonNil.Name)
+	default:
+		log.Infof("validator has no support for nullable %v, ignore it", nonNil)
+		return
+	}
+	delete(pf.validators, "DataRequired")
+	pf.Validators = ""
+	pf.buildValidatorsString()
 }
 
 // WTFType return wtforms type of a field
 func (pf pythonField) WTFType() string {
 	switch {
+	case pf.isAny:
+		return fmt.Sprintf("%v()", pf.Type)
 	case pf.isList && pf.isFormField:
-		return fmt.Sprintf("FieldList(FormField(%v))", pf.Type)
+		return fmt.Sprintf("FieldList(%v)", pf.formField())
 	case pf.isList:
 		return fmt.Sprintf("FieldList(%v('%v', [required()]), %v)", pf.Type, pf.Name, pf.Validators)
 	case pf.isFormField:
-		return fmt.Sprintf("FormField(%v)", pf.Type)
+		return pf.formField()
 	default:
 		return fmt.Sprintf("%v(validators=[%v])", pf.Type, pf.Validators)
 	}
 }
 
+// formField returns the form field of a field whose type is a class,
+// the form of a polymorphic field is chosen by the discriminator value
+func (pf pythonField) formField() string {
+	if pf.isPolymorph {
+		return fmt.Sprintf("%v%v(%v)", pf.Type, discriminatorFormFieldSuffix, pf.Type)
+	}
+	return fmt.Sprintf("FormField(%v)", pf.Type)
+}
+
 // generate all python classes from an RAML document
-func generatePythonClasses(types map[string]raml.Type, dir string) error {
-	for k, t := range types {
-		pc := newPythonClassFromType(t, k)
+func generatePythonClasses(types map[string]raml.Type, libraries map[string]*raml.Library, dir string) error {
+	resolved, err := raml.NewTypeResolver(types, libraries).ResolveAll()
+	if err != nil {
+		return err
+	}
+	for k, rt := range resolved {
+		pc := newPythonClassFromType(rt, k)
+		pc.Discriminator = newDiscriminatorDef(rt, resolved)
 		if err := pc.generate(dir); err != nil {
 			return err
 		}
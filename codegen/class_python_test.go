@@ -19,7 +19,7 @@ func TestGeneratePythonClass(t *testing.T) {
 		So(err, ShouldBeNil)
 
 		Convey("python class from raml Types", func() {
-			err = generatePythonClasses(apiDef.Types, targetDir)
+			err = generatePythonClasses(apiDef.Types, apiDef.Libraries, targetDir)
 			So(err, ShouldBeNil)
 
 			// strings validator
@@ -52,6 +52,14 @@ func TestGeneratePythonClass(t *testing.T) {
 			// another test could be seen at body_test.go
 		})
 
+		Convey("annotations of the properties", func() {
+			rt, err := apiDef.TypeResolver().Resolve("ValidationString")
+			So(err, ShouldBeNil)
+
+			pc := newPythonClassFromType(rt, "ValidationString")
+			So(pc.Fields["name"].Annotations.Value("label"), ShouldEqual, "user name")
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetDir)
 		})
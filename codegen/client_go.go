@@ -32,7 +32,7 @@ func (gc goClient) generate(apiDef *raml.APIDefinition, dir string) error {
 	}
 
 	// generate struct
-	if err := generateStructs(apiDef.Types, dir, gc.PackageName, langGo); err != nil {
+	if err := generateStructs(apiDef.Types, apiDef.Libraries, dir, gc.PackageName, langGo); err != nil {
 		return err
 	}
 
@@ -41,6 +41,11 @@ func (gc goClient) generate(apiDef *raml.APIDefinition, dir string) error {
 		return err
 	}
 
+	// generate the parameters of the methods
+	if err := generateParamsStructs(apiDef, dir, gc.PackageName, langGo, false); err != nil {
+		return err
+	}
+
 	// libraries
 	if err := generateLibraries(gc.libraries, dir); err != nil {
 		return err
@@ -65,15 +70,19 @@ func (gc *goClient) generateClientFile(dir string) error {
 }
 
 // LibImportPaths returns all imported lib
-func (gc goClient) LibImportPaths() map[string]struct{} {
+func (gc goClient) LibImportPaths() (map[string]struct{}, error) {
 	ip := map[string]struct{}{}
 
 	// methods
 	for _, v := range gc.Methods {
 		gm := v.(goClientMethod)
-		for lib := range gm.libImported(globRootImportPath) {
+		libs, err := gm.libImported(globRootImportPath)
+		if err != nil {
+			return nil, err
+		}
+		for lib := range libs {
 			ip[lib] = struct{}{}
 		}
 	}
-	return ip
+	return ip, nil
 }
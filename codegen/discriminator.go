@@ -0,0 +1,52 @@
+package codegen
+
+import (
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+const (
+	// suffix of the name of the holder of a polymorphic value
+	discriminatorValueSuffix = "Value"
+
+	// suffix of the name of the python form field of a polymorphic value
+	discriminatorFormFieldSuffix = "FormField"
+)
+
+// discriminatorDef defines the polymorphism of a type which declares a discriminator
+type discriminatorDef struct {
+	Property string          // name of the discriminator property
+	Subtypes []discriminated // the type itself and its subtypes
+}
+
+// discriminated is a type of a discriminated hierarchy
+type discriminated struct {
+	Name  string // name of the type
+	Value string // discriminator value of the type
+}
+
+// isDiscriminatorRoot returns true if the type declares a discriminator
+func isDiscriminatorRoot(rt *raml.ResolvedType) bool {
+	return rt.Decl != nil && rt.Decl.Discriminator != "" && rt.Kind == raml.KindObject
+}
+
+// newDiscriminatorDef creates the discriminator definition of a type,
+// it returns nil if the type doesn't declare a discriminator.
+func newDiscriminatorDef(rt *raml.ResolvedType, types map[string]*raml.ResolvedType) *discriminatorDef {
+	if !isDiscriminatorRoot(rt) {
+		return nil
+	}
+	dd := &discriminatorDef{
+		Property: rt.Decl.Discriminator,
+		Subtypes: []discriminated{{Name: rt.Name, Value: rt.DiscriminatorValue}},
+	}
+	for _, st := range rt.Subtypes(types) {
+		if st.Kind != raml.KindObject {
+			continue
+		}
+		dd.Subtypes = append(dd.Subtypes, discriminated{
+			Name:  goTypeName(st),
+			Value: st.DiscriminatorValue,
+		})
+	}
+	return dd
+}
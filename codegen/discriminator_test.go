@@ -0,0 +1,51 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestDiscriminator(t *testing.T) {
+	Convey("discriminator", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("Go server", func() {
+			err := GenerateServer("./fixtures/discriminator/discriminator.raml", targetDir, "main", "go", "", "examples.com/pets", true)
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"Pet", "Cat", "Dog", "Shelter"} {
+				s, err := testLoadFile(filepath.Join(targetDir, name+".go"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/discriminator", name+".txt"))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("Python server", func() {
+			err := GenerateServer("./fixtures/discriminator/discriminator.raml", targetDir, "main", "python", "", "examples.com/pets", true)
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"Pet", "Cat", "Dog", "Shelter"} {
+				s, err := testLoadFile(filepath.Join(targetDir, name+".py"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/discriminator", name+".py"))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -0,0 +1,189 @@
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
+)
+
+const (
+	userFacetsTemplateLocation             = "./templates/user_facets_go.tmpl"
+	userFacetsFileResult                   = "user_facets.go"
+	userFacetsRegistrationTemplateLocation = "./templates/user_facets_registration_go.tmpl"
+	userFacetsRegistrationFileResult       = "user_facets_registration.go"
+	inputValidatorsTemplateLocation        = "./templates/input_validators_python.tmpl"
+	inputValidatorsFileResult              = "input_validators.py"
+	userFacetsPythonTemplateLocation       = "./templates/user_facets_python.tmpl"
+	userFacetsPythonFileResult             = "user_facets.py"
+)
+
+// userFacet is an user-defined facet declared by a type
+type userFacet struct {
+	Name     string // facet name
+	TypeName string // name of the type which declares the facet
+}
+
+// FuncName returns the name of the Go function which validates this facet
+func (uf userFacet) FuncName() string {
+	return "validate" + strings.Title(uf.Name) + "Facet"
+}
+
+type userFacets []userFacet
+
+func (ufs userFacets) Len() int           { return len(ufs) }
+func (ufs userFacets) Swap(i, j int)      { ufs[i], ufs[j] = ufs[j], ufs[i] }
+func (ufs userFacets) Less(i, j int) bool { return ufs[i].Name < ufs[j].Name }
+
+// declaredFacets returns the user-defined facets declared by the types,
+// sorted by name.
+func declaredFacets(types map[string]raml.Type) userFacets {
+	declared := map[string]userFacet{}
+	for typeName, t := range types {
+		for name := range t.Facets {
+			name = strings.TrimSuffix(name, "?")
+			if uf, ok := declared[name]; !ok || typeName < uf.TypeName {
+				declared[name] = userFacet{Name: name, TypeName: typeName}
+			}
+		}
+	}
+	facets := make(userFacets, 0, len(declared))
+	for _, uf := range declared {
+		facets = append(facets, uf)
+	}
+	sort.Sort(facets)
+	return facets
+}
+
+// libDeclaredFacets returns the user-defined facets declared by the types
+// and the types of the libraries, sorted by name.
+func libDeclaredFacets(types map[string]raml.Type, libraries map[string]*raml.Library) userFacets {
+	facets := declaredFacets(types)
+	names := map[string]bool{}
+	for _, uf := range facets {
+		names[uf.Name] = true
+	}
+	for _, lib := range libraries {
+		for _, uf := range libDeclaredFacets(lib.Types, lib.Libraries) {
+			if !names[uf.Name] {
+				names[uf.Name] = true
+				facets = append(facets, uf)
+			}
+		}
+	}
+	sort.Sort(facets)
+	return facets
+}
+
+// generate the validation functions of the user-defined facets declared by the types.
+// The functions are registered in a file which is always regenerated, their stubs are
+// only generated when user_facets.go is not present, it is meant to be edited to implement
+// the validations.
+func generateUserFacets(types map[string]raml.Type, dir, packageName string) error {
+	facets := declaredFacets(types)
+	if len(facets) == 0 {
+		return nil
+	}
+	ctx := struct {
+		PackageName string
+		Facets      userFacets
+	}{
+		PackageName: packageName,
+		Facets:      facets,
+	}
+	registration := filepath.Join(dir, userFacetsRegistrationFileResult)
+	if err := generateFile(ctx, userFacetsRegistrationTemplateLocation, "user_facets_registration_go", registration, true); err != nil {
+		return err
+	}
+	return generateFile(ctx, userFacetsTemplateLocation, "user_facets_go", filepath.Join(dir, userFacetsFileResult), false)
+}
+
+// generate the python input validators, which import the validators of the
+// user-defined facets. The input validators are always regenerated, the stubs
+// of the facet validators are only generated when user_facets.py is not present.
+func generateInputValidators(types map[string]raml.Type, libraries map[string]*raml.Library, dir string) error {
+	ctx := struct {
+		Facets userFacets
+	}{
+		Facets: libDeclaredFacets(types, libraries),
+	}
+	if err := generateFile(ctx, inputValidatorsTemplateLocation, "input_validators_python",
+		filepath.Join(dir, inputValidatorsFileResult), true); err != nil {
+		return err
+	}
+	if len(ctx.Facets) == 0 {
+		return nil
+	}
+	return generateFile(ctx, userFacetsPythonTemplateLocation, "user_facets_python",
+		filepath.Join(dir, userFacetsPythonFileResult), false)
+}
+
+// facetValidators returns the validator.v2 tags of the user-defined facets values.
+// The value of a facet is the parameter of its validation function,
+// the items of an array value are separated by a space.
+func facetValidators(values map[string]interface{}) string {
+	var names []string
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var validators []string
+	for _, name := range names {
+		validators = append(validators, fmt.Sprintf("%v=%v", name, facetParam(values[name])))
+	}
+	return strings.Join(validators, ",")
+}
+
+// facetParam converts a facet value into validator.v2 parameter
+func facetParam(val interface{}) string {
+	if arr, ok := val.([]interface{}); ok {
+		var items []string
+		for _, v := range arr {
+			items = append(items, facetParam(v))
+		}
+		return strings.Join(items, " ")
+	}
+	return fmt.Sprintf("%v", val)
+}
+
+// joinValidators joins two validator.v2 tags
+func joinValidators(a, b string) string {
+	if a == "" || b == "" {
+		return a + b
+	}
+	return a + "," + b
+}
+
+// addFacetValidators adds the python validators of the user-defined facets values,
+// the validator of a facet is a function of input_validators module.
+func (pf *pythonField) addFacetValidators(values map[string]interface{}) {
+	for name, val := range values {
+		pf.addValidator(name, "value", pythonValue(val))
+		pf.facets = append(pf.facets, name)
+	}
+}
+
+// pythonValue converts a value into python literal
+func pythonValue(val interface{}) string {
+	switch v := val.(type) {
+	case bool:
+		if v {
+			return "True"
+		}
+		return "False"
+	case string:
+		return fmt.Sprintf("%q", v)
+	case nil:
+		return "None"
+	case []interface{}:
+		var items []string
+		for _, item := range v {
+			items = append(items, pythonValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	}
+	return fmt.Sprintf("%v", val)
+}
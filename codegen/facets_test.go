@@ -0,0 +1,90 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUserDefinedFacets(t *testing.T) {
+	Convey("user-defined facets", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("Go server", func() {
+			err := GenerateServer("./fixtures/facets/facets.raml", targetDir, "main", "go", "", "examples.com/facets", true)
+			So(err, ShouldBeNil)
+
+			files := map[string]string{
+				"CustomDate.go":               "CustomDate.txt",
+				"PossibleMeetingDate.go":      "PossibleMeetingDate.txt",
+				"Meeting.go":                  "Meeting.txt",
+				"user_facets.go":              "user_facets.txt",
+				"user_facets_registration.go": "user_facets_registration.txt",
+			}
+			for file, fixture := range files {
+				s, err := testLoadFile(filepath.Join(targetDir, file))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/facets", fixture))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("Python server", func() {
+			err := GenerateServer("./fixtures/facets/facets.raml", targetDir, "main", "python", "", "examples.com/facets", true)
+			So(err, ShouldBeNil)
+
+			for _, file := range []string{"Meeting.py", "input_validators.py", "user_facets.py"} {
+				s, err := testLoadFile(filepath.Join(targetDir, file))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/facets", file))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("the implementations are kept when regenerating", func() {
+			goImpl := "package main\n\n// implemented\n"
+			pyImpl := "# implemented\n"
+			So(ioutil.WriteFile(filepath.Join(targetDir, "user_facets.go"), []byte(goImpl), 0644), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(targetDir, "user_facets.py"), []byte(pyImpl), 0644), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(targetDir, "user_facets_registration.go"), nil, 0644), ShouldBeNil)
+			So(ioutil.WriteFile(filepath.Join(targetDir, "input_validators.py"), nil, 0644), ShouldBeNil)
+
+			err := GenerateServer("./fixtures/facets/facets.raml", targetDir, "main", "go", "", "examples.com/facets", true)
+			So(err, ShouldBeNil)
+			err = GenerateServer("./fixtures/facets/facets.raml", targetDir, "main", "python", "", "examples.com/facets", true)
+			So(err, ShouldBeNil)
+
+			files := map[string]string{
+				"user_facets.go":              goImpl,
+				"user_facets.py":              pyImpl,
+				"user_facets_registration.go": "",
+				"input_validators.py":         "",
+			}
+			for file, content := range files {
+				s, err := testLoadFile(filepath.Join(targetDir, file))
+				So(err, ShouldBeNil)
+				if content == "" { // regenerated
+					fixture := strings.Replace(file, ".go", ".txt", 1)
+					content, err = testLoadFile(filepath.Join("./fixtures/facets", fixture))
+					So(err, ShouldBeNil)
+				}
+				So(s, ShouldEqual, content)
+			}
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
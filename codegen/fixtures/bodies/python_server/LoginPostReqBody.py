@@ -0,0 +1,12 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+
+
+class LoginPostReqBody(Form):
+    
+    password = TextField(validators=[DataRequired(message=""), Length(min=8)])
+    username = TextField(validators=[DataRequired(message="")])
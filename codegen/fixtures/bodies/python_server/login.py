@@ -0,0 +1,21 @@
+from flask import Blueprint, jsonify, request
+
+
+from LoginPostReqBody import LoginPostReqBody
+
+
+login_api = Blueprint('login_api', __name__)
+
+
+@login_api.route('/login', methods=['POST'])
+def login_post():
+    '''
+    log in with the login form
+    It is handler for POST /login
+    '''
+    
+    inputs = LoginPostReqBody(request.form)
+    if not inputs.validate():
+        return jsonify(errors=inputs.errors), 400
+    
+    return jsonify()
@@ -1,6 +1,8 @@
 from flask import Blueprint, jsonify, request
 
 
+from DeliveriesGetParams import DeliveriesGetParams
+
 from User import User
 
 from User import User
@@ -16,6 +18,10 @@ def deliveries_get():
     It is handler for GET /deliveries
     '''
     
+    params = DeliveriesGetParams(request.args)
+    if not params.validate():
+        return jsonify(errors=params.errors), 400
+    
     return jsonify()
 
 
@@ -1,6 +1,8 @@
 from flask import Blueprint, jsonify, request
 
 
+from DronesGetParams import DronesGetParams
+
 from User import User
 
 from User import User
@@ -16,6 +18,10 @@ def drones_get():
     It is handler for GET /drones
     '''
     
+    params = DronesGetParams(request.args)
+    if not params.validate():
+        return jsonify(errors=params.errors), 400
+    
     return jsonify()
 
 
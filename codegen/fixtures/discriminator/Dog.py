@@ -0,0 +1,12 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+from Pet import Pet
+
+
+class Dog(Pet):
+    
+    breed = TextField(validators=[DataRequired(message="")])
@@ -0,0 +1,54 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+from wtforms.utils import unset_value
+
+
+class Pet(Form):
+    
+    kind = TextField(validators=[DataRequired(message="")])
+    name = TextField(validators=[DataRequired(message="")])
+
+    @classmethod
+    def subtype(cls, value):
+        """
+        returns Pet or its subtype whose `kind` property is value
+        """
+        if value == 'Cat':
+            from Cat import Cat
+            return Cat
+        if value == 'dog':
+            from Dog import Dog
+            return Dog
+        return Pet
+
+    @classmethod
+    def from_json(cls, data, *args, **kwargs):
+        """
+        create Pet or one of its subtypes,
+        the type is identified by the value of `kind` property
+        """
+        if cls is Pet and isinstance(data, dict):
+            subtype = Pet.subtype(data.get('kind'))
+            if subtype is not Pet:
+                return subtype.from_json(data, *args, **kwargs)
+        return super(Pet, cls).from_json(data, *args, **kwargs)
+
+
+class PetFormField(FormField):
+    """
+    form field of Pet or one of its subtypes,
+    the form is chosen according to the value of `kind` property
+    """
+
+    def process(self, formdata, data=unset_value):
+        value = None
+        if formdata:
+            value = formdata.get(self.name + self.separator + 'kind')
+        elif isinstance(data, dict):
+            value = data.get('kind')
+        self.form_class = Pet.subtype(value)
+        super(PetFormField, self).process(formdata, data)
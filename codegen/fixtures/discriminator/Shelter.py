@@ -0,0 +1,13 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+from Pet import Pet, PetFormField
+
+
+class Shelter(Form):
+    
+    favorite = PetFormField(Pet)
+    pets = FieldList(PetFormField(Pet))
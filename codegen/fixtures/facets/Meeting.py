@@ -0,0 +1,12 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of, codes, noHolidays
+
+
+
+class Meeting(Form):
+    
+    date = DateField(validators=[DataRequired(message=""), noHolidays(value=True)])
+    price = TextField(validators=[DataRequired(message=""), codes(value=["EUR", "USD"])])
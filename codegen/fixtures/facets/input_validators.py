@@ -0,0 +1,17 @@
+
+# This file is auto-generated by go-raml
+# Do not edit this file by hand since it will be overwritten during the next generation
+
+from wtforms.validators import ValidationError
+from user_facets import codes, noHolidays, onlyFutureDates
+
+def multiple_of(mult):
+    ''' check if value is multipe of mult'''
+
+    message = 'Must be multiple of %d' % (mult)
+
+    def _multiple_of(form, field):
+        if field.data % mult != 0:
+            raise ValidationError(message)
+
+    return _multiple_of
@@ -0,0 +1,29 @@
+
+from wtforms.validators import ValidationError
+
+
+def codes(value):
+    ''' check the `codes` facet declared by Currency, value is the value of the facet'''
+
+    def _codes(form, field):
+        pass
+
+    return _codes
+
+
+def noHolidays(value):
+    ''' check the `noHolidays` facet declared by CustomDate, value is the value of the facet'''
+
+    def _noHolidays(form, field):
+        pass
+
+    return _noHolidays
+
+
+def onlyFutureDates(value):
+    ''' check the `onlyFutureDates` facet declared by CustomDate, value is the value of the facet'''
+
+    def _onlyFutureDates(form, field):
+        pass
+
+    return _onlyFutureDates
@@ -0,0 +1,19 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required, Optional
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList, Field
+from input_validators import multiple_of
+
+from Friend import Friend
+
+
+class Person(Form):
+    
+    age = IntegerField(validators=[Optional(), NumberRange(min=0)])
+    comment = TextField(validators=[Optional(), Length(max=140)])
+    data = Field()
+    friends = FieldList(FormField(Friend))
+    name = TextField(validators=[DataRequired(message="")])
+    nickname = TextField(validators=[Optional(), Length(min=2)])
+    none = Field()
+    parent = FormField(Friend)
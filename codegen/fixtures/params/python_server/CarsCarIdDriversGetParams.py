@@ -0,0 +1,12 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required, Optional
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+
+
+class CarsCarIdDriversGetParams(Form):
+    
+    limit = IntegerField(validators=[Optional(), NumberRange(min=1, max=100)])
+    offset = IntegerField(validators=[Optional(), NumberRange(min=0)])
@@ -0,0 +1,15 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required, AnyOf, Optional
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+
+
+class CarsGetParams(Form):
+    
+    color = TextField(validators=[Optional(), AnyOf(values=["red", "green", "blue"])])
+    electric = BooleanField(validators=[Optional()])
+    minPrice = FloatField(validators=[Optional(), NumberRange(min=0)])
+    since = DateField(validators=[Optional()])
+    sort = TextField(validators=[Optional(), AnyOf(values=["price", "year"])])
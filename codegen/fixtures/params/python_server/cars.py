@@ -0,0 +1,45 @@
+from flask import Blueprint, jsonify, request
+
+
+from CarsGetParams import CarsGetParams
+
+from CarsCarIdDriversGetParams import CarsCarIdDriversGetParams
+
+
+cars_api = Blueprint('cars_api', __name__)
+
+
+@cars_api.route('/cars', methods=['GET'])
+def cars_get():
+    '''
+    It is handler for GET /cars
+    '''
+    
+    params = CarsGetParams(request.args)
+    if not params.validate():
+        return jsonify(errors=params.errors), 400
+    
+    return jsonify()
+
+
+@cars_api.route('/cars/<int:carId>', methods=['GET'])
+def cars_byCarId_get(carId):
+    '''
+    get a car
+    It is handler for GET /cars/<int:carId>
+    '''
+    
+    return jsonify()
+
+
+@cars_api.route('/cars/<int:carId>/drivers', methods=['GET'])
+def cars_byCarId_drivers_get(carId):
+    '''
+    It is handler for GET /cars/<int:carId>/drivers
+    '''
+    
+    params = CarsCarIdDriversGetParams(request.args)
+    if not params.validate():
+        return jsonify(errors=params.errors), 400
+    
+    return jsonify()
@@ -0,0 +1,16 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+from OrderItemType import OrderItemType
+
+
+class Order(Form):
+    
+    date = DateField(validators=[DataRequired(message="")])
+    id = IntegerField(validators=[DataRequired(message="")])
+    item = FieldList(FormField(OrderItemType))
+    note = TextField(validators=[])
+    status = TextField(validators=[DataRequired(message="")])
@@ -0,0 +1,13 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+
+
+class OrderItemType(Form):
+    
+    price = FloatField(validators=[DataRequired(message="")])
+    quantity = IntegerField(validators=[DataRequired(message="")])
+    sku = TextField(validators=[DataRequired(message="")])
@@ -0,0 +1,21 @@
+
+from flask_wtf import Form
+from wtforms.validators import DataRequired, Length, Regexp, NumberRange, required, Optional
+from wtforms import TextField, FormField, IntegerField, FloatField, FileField, BooleanField, DateField, FieldList
+from input_validators import multiple_of
+
+from PersonAddress import PersonAddress
+from PersonPhone import PersonPhone
+
+
+class Person(Form):
+    
+    address = FormField(PersonAddress)
+    age = IntegerField(validators=[NumberRange(min=0, max=150)])
+    birthday = DateField(validators=[])
+    email = TextField(validators=[Regexp(regex="^.+@.+$")])
+    name = TextField(validators=[DataRequired(message=""), Length(min=1)])
+    nickname = TextField(validators=[Optional()])
+    phones = FieldList(FormField(PersonPhone))
+    status = TextField(validators=[])
+    tags = FieldList(TextField('tags', [required()]), )
@@ -1,11 +1,11 @@
 package codegen
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
 	"github.com/Jumpscale/go-raml/raml"
-	log "github.com/Sirupsen/logrus"
 )
 
 // library defines an RAML library
@@ -53,7 +53,7 @@ func (l *goLibrary) generate() error {
 	}
 
 	// generate all Type structs
-	if err := generateStructs(l.Types, l.dir, l.PackageName, langGo); err != nil {
+	if err := generateStructs(l.Types, l.Libraries, l.dir, l.PackageName, langGo); err != nil {
 		return err
 	}
 
@@ -81,30 +81,30 @@ func libRelDir(filename string) string {
 }
 
 // get library import path from a type
-func libImportPath(rootImportPath, typ string) string {
+func libImportPath(rootImportPath, typ string) (string, error) {
 	// library use '.', return nothing if it is not a library
 	if strings.Index(typ, ".") < 0 {
-		return ""
+		return "", nil
 	}
 
 	// library name in the current document
 	libName := strings.Split(typ, ".")[0]
 
 	if libName == "goraml" { // special package name, reserved for goraml
-		return filepath.Join(rootImportPath, "goraml")
+		return filepath.Join(rootImportPath, "goraml"), nil
 	}
 
 	// raml file of this lib
 	libRAMLFile := globAPIDef.FindLibFile(denormalizePkgName(libName))
 
 	if libRAMLFile == "" {
-		log.Fatalf("can't find library : %v", libName)
+		return "", fmt.Errorf("can't find library %v of type %v", libName, typ)
 	}
 
 	// relative lib package
 	libPkg := libRelDir(libRAMLFile)
 
-	return filepath.Join(rootImportPath, normalizePkgName(libPkg))
+	return filepath.Join(rootImportPath, normalizePkgName(libPkg)), nil
 }
 
 // normalize package name because not all characters can be used as package name
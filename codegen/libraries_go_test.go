@@ -80,3 +80,18 @@ func TestGoLibrary(t *testing.T) {
 	})
 
 }
+
+func TestGoMissingLibrary(t *testing.T) {
+	Convey("type from unknown library", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		err = GenerateServer("./fixtures/libraries/missing_lib.raml", targetDir, "main", "go", "apidocs", "examples.com/ramlcode", true)
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "missing_lib.raml:5:5: unknown library geo of type geo.City")
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
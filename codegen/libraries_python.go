@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -63,7 +64,7 @@ func (l *pythonLibrary) generate() error {
 	}
 
 	// python classes
-	if err := generatePythonClasses(l.Types, l.dir); err != nil {
+	if err := generatePythonClasses(l.Types, l.Libraries, l.dir); err != nil {
 		log.Errorf("failed to generate python clased:%v", err)
 		return err
 	}
@@ -83,15 +84,16 @@ func (l *pythonLibrary) generate() error {
 	return nil
 }
 
-func pythonLibImportPath(typ, prefix string) (string, string) {
+// get python import path and name of a type which might be from a library
+func pythonLibImportPath(typ, prefix string) (string, string, error) {
 	// library use '.'
 	if strings.Index(typ, ".") < 0 {
-		return prefix + typ, prefix + typ
+		return prefix + typ, prefix + typ, nil
 	}
 
-	splitted := strings.Split(typ, ".")
+	splitted := strings.Split(libTypeName(typ), ".")
 	if len(splitted) != 2 {
-		log.Fatalf("pythonLibImportPath invalid typ:" + typ)
+		return "", "", fmt.Errorf("invalid library type:%v", typ)
 	}
 	// library name in the current document
 	libName := splitted[0]
@@ -100,11 +102,11 @@ func pythonLibImportPath(typ, prefix string) (string, string) {
 	libRAMLFile := globAPIDef.FindLibFile(denormalizePkgName(libName))
 
 	if libRAMLFile == "" {
-		log.Fatalf("pythonLibImportPath() can't find library : %v", libName)
+		return "", "", fmt.Errorf("can't find library %v of type %v", libName, typ)
 	}
 
 	// relative lib package
 	libPkg := libRelDir(libRAMLFile)
 
-	return strings.Replace(normalizePkgName(libPkg), "/", ".", -1) + "." + prefix + splitted[1], prefix + splitted[1]
+	return strings.Replace(normalizePkgName(libPkg), "/", ".", -1) + "." + prefix + splitted[1], prefix + splitted[1], nil
 }
@@ -15,16 +15,19 @@ type methodInterface interface {
 // Method defines base Method struct
 type method struct {
 	*raml.Method
-	MethodName   string
-	Endpoint     string
-	verb         string
-	ReqBody      string         // request body type
-	RespBody     string         // response body type
-	ResourcePath string         // normalized resource path
-	resource     *raml.Resource // resource object of this method
-	Params       string         // methods params
-	FuncComments []string
-	SecuredBy    []raml.DefinitionChoice
+	MethodName        string
+	Endpoint          string
+	verb              string
+	ReqBody           string         // request body type
+	RespBody          string         // response body type
+	ReqBodyMediaType  string         // media type of the request body
+	RespBodyMediaType string         // media type of the response body
+	ResourcePath      string         // normalized resource path
+	resource          *raml.Resource // resource object of this method
+	Params            string         // methods params
+	ParamsStruct      string         // type of the URI parameters, query parameters and headers
+	FuncComments      []string
+	SecuredBy         []raml.DefinitionChoice
 }
 
 func (m method) Verb() string {
@@ -39,6 +42,19 @@ func (m method) EndpointStr() string {
 	return m.Endpoint
 }
 
+// ReqBodyEncoding returns the encoding of the request body: json, xml or form
+func (m method) ReqBodyEncoding() string {
+	return bodyEncoding(m.ReqBodyMediaType)
+}
+
+// RespBodyEncoding returns the encoding of the response body: json or xml
+func (m method) RespBodyEncoding() string {
+	if enc := bodyEncoding(m.RespBodyMediaType); enc == encodingXML {
+		return enc
+	}
+	return encodingJSON
+}
+
 func newMethod(r *raml.Resource, rd *resourceDef, m *raml.Method, methodName string) method {
 	method := method{
 		Method:   m,
@@ -48,12 +64,12 @@ func newMethod(r *raml.Resource, rd *resourceDef, m *raml.Method, methodName str
 	}
 
 	// set request body
-	method.ReqBody = assignBodyName(m.Bodies, normalizeURITitle(method.Endpoint)+methodName, "ReqBody")
+	method.ReqBody, method.ReqBodyMediaType = assignBodyName(m.Bodies, normalizeURITitle(method.Endpoint)+methodName, "ReqBody")
 
 	//set response body
 	for k, v := range m.Responses {
 		if k >= 200 && k < 300 {
-			method.RespBody = assignBodyName(v.Bodies, normalizeURITitle(method.Endpoint)+methodName, "RespBody")
+			method.RespBody, method.RespBodyMediaType = assignBodyName(v.Bodies, normalizeURITitle(method.Endpoint)+methodName, "RespBody")
 		}
 	}
 
@@ -71,6 +87,10 @@ func newServerMethod(apiDef *raml.APIDefinition, r *raml.Resource, rd *resourceD
 
 	method := newMethod(r, rd, m, methodName)
 
+	if hasParams(r, m, paramsLocations(lang, true)...) {
+		method.ParamsStruct = paramsName(method.Endpoint, methodName)
+	}
+
 	// security scheme
 	if len(m.SecuredBy) > 0 {
 		method.SecuredBy = m.SecuredBy
@@ -106,7 +126,7 @@ func newClientMethod(r *raml.Resource, rd *resourceDef, m *raml.Method, methodNa
 
 	name := normalizeURITitle(method.Endpoint)
 
-	method.ReqBody = assignBodyName(m.Bodies, name+methodName, "ReqBody")
+	method.ReqBody, method.ReqBodyMediaType = assignBodyName(m.Bodies, name+methodName, "ReqBody")
 
 	switch lang {
 	case langGo:
@@ -123,26 +143,24 @@ func newClientMethod(r *raml.Resource, rd *resourceDef, m *raml.Method, methodNa
 	}
 }
 
-// assignBodyName assign method's request body by bodies.Type or bodies.ApplicationJSON
-// if bodiesType generated from bodies.Type we dont need append prefix and suffix
-// 		example : bodies.Type = City, so bodiesType = City
-// if bodiesType generated from bodies.ApplicationJSON, we get that value from prefix and suffix
+// assignBodyName assign method's request body by the type of the body
+// of the preferred media type, see typedBody.
+// if bodiesType generated from the body type we dont need append prefix and suffix
+// 		example : type = City, so bodiesType = City
+// if bodiesType generated from the body properties, we get that value from prefix and suffix
 //		suffix = [ReqBody | RespBody] and prefix should be uri + method name.
 //		example prefix could be UsersUserIdDelete
-func assignBodyName(bodies raml.Bodies, prefix, suffix string) string {
-	var bodiesType string
-
-	if len(bodies.Type) > 0 {
-		bodiesType = convertToGoType(bodies.Type)
-	} else if bodies.ApplicationJSON != nil {
-		if bodies.ApplicationJSON.Type != "" {
-			bodiesType = convertToGoType(bodies.ApplicationJSON.Type)
-		} else {
-			bodiesType = prefix + suffix
-		}
+// It also returns the media type of the body.
+func assignBodyName(bodies raml.Bodies, prefix, suffix string) (string, string) {
+	mediaType, body, ok := typedBody(bodies)
+	switch {
+	case !ok:
+		return "", ""
+	case body.Type != "":
+		return convertToGoType(body.Type), mediaType
+	default:
+		return prefix + suffix, mediaType
 	}
-
-	return bodiesType
 }
 
 // find resource's securedBy recursively
@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/Jumpscale/go-raml/raml"
@@ -43,18 +44,20 @@ func (gm *goServerMethod) setup(apiDef *raml.APIDefinition, r *raml.Resource, rd
 }
 
 // return all libs imported by this method
-func (m method) libImported(rootImportPath string) map[string]struct{} {
+func (m method) libImported(rootImportPath string) (map[string]struct{}, error) {
 	libs := map[string]struct{}{}
 
-	// req body
-	if lib := libImportPath(rootImportPath, m.ReqBody); lib != "" {
-		libs[lib] = struct{}{}
-	}
-	// resp body
-	if lib := libImportPath(rootImportPath, m.RespBody); lib != "" {
-		libs[lib] = struct{}{}
+	// req & resp body
+	for _, body := range []string{m.ReqBody, m.RespBody} {
+		lib, err := libImportPath(rootImportPath, body)
+		if err != nil {
+			return nil, fmt.Errorf("%v %v: %v", m.Verb(), m.Endpoint, err)
+		}
+		if lib != "" {
+			libs[lib] = struct{}{}
+		}
 	}
-	return libs
+	return libs, nil
 }
 
 type goClientMethod struct {
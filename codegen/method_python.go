@@ -22,8 +22,12 @@ func (pm *pythonServerMethod) setup(apiDef *raml.APIDefinition, r *raml.Resource
 		pm.MethodName = snakeCaseResourceURI(r) + "_" + strings.ToLower(pm.Verb())
 	}
 	pm.Params = strings.Join(getResourceParams(r), ", ")
-	pm.Endpoint = strings.Replace(pm.Endpoint, "{", "<", -1)
-	pm.Endpoint = strings.Replace(pm.Endpoint, "}", ">", -1)
+	pm.Endpoint = flaskEndpoint(apiDef, r, pm.Endpoint)
+
+	// the XML request bodies are not validated
+	if pm.ReqBodyEncoding() == encodingXML {
+		pm.ReqBody = ""
+	}
 
 	// security middlewares
 	for _, v := range pm.SecuredBy {
@@ -0,0 +1,215 @@
+package codegen
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
+	log "github.com/Sirupsen/logrus"
+)
+
+const (
+	paramsSuffix = "Params"
+
+	// location of a parameter in the request
+	paramInURI    = "uri"
+	paramInQuery  = "query"
+	paramInHeader = "header"
+)
+
+// paramDef is an URI parameter, a query parameter or a header of a method
+type paramDef struct {
+	Name     string // name of the parameter in the request
+	In       string // location of the parameter in the request
+	Required bool
+	Type     *raml.ResolvedType
+}
+
+// methodParams returns the URI parameters, the query parameters and the headers
+// of a method, each group is sorted by name.
+// The query parameters are the properties of the query string if
+// the method declares it instead of query parameters.
+func methodParams(apiDef *raml.APIDefinition, r *raml.Resource, m *raml.Method) ([]paramDef, error) {
+	tr := apiDef.TypeResolver()
+	var params []paramDef
+
+	addParams := func(in string, nps map[string]raml.NamedParameter, required bool) error {
+		var names []string
+		for name := range nps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			rt, err := tr.ResolveParam(nps[name])
+			if err != nil {
+				return fmt.Errorf("%v parameter %v: %v", in, name, err)
+			}
+			params = append(params, paramDef{
+				Name:     name,
+				In:       in,
+				Required: required || nps[name].Required,
+				Type:     rt,
+			})
+		}
+		return nil
+	}
+
+	// URI parameters, which are declared by the resource or one of its parents
+	uriParams := map[string]raml.NamedParameter{}
+	for _, name := range getResourceParams(r) {
+		uriParams[name] = findURIParameter(r, name)
+	}
+	if err := addParams(paramInURI, uriParams, true); err != nil {
+		return nil, err
+	}
+
+	// query parameters
+	if err := addParams(paramInQuery, m.QueryParameters, false); err != nil {
+		return nil, err
+	}
+	if m.QueryString != nil {
+		qs, err := tr.ResolveDecl(*m.QueryString)
+		if err != nil {
+			return nil, fmt.Errorf("query string: %v", err)
+		}
+		if qs.Kind != raml.KindObject {
+			log.Infof("query string of type %v is not supported, ignore it", qs.Kind)
+		} else {
+			props := qs.AllProperties()
+			for _, name := range qs.PropertyNames() {
+				params = append(params, paramDef{
+					Name:     name,
+					In:       paramInQuery,
+					Required: props[name].Required,
+					Type:     props[name].Type,
+				})
+			}
+		}
+	}
+
+	// headers
+	headers := map[string]raml.NamedParameter{}
+	for name, h := range m.Headers {
+		headers[string(name)] = raml.NamedParameter(h)
+	}
+	if err := addParams(paramInHeader, headers, false); err != nil {
+		return nil, err
+	}
+	return params, nil
+}
+
+// findURIParameter finds the declaration of an URI parameter
+// in a resource or its parents.
+// An undeclared URI parameter is a string.
+func findURIParameter(r *raml.Resource, name string) raml.NamedParameter {
+	for ; r != nil; r = r.Parent {
+		if np, ok := r.URIParameters[name]; ok {
+			return np
+		}
+	}
+	return raml.NamedParameter{Name: name, Type: "string"}
+}
+
+// hasParams returns true if a method has at least one parameter in
+// one of the given locations
+func hasParams(r *raml.Resource, m *raml.Method, in ...string) bool {
+	for _, loc := range in {
+		switch {
+		case loc == paramInURI && len(getResourceParams(r)) > 0:
+			return true
+		case loc == paramInQuery && (len(m.QueryParameters) > 0 || m.QueryString != nil):
+			return true
+		case loc == paramInHeader && len(m.Headers) > 0:
+			return true
+		}
+	}
+	return false
+}
+
+// paramsLocations returns the locations of the parameters
+// which are held by the generated parameters types.
+// The generated python server only validates the query parameters.
+func paramsLocations(lang string, isServer bool) []string {
+	switch {
+	case lang == langPython && isServer:
+		return []string{paramInQuery}
+	case lang == langPython:
+		return nil
+	case isServer:
+		return []string{paramInURI, paramInQuery, paramInHeader}
+	default: // the URI parameters are arguments of the client methods
+		return []string{paramInQuery, paramInHeader}
+	}
+}
+
+// paramsName returns the name of the type which holds the parameters of a method
+func paramsName(endpoint, methodName string) string {
+	return normalizeURITitle(endpoint) + methodName + paramsSuffix
+}
+
+// generateParamsStructs generates the types which hold the parameters
+// of the methods of all resources.
+// The server parses the URI parameters, the query parameters and the headers
+// of the requests, the client builds the query parameters and the headers.
+func generateParamsStructs(apiDef *raml.APIDefinition, dir, packageName, lang string, isServer bool) error {
+	var keys []string
+	for k := range apiDef.Resources {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		r := apiDef.Resources[k]
+		if err := generateResourceParams(apiDef, &r, dir, packageName, lang, isServer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// generate the parameters types of the methods of a resource and its nested resources
+func generateResourceParams(apiDef *raml.APIDefinition, r *raml.Resource, dir, packageName, lang string, isServer bool) error {
+	var methods = []struct {
+		Name   string
+		Method *raml.Method
+	}{
+		{"Get", r.Get},
+		{"Post", r.Post},
+		{"Put", r.Put},
+		{"Patch", r.Patch},
+		{"Delete", r.Delete},
+	}
+	for _, v := range methods {
+		if v.Method == nil {
+			continue
+		}
+		if !hasParams(r, v.Method, paramsLocations(lang, isServer)...) {
+			continue
+		}
+		params, err := methodParams(apiDef, r, v.Method)
+		if err != nil {
+			return fmt.Errorf("%v %v: %v", strings.ToUpper(v.Name), r.FullURI(), err)
+		}
+		name := paramsName(r.FullURI(), v.Name)
+
+		switch lang {
+		case langGo:
+			pd := newGoParamsDef(name, packageName, strings.ToUpper(v.Name), r.FullURI(), params, isServer)
+			err = pd.generate(dir)
+		case langPython:
+			pc := newPythonQueryParamsClass(name, params)
+			err = pc.generate(dir)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, n := range r.Nested {
+		if err := generateResourceParams(apiDef, n, dir, packageName, lang, isServer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+package codegen
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	paramsGoTemplate       = "./templates/params_go.tmpl"
+	paramsHelperGoTemplate = "./templates/params_helper_go.tmpl"
+	paramsHelperFileResult = "params.go"
+)
+
+// goParamsDef is the Go struct which holds the parameters of a method
+type goParamsDef struct {
+	Name        string // struct name
+	PackageName string
+	Verb        string
+	Endpoint    string
+	IsServer    bool // the server parses the parameters, the client builds them
+	Fields      []goParamField
+}
+
+// goParamField is a field of the parameters struct
+type goParamField struct {
+	Name        string // field name
+	Type        string // field type
+	Param       string // name of the parameter in the request
+	In          string // location of the parameter in the request
+	Required    bool
+	Validators  string        // validator.v2 tags of the facets of the parameter
+	Enum        []interface{} // enum values
+	UniqueItems bool
+	isDate      bool // the date types are formatted by their String method
+}
+
+// create the parameters struct of a method,
+// the URI parameters are only held by the server struct
+func newGoParamsDef(name, packageName, verb, endpoint string, params []paramDef, isServer bool) goParamsDef {
+	pd := goParamsDef{
+		Name:        name,
+		PackageName: packageName,
+		Verb:        verb,
+		Endpoint:    endpoint,
+		IsServer:    isServer,
+	}
+	names := map[string]bool{}
+	for _, p := range params {
+		if p.In == paramInURI && !isServer {
+			continue
+		}
+		f := newGoParamField(p)
+		if names[f.Name] { // e.g. an URI parameter and a query parameter with the same name
+			f.Name = strings.Title(p.In) + f.Name
+		}
+		names[f.Name] = true
+		pd.Fields = append(pd.Fields, f)
+	}
+	return pd
+}
+
+// create the field of a parameter.
+// An optional parameter which isn't an array is a pointer,
+// it is nil when the parameter is omitted.
+func newGoParamField(p paramDef) goParamField {
+	f := goParamField{
+		Name:     goParamName(p.Name),
+		Type:     goType(p.Type),
+		Param:    p.Name,
+		In:       p.In,
+		Required: p.Required,
+	}
+	if !p.Required && !f.IsSlice() && !f.IsPointer() {
+		f.Type = "*" + f.Type
+	}
+	f.Enum = p.Type.Enum
+	switch p.Type.Builtin {
+	case "date", "date-only", "time-only", "datetime-only", "datetime":
+		f.isDate = true
+	}
+
+	facets := p.Type.Facets
+	var validators []string
+	if facets.MinLength != nil {
+		validators = append(validators, fmt.Sprintf("min=%v", *facets.MinLength))
+	}
+	if facets.MaxLength != nil {
+		validators = append(validators, fmt.Sprintf("max=%v", *facets.MaxLength))
+	}
+	if facets.Pattern != nil {
+		validators = append(validators, fmt.Sprintf("regexp=%v", *facets.Pattern))
+	}
+	if facets.Minimum != nil {
+		validators = append(validators, fmt.Sprintf("min=%v", *facets.Minimum))
+	}
+	if facets.Maximum != nil {
+		validators = append(validators, fmt.Sprintf("max=%v", *facets.Maximum))
+	}
+	if facets.MultipleOf != nil {
+		validators = append(validators, fmt.Sprintf("multipleOf=%v", *facets.MultipleOf))
+	}
+	if facets.MinItems != nil {
+		validators = append(validators, fmt.Sprintf("min=%v", *facets.MinItems))
+	}
+	if facets.MaxItems != nil {
+		validators = append(validators, fmt.Sprintf("max=%v", *facets.MaxItems))
+	}
+	f.Validators = joinValidators(strings.Join(validators, ","), facetValidators(p.Type.FacetValues))
+	f.UniqueItems = facets.UniqueItems != nil && *facets.UniqueItems
+	return f
+}
+
+// IsSlice returns true if the field is an array
+func (f goParamField) IsSlice() bool {
+	return strings.HasPrefix(f.Type, "[]")
+}
+
+// IsPointer returns true if the field is nil when the parameter is omitted
+func (f goParamField) IsPointer() bool {
+	return strings.HasPrefix(f.Type, "*")
+}
+
+// Value returns the expression of the value of the field
+func (f goParamField) Value() string {
+	if f.IsPointer() {
+		return "*p." + f.Name
+	}
+	return "p." + f.Name
+}
+
+// RequestValue returns the expression of the value of the field in the request
+func (f goParamField) RequestValue() string {
+	switch {
+	case f.isDate && f.IsPointer():
+		return "p." + f.Name
+	case f.isDate:
+		return "&p." + f.Name
+	}
+	return f.Value()
+}
+
+// EnumCases returns the Go literals of the enum values
+func (f goParamField) EnumCases() string {
+	var cases []string
+	for _, v := range f.Enum {
+		cases = append(cases, goLiteral(v))
+	}
+	return strings.Join(cases, ", ")
+}
+
+// EnumError returns the format of the error of a value which isn't in the enum
+func (f goParamField) EnumError() string {
+	values := strings.Replace(fmt.Sprint(f.Enum), "%", "%%", -1)
+	return strconv.Quote(fmt.Sprintf("%v: %%v is not one of %v", f.Param, values))
+}
+
+// Source returns the expression of the values of the parameter in the request
+func (f goParamField) Source() string {
+	switch f.In {
+	case paramInURI:
+		return fmt.Sprintf(`[]string{vars["%v"]}`, f.Param)
+	case paramInHeader:
+		return fmt.Sprintf(`r.Header[http.CanonicalHeaderKey("%v")]`, f.Param)
+	default:
+		return fmt.Sprintf(`query["%v"]`, f.Param)
+	}
+}
+
+// Query returns the query parameter fields
+func (pd goParamsDef) Query() []goParamField {
+	return pd.fieldsIn(paramInQuery)
+}
+
+// Headers returns the header fields
+func (pd goParamsDef) Headers() []goParamField {
+	return pd.fieldsIn(paramInHeader)
+}
+
+// HasURIParams returns true if the struct holds URI parameters
+func (pd goParamsDef) HasURIParams() bool {
+	return len(pd.fieldsIn(paramInURI)) > 0
+}
+
+func (pd goParamsDef) fieldsIn(in string) []goParamField {
+	var fields []goParamField
+	for _, f := range pd.Fields {
+		if f.In == in {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// ImportPaths returns all packages that need to be imported by the parameters struct
+func (pd goParamsDef) ImportPaths() (map[string]struct{}, error) {
+	ip := map[string]struct{}{}
+	if pd.IsServer {
+		ip["net/http"] = struct{}{}
+		lib, err := libImportPath(globRootImportPath, "goraml.ParseParam")
+		if err != nil {
+			return nil, err
+		}
+		ip[lib] = struct{}{}
+		if pd.HasURIParams() {
+			ip["github.com/gorilla/mux"] = struct{}{}
+		}
+	}
+	for _, f := range pd.Fields {
+		if f.Validators != "" {
+			ip["gopkg.in/validator.v2"] = struct{}{}
+		}
+		if f.Validators != "" || len(f.Enum) > 0 || f.UniqueItems {
+			ip["fmt"] = struct{}{}
+		}
+		lib, err := goImportPath(globRootImportPath, f.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%v parameter %v of %v %v: %v", f.In, f.Param, pd.Verb, pd.Endpoint, err)
+		}
+		if lib != "" {
+			ip[lib] = struct{}{}
+		}
+	}
+	return ip, nil
+}
+
+// generate Go file of the parameters struct
+func (pd goParamsDef) generate(dir string) error {
+	fileName := filepath.Join(dir, pd.Name+".go")
+	if err := generateFile(pd, paramsGoTemplate, "params_go", fileName, true); err != nil {
+		return err
+	}
+	return runGoFmt(fileName)
+}
+
+// goParamName returns the Go field name of a parameter, e.g. `XRequestId` for `X-Request-Id`
+func goParamName(name string) string {
+	return strings.Replace(strings.Title(regNonAlphanum.ReplaceAllString(name, " ")), " ", "", -1)
+}
+
+// goLiteral returns the Go literal of an enum value
+func goLiteral(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// generate the helper which parses the parameters of the requests
+func generateParamsHelper(packageName, dir string) error {
+	var ctx = struct {
+		PackageName string
+	}{
+		PackageName: packageName,
+	}
+	fileName := filepath.Join(dir, paramsHelperFileResult)
+	if err := generateFile(ctx, paramsHelperGoTemplate, "params_helper_go", fileName, true); err != nil {
+		return err
+	}
+	return runGoFmt(fileName)
+}
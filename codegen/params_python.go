@@ -0,0 +1,83 @@
+package codegen
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
+	log "github.com/Sirupsen/logrus"
+)
+
+var (
+	pythonIdentifierRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// flask converters of the URI parameters, the default one is `string`
+	flaskConverters = map[string]string{
+		"integer": "int",
+		"number":  "float",
+	}
+)
+
+// create the python class which validates the query parameters of a method
+func newPythonQueryParamsClass(name string, params []paramDef) pythonClass {
+	pc := pythonClass{
+		Name:   name,
+		Fields: map[string]pythonField{},
+	}
+	for _, p := range params {
+		if p.In != paramInQuery {
+			continue
+		}
+		if !pythonIdentifierRe.MatchString(p.Name) {
+			log.Infof("validator has no support for query parameter named %v, ignore it", p.Name)
+			continue
+		}
+		rt := p.Type
+		if rt.Kind != raml.KindScalar || !pythonScalarTypes[rt.Builtin] {
+			log.Infof("validator has no support for query parameter %v of type %v, ignore it", p.Name, rt)
+			continue
+		}
+
+		field := pythonField{
+			Name:     p.Name,
+			Required: p.Required,
+		}
+		field.setType(rt.Builtin)
+		field.buildValidators(raml.Property{
+			Required:   p.Required,
+			Pattern:    rt.Facets.Pattern,
+			MinLength:  rt.Facets.MinLength,
+			MaxLength:  rt.Facets.MaxLength,
+			Minimum:    rt.Facets.Minimum,
+			Maximum:    rt.Facets.Maximum,
+			MultipleOf: rt.Facets.MultipleOf,
+		})
+		if len(rt.Enum) > 0 {
+			field.addValidator("AnyOf", "values", pythonValue(rt.Enum))
+			field.Validators = ""
+			field.buildValidatorsString()
+		}
+		if !p.Required { // an omitted parameter isn't validated
+			field.Validators = strings.TrimSuffix("Optional(), "+field.Validators, ", ")
+		}
+		pc.Fields[p.Name] = field
+	}
+	return pc
+}
+
+// flaskEndpoint returns the flask route of a method,
+// the URI parameters of type integer and number are converted by flask
+func flaskEndpoint(apiDef *raml.APIDefinition, r *raml.Resource, endpoint string) string {
+	tr := apiDef.TypeResolver()
+	for _, name := range getResourceParams(r) {
+		var converter string
+		rt, err := tr.ResolveParam(findURIParameter(r, name))
+		if err != nil {
+			log.Infof("uri parameter %v: %v, use a string", name, err)
+		} else if c, ok := flaskConverters[rt.Builtin]; ok && rt.Kind == raml.KindScalar {
+			converter = c + ":"
+		}
+		endpoint = strings.Replace(endpoint, "{"+name+"}", "<"+converter+name+">", -1)
+	}
+	return endpoint
+}
@@ -0,0 +1,75 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParams(t *testing.T) {
+	Convey("typed parameters", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		rootFixture := "./fixtures/params"
+		check := func(checks []struct{ Result, Expected string }) {
+			for _, check := range checks {
+				s, err := testLoadFile(filepath.Join(targetDir, check.Result))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join(rootFixture, check.Expected))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		}
+
+		Convey("Go server", func() {
+			err := GenerateServer("./fixtures/params/api.raml", targetDir, "main", "go", "", "examples.com/ramlcode", true)
+			So(err, ShouldBeNil)
+
+			check([]struct{ Result, Expected string }{
+				{"CarsGetParams.go", "go_server/CarsGetParams.txt"},
+				{"CarsCarIdDriversGetParams.go", "go_server/CarsCarIdDriversGetParams.txt"},
+				{"cars_api.go", "go_server/cars_api.txt"},
+				{"goraml/params.go", "go_server/goraml/params.txt"},
+			})
+		})
+
+		Convey("Go client", func() {
+			apiDef := new(raml.APIDefinition)
+			err := raml.ParseFile("./fixtures/params/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			err = GenerateClient(apiDef, targetDir, "theclient", langGo, "examples.com/client")
+			So(err, ShouldBeNil)
+
+			check([]struct{ Result, Expected string }{
+				{"CarsGetParams.go", "go_client/CarsGetParams.txt"},
+			})
+
+			// the URI parameters are arguments of the client methods
+			_, err = os.Stat(filepath.Join(targetDir, "CarsCarIdGetParams.go"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+		})
+
+		Convey("python server", func() {
+			err := GenerateServer("./fixtures/params/api.raml", targetDir, "main", "python", "", "", true)
+			So(err, ShouldBeNil)
+
+			check([]struct{ Result, Expected string }{
+				{"CarsGetParams.py", "python_server/CarsGetParams.py"},
+				{"CarsCarIdDriversGetParams.py", "python_server/CarsCarIdDriversGetParams.py"},
+				{"cars.py", "python_server/cars.py"},
+			})
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
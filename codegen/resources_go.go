@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -46,7 +47,7 @@ func (gr *goResource) generate(r *raml.Resource, URI, dir string) error {
 
 // InterfaceImportPaths returns all packages imported by
 // this resource interface file
-func (gr goResource) InterfaceImportPaths() []string {
+func (gr goResource) InterfaceImportPaths() ([]string, error) {
 	ip := map[string]struct{}{
 		"net/http":               struct{}{},
 		"github.com/gorilla/mux": struct{}{},
@@ -60,7 +61,11 @@ func (gr goResource) InterfaceImportPaths() []string {
 			ip["github.com/justinas/alice"] = struct{}{}
 		}
 		for _, sb := range gm.SecuredBy {
-			if lib := libImportPath(globRootImportPath, sb.Name); lib != "" {
+			lib, err := libImportPath(globRootImportPath, libTypeName(sb.Name))
+			if err != nil {
+				return nil, fmt.Errorf("security scheme of %v %v: %v", gm.Verb(), gm.Endpoint, err)
+			}
+			if lib != "" {
 				ip[lib] = struct{}{}
 			}
 		}
@@ -68,12 +73,12 @@ func (gr goResource) InterfaceImportPaths() []string {
 
 	// return sorted array for predictable order
 	// we need it for unit test to always return same order
-	return sortImportPaths(ip)
+	return sortImportPaths(ip), nil
 }
 
 // APIImportPaths returns all packages that need to be imported
 // by the API implementation
-func (gr goResource) APILibImportPaths() []string {
+func (gr goResource) APILibImportPaths() ([]string, error) {
 	ip := map[string]struct{}{
 		"net/http": struct{}{},
 	}
@@ -81,17 +86,38 @@ func (gr goResource) APILibImportPaths() []string {
 	// methods
 	for _, v := range gr.Methods {
 		gm := v.(goServerMethod)
-		if gm.RespBody != "" || gm.ReqBody != "" {
+		if gm.ParamsStruct != "" || gm.ReqBody != "" { // the errors are JSON encoded
 			ip["encoding/json"] = struct{}{}
 		}
-		for lib := range gm.libImported(globRootImportPath) {
+		if gm.ReqBody != "" {
+			switch gm.ReqBodyEncoding() {
+			case encodingXML:
+				ip["encoding/xml"] = struct{}{}
+			case encodingForm:
+				lib, err := libImportPath(globRootImportPath, "goraml.DecodeForm")
+				if err != nil {
+					return nil, err
+				}
+				ip[lib] = struct{}{}
+			default:
+				ip["encoding/json"] = struct{}{}
+			}
+		}
+		if gm.RespBody != "" {
+			ip["encoding/"+gm.RespBodyEncoding()] = struct{}{}
+		}
+		libs, err := gm.libImported(globRootImportPath)
+		if err != nil {
+			return nil, err
+		}
+		for lib := range libs {
 			ip[lib] = struct{}{}
 		}
 	}
 
 	// return sorted array for predictable order
 	// we need it for unit test to always return same order
-	return sortImportPaths(ip)
+	return sortImportPaths(ip), nil
 }
 
 func sortImportPaths(ip map[string]struct{}) []string {
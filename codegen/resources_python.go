@@ -44,11 +44,15 @@ func (pr *pythonResource) generate(r *raml.Resource, URI, dir string) error {
 	return generateFile(pr, resourcePyTemplate, "resource_python_template", filename, true)
 }
 
-// return array of request body in this resource
+// return array of the classes which validate the request bodies
+// and the query parameters in this resource
 func (pr pythonResource) ReqBodies() []string {
 	var reqs []string
 	for _, m := range pr.Methods {
 		pm := m.(pythonServerMethod)
+		if pm.ParamsStruct != "" {
+			reqs = append(reqs, pm.ParamsStruct)
+		}
 		if pm.ReqBody != "" {
 			reqs = append(reqs, pm.ReqBody)
 		}
@@ -0,0 +1,53 @@
+package codegen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestSchemaTypes(t *testing.T) {
+	Convey("types from JSON and XML schemas", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		names := []string{"Person", "PersonAddress", "PersonCountry", "PersonPhone", "Order", "OrderItemType"}
+
+		Convey("Go server", func() {
+			err := GenerateServer("./fixtures/schemas/schemas.raml", targetDir, "main", "go", "", "examples.com/schemas", true)
+			So(err, ShouldBeNil)
+
+			for _, name := range names {
+				s, err := testLoadFile(filepath.Join(targetDir, name+".go"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/schemas", name+".txt"))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("Python server", func() {
+			err := GenerateServer("./fixtures/schemas/schemas.raml", targetDir, "main", "python", "", "examples.com/schemas", true)
+			So(err, ShouldBeNil)
+
+			for _, name := range names {
+				s, err := testLoadFile(filepath.Join(targetDir, name+".py"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/schemas", name+".py"))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -90,7 +90,7 @@ func getOauth2MwrHandler(ss raml.DefinitionChoice) (string, error) {
 	// middleware name
 	// need to handle case where it reside in different package
 	var packageName string
-	name := ss.Name
+	name := libTypeName(ss.Name)
 
 	if splitted := strings.Split(name, "."); len(splitted) == 2 {
 		packageName = splitted[0]
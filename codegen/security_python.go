@@ -31,7 +31,10 @@ func newPythonOauth2Middleware(ss raml.DefinitionChoice) (pythonMiddleware, erro
 		return pythonMiddleware{}, err
 	}
 
-	importPath, name := pythonOauth2libImportPath(ss.Name)
+	importPath, name, err := pythonOauth2libImportPath(ss.Name)
+	if err != nil {
+		return pythonMiddleware{}, err
+	}
 	return pythonMiddleware{
 		ImportPath: importPath,
 		Name:       name,
@@ -40,6 +43,6 @@ func newPythonOauth2Middleware(ss raml.DefinitionChoice) (pythonMiddleware, erro
 }
 
 // get library import path from a type
-func pythonOauth2libImportPath(typ string) (string, string) {
-	return pythonLibImportPath(securitySchemeName(typ), "oauth2_")
+func pythonOauth2libImportPath(typ string) (string, string, error) {
+	return pythonLibImportPath(securitySchemeName(libTypeName(typ)), "oauth2_")
 }
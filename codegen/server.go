@@ -56,8 +56,13 @@ func (gs goServer) generate(dir string) error {
 		return err
 	}
 
+	// parser of the request parameters
+	if err := generateParamsHelper(gh.packageName, filepath.Join(dir, gh.packageDir)); err != nil {
+		return err
+	}
+
 	// generate all Type structs
-	if err := generateStructs(gs.apiDef.Types, dir, gs.PackageName, langGo); err != nil {
+	if err := generateStructs(gs.apiDef.Types, gs.apiDef.Libraries, dir, gs.PackageName, langGo); err != nil {
 		return err
 	}
 
@@ -66,6 +71,11 @@ func (gs goServer) generate(dir string) error {
 		return err
 	}
 
+	// generate the parameters of the methods
+	if err := generateParamsStructs(gs.apiDef, dir, gs.PackageName, langGo, true); err != nil {
+		return err
+	}
+
 	// security scheme
 	if err := generateSecurity(gs.apiDef.SecuritySchemes, dir, gs.PackageName, langGo); err != nil {
 		log.Errorf("failed to generate security scheme:%v", err)
@@ -100,8 +110,7 @@ func (gs goServer) generate(dir string) error {
 // generate all python server files
 func (ps pythonServer) generate(dir string) error {
 	// generate input validators helper
-	if err := generateFile(struct{}{}, "./templates/input_validators_python.tmpl", "input_validators_python",
-		filepath.Join(dir, "input_validators.py"), false); err != nil {
+	if err := generateInputValidators(ps.apiDef.Types, ps.apiDef.Libraries, dir); err != nil {
 		return err
 	}
 
@@ -111,8 +120,14 @@ func (ps pythonServer) generate(dir string) error {
 		return err
 	}
 
+	// generate the query parameters validators
+	if err := generateParamsStructs(ps.apiDef, dir, "", langPython, true); err != nil {
+		log.Errorf("failed to generate python classes from query parameters:%v", err)
+		return err
+	}
+
 	// python classes
-	if err := generatePythonClasses(ps.apiDef.Types, dir); err != nil {
+	if err := generatePythonClasses(ps.apiDef.Types, ps.apiDef.Libraries, dir); err != nil {
 		log.Errorf("failed to generate python clased:%v", err)
 		return err
 	}
@@ -157,7 +172,7 @@ func (ps pythonServer) generate(dir string) error {
 func GenerateServer(ramlFile, dir, packageName, lang, apiDocsDir, rootImportPath string, generateMain bool) error {
 	apiDef := new(raml.APIDefinition)
 	// parse the raml file
-	ramlBytes, err := raml.ParseReadFile(ramlFile, apiDef)
+	err := raml.ParseFile(ramlFile, apiDef)
 	if err != nil {
 		return err
 	}
@@ -203,5 +218,9 @@ func GenerateServer(ramlFile, dir, packageName, lang, apiDocsDir, rootImportPath
 
 	log.Infof("Generating API Docs to %v endpoint", sd.APIDocsDir)
 
+	ramlBytes, err := apiDef.Bundle()
+	if err != nil {
+		return err
+	}
 	return apidocs.Generate(ramlBytes, filepath.Join(dir, sd.APIDocsDir))
 }
@@ -96,6 +96,20 @@ func TestServer(t *testing.T) {
 
 		})
 
+		Convey("API docs of a specification using libraries", func() {
+			err := GenerateServer("./fixtures/libraries/api.raml", targetdir, "main", "python", "apidocs", "", true)
+			So(err, ShouldBeNil)
+
+			// the libraries are inlined in the API docs specification
+			b, err := ioutil.ReadFile(filepath.Join(targetdir, "apidocs", "api.raml"))
+			So(err, ShouldBeNil)
+			s := string(b)
+			So(s, ShouldStartWith, "#%RAML 1.0\ntitle: Example API\n")
+			So(s, ShouldNotContainSubstring, "uses:")
+			So(s, ShouldNotContainSubstring, "files.")
+			So(s, ShouldContainSubstring, "      dir: Directory\n")
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetdir)
 		})
@@ -21,18 +21,20 @@ type fieldDef struct {
 	Type          string // field type
 	IsComposition bool   // composition type
 	IsOmitted     bool   // omitted empty
+	IsNullable    bool   // null is a valid value, the validators only check the other values
 	UniqueItems   bool
 
-	Validators string
+	Validators  string
+	Annotations raml.Annotations // annotations applied to the property
 }
 
 func (fd *fieldDef) buildValidators(p raml.Property) {
 	validators := ""
-	// string
-	if p.MinLength != nil {
+	// string, the length of a file is its size which isn't validated
+	if p.MinLength != nil && !isFileExpr(p.Type) {
 		validators += fmt.Sprintf(",min=%v", *p.MinLength)
 	}
-	if p.MaxLength != nil {
+	if p.MaxLength != nil && !isFileExpr(p.Type) {
 		validators += fmt.Sprintf(",max=%v", *p.MaxLength)
 	}
 	if p.Pattern != nil {
@@ -67,13 +69,11 @@ func (fd *fieldDef) buildValidators(p raml.Property) {
 	}
 
 	// Required
-	if !fd.IsOmitted {
+	if !fd.IsOmitted && !fd.IsNullable {
 		validators += ",nonzero"
 	}
 
-	if validators != "" {
-		fd.Validators = validators[1:]
-	}
+	fd.Validators = strings.TrimPrefix(validators, ",")
 }
 
 // StructDef defines a struct
@@ -84,6 +84,21 @@ type structDef struct {
 	PackageName string              // package name
 	Fields      map[string]fieldDef // all struct's fields
 	OneLineDef  string              // not empty if this struct can be defined in one line
+	Facets      raml.Facets         // facets of the type, including the inherited ones
+
+	// validator.v2 tags of the user-defined facets values of the type
+	FacetValidators string
+
+	// validator.v2 tags of the facets of a nullable scalar type
+	ScalarValidators string
+
+	oneLineType string // type of the one line definition
+
+	// not nil if this struct declares a discriminator
+	Discriminator *discriminatorDef
+
+	// true if this struct is an XML body, its fields have xml tags
+	IsXML bool
 
 	Validators []string
 }
@@ -100,9 +115,11 @@ func newStructDef(name, packageName, description string, properties map[string]i
 	for k, v := range properties {
 		prop := raml.ToProperty(k, v)
 		fd := fieldDef{
-			Name:      strings.Title(prop.Name),
-			Type:      convertToGoType(prop.Type),
-			IsOmitted: !prop.Required,
+			Name:        strings.Title(prop.Name),
+			Type:        convertToGoType(prop.Type),
+			IsOmitted:   !prop.Required,
+			IsNullable:  isNullableExpr(prop.Type),
+			Annotations: prop.Annotations,
 		}
 
 		fd.buildValidators(prop)
@@ -116,26 +133,78 @@ func newStructDef(name, packageName, description string, properties map[string]i
 	}
 }
 
-// create struct definition from RAML Type node
-func newStructDefFromType(t raml.Type, sName, packageName, lang string) structDef {
+// create struct definition from a resolved RAML type
+func newStructDefFromType(rt *raml.ResolvedType, sName, packageName string) structDef {
+	t := *rt.Decl
 	sd := newStructDef(sName, packageName, t.Description, t.Properties)
 	sd.T = t
+	sd.Facets = rt.Facets
+	sd.FacetValidators = facetValidators(rt.FacetValues)
+
+	// the field types are taken from the resolved properties
+	for name, prop := range rt.Properties {
+		if fd, ok := sd.Fields[name]; ok {
+			fd.Type = goType(prop.Type)
+			if prop.Type.IsNullable() {
+				fd.Type = nullableGoType(fd.Type)
+				if !fd.IsNullable { // a declared nullable type, validated when it isn't nil
+					fd.IsNullable = true
+					fd.buildValidators(facetsProperty(prop.Type.Facets))
+				}
+			}
+			if fd.Type == sName { // a struct can't contain itself, e.g. a recursive JSON schema
+				fd.Type = "*" + fd.Type
+			}
+			fd.Validators = joinValidators(fd.Validators, facetValidators(prop.Type.FacetValues))
+			sd.Fields[name] = fd
+		}
+	}
 
-	// handle advanced type on raml1.0
-	sd.handleAdvancedType()
+	sd.buildFromResolvedType(rt)
 
+	// the facets of a nullable scalar type, the nullable type `string?` is a `string`
+	if nonNil, ok := rt.NonNil(); ok && nonNil.Kind == raml.KindScalar {
+		fd := fieldDef{IsOmitted: true}
+		fd.buildValidators(facetsProperty(rt.Facets))
+		sd.ScalarValidators = fd.Validators
+	}
 	return sd
 }
 
+// facetsProperty returns a property which has the facets of a resolved type
+func facetsProperty(f raml.Facets) raml.Property {
+	return raml.Property{
+		Pattern:     f.Pattern,
+		MinLength:   f.MinLength,
+		MaxLength:   f.MaxLength,
+		Minimum:     f.Minimum,
+		Maximum:     f.Maximum,
+		MultipleOf:  f.MultipleOf,
+		MinItems:    f.MinItems,
+		MaxItems:    f.MaxItems,
+		UniqueItems: f.UniqueItems != nil && *f.UniqueItems,
+	}
+}
+
+// Value returns the expression of the value of a field in the Validate method
+func (fd fieldDef) Value() string {
+	if strings.HasPrefix(fd.Type, "*") {
+		return "*s." + fd.Name
+	}
+	return "s." + fd.Name
+}
+
 // create struct definition from RAML Body node
-func newStructDefFromBody(body *raml.Bodies, structNamePrefix, packageName string, isGenerateRequest bool) structDef {
+func newStructDefFromBody(body raml.Body, mediaType, structNamePrefix, packageName string, isGenerateRequest bool) structDef {
 	// set struct name based on request or response
 	structName := structNamePrefix + respBodySuffix
 	if isGenerateRequest {
 		structName = structNamePrefix + reqBodySuffix
 	}
 
-	return newStructDef(structName, packageName, "", body.ApplicationJSON.Properties)
+	sd := newStructDef(structName, packageName, "", body.Properties)
+	sd.IsXML = bodyEncoding(mediaType) == encodingXML
+	return sd
 }
 
 // generate Go struct
@@ -148,38 +217,61 @@ func (sd structDef) generate(dir string) error {
 }
 
 // generate all structs from an RAML api definition
-func generateStructs(types map[string]raml.Type, dir, packageName, lang string) error {
-	for name, t := range types {
-		sd := newStructDefFromType(t, name, packageName, lang)
+func generateStructs(types map[string]raml.Type, libraries map[string]*raml.Library, dir, packageName, lang string) error {
+	resolved, err := raml.NewTypeResolver(types, libraries).ResolveAll()
+	if err != nil {
+		return err
+	}
+	for name, rt := range resolved {
+		sd := newStructDefFromType(rt, name, packageName)
+		sd.Discriminator = newDiscriminatorDef(rt, resolved)
 		if err := sd.generate(dir); err != nil {
 			return err
 		}
 	}
-	return nil
+	return generateUserFacets(types, dir, packageName)
 }
 
 // ImportPaths returns all packages that
 // need to be imported by this struct
-func (sd structDef) ImportPaths() map[string]struct{} {
+func (sd structDef) ImportPaths() (map[string]struct{}, error) {
 	ip := map[string]struct{}{}
 
-	if sd.needFmt() {
+	if sd.needFmt() || sd.Discriminator != nil {
 		ip["fmt"] = struct{}{}
 	}
-	if sd.OneLineDef == "" {
+	if sd.Discriminator != nil {
+		ip["encoding/json"] = struct{}{}
+	}
+	if sd.OneLineDef == "" || sd.FacetValidators != "" || sd.ScalarValidators != "" {
 		ip["gopkg.in/validator.v2"] = struct{}{}
 	}
 
 	// libraries
+	if sd.oneLineType != "" {
+		lib, err := goImportPath(globRootImportPath, sd.oneLineType)
+		if err != nil {
+			return nil, fmt.Errorf("%v: %v", sd.Name, err)
+		}
+		if lib != "" {
+			ip[lib] = struct{}{}
+		}
+	}
 	for _, fd := range sd.Fields {
-		if lib := libImportPath(globRootImportPath, fd.Type); lib != "" {
+		lib, err := goImportPath(globRootImportPath, fd.Type)
+		if err != nil {
+			return nil, fmt.Errorf("field %v of %v: %v", fd.Name, sd.Name, err)
+		}
+		if lib != "" {
 			ip[lib] = struct{}{}
 		}
 	}
-	return ip
+	return ip, nil
 }
 
-// handle advance type type into structField
+// build the struct from the resolved type,
+// the inheritance is implemented as composition
+// spec : http://docs.raml.org/specs/1.0/#raml-10-spec-inheritance-and-specialization
 // example:
 //   Mammal:
 //     type: Animal
@@ -187,93 +279,49 @@ func (sd structDef) ImportPaths() map[string]struct{} {
 //       name:
 //         type: string
 // the additional fieldDef would be Animal composition
-func (sd *structDef) handleAdvancedType() {
-	if sd.T.Type == nil {
-		sd.T.Type = "object"
-	}
-
-	strType := interfaceToString(sd.T.Type)
-
-	switch {
-	case len(strings.Split(strType, ",")) > 1: //multiple inheritance
-		sd.addMultipleInheritance(strType)
-	case sd.T.IsUnion():
-		sd.buildUnion()
-	case sd.T.IsArray(): // arary type
-		sd.buildArray()
-	case strings.ToLower(strType) == "object": // plain type
-		return
-	case sd.T.IsEnum(): // enum
-		sd.buildEnum()
-	case len(sd.T.Properties) == 0: // specialization
-		sd.buildSpecialization()
-	default: // single inheritance
-		sd.addSingleInheritance(strType)
-	}
-}
-
-// add single inheritance
-// inheritance is implemented as composition
-// spec : http://docs.raml.org/specs/1.0/#raml-10-spec-inheritance-and-specialization
-func (sd *structDef) addSingleInheritance(strType string) {
-	fd := fieldDef{
-		Name:          strType,
-		IsComposition: true,
-	}
-	sd.Fields[strType] = fd
-
-}
-
-// construct multiple inheritance to Go type
-// example :
-// Anggora:
-//	 type: [ Animal , Cat ]
-//	 properties:
-//		color:
-//			type: string
-// The additional fielddef would be a composition of Animal & Cat
-// http://docs.raml.org/specs/1.0/#raml-10-spec-multiple-inheritance
-func (sd *structDef) addMultipleInheritance(strType string) {
-	for _, s := range strings.Split(strType, ",") {
-		fieldType := strings.TrimSpace(s)
-		fd := fieldDef{
-			Name:          fieldType,
-			IsComposition: true,
+func (sd *structDef) buildFromResolvedType(rt *raml.ResolvedType) {
+	switch rt.Kind {
+	case raml.KindObject:
+		switch {
+		case len(rt.Parents) == 0: // plain type
+			return
+		case len(rt.Parents) == 1 && len(rt.Properties) == 0 && rt.DiscriminatorRoot() == nil: // specialization
+			sd.buildOneLine(goTypeName(rt.Parents[0]))
+		default: // single & multiple inheritance
+			for _, parent := range rt.Parents {
+				sd.addComposition(goTypeName(parent))
+			}
+		}
+	case raml.KindArray: // array type, example result `type TypeName []something`
+		sd.buildOneLine("[]" + goType(rt.Items))
+	case raml.KindUnion:
+		// a nullable type is its non nil type, the fields of this type are nullable
+		if nonNil, ok := rt.NonNil(); ok {
+			sd.buildOneLine(strings.TrimPrefix(goType(nonNil), "*"))
+		} else {
+			sd.buildOneLine("interface{}")
+		}
+	case raml.KindAny, raml.KindNil: // implemented as `interface{}`
+		sd.buildOneLine("interface{}")
+	default: // enum & specialization of scalar type
+		if len(rt.Parents) == 1 {
+			sd.buildOneLine(goTypeName(rt.Parents[0]))
+		} else {
+			sd.buildOneLine(convertToGoType(rt.Builtin))
 		}
-
-		sd.Fields[fd.Name] = fd
 	}
 }
 
-// buildEnum based on http://docs.raml.org/specs/1.0/#raml-10-spec-enums
-// example result  `type TypeName []data_type`
-func (sd *structDef) buildEnum() {
-	if _, ok := sd.T.Type.(string); !ok {
-		return
+// add composition field of an inherited type
+func (sd *structDef) addComposition(name string) {
+	sd.Fields[name] = fieldDef{
+		Name:          name,
+		IsComposition: true,
 	}
-
-	sd.buildOneLine(convertToGoType(sd.T.Type.(string)))
-}
-
-// build array type
-// spec http://docs.raml.org/specs/1.0/#raml-10-spec-array-types
-// example result  `type TypeName []something`
-func (sd *structDef) buildArray() {
-	sd.buildOneLine(convertToGoType(sd.T.Type.(string)))
-}
-
-// build union type
-// union type is implemented as `interface{}`
-// example result `type sometype interface{}`
-func (sd *structDef) buildUnion() {
-	sd.buildOneLine(convertUnion(sd.T.Type.(string)))
-}
-
-func (sd *structDef) buildSpecialization() {
-	sd.buildOneLine(convertToGoType(sd.T.Type.(string)))
 }
 
 func (sd *structDef) buildOneLine(tipe string) {
+	sd.oneLineType = tipe
 	sd.OneLineDef = "type " + sd.Name + " " + tipe
 }
 
@@ -296,13 +344,13 @@ func generateInputValidator(packageName, dir string) error {
 // because validation error will need `fmt` to build error message
 func (sd structDef) needFmt() bool {
 	// array type min items and max items
-	if sd.T.MinItems > 0 || sd.T.MaxItems > 0 {
+	if sd.Facets.MinItems != nil || sd.Facets.MaxItems != nil || sd.Facets.UniqueItems != nil {
 		return true
 	}
 
-	// unique items
+	// unique items and validators of the nullable fields
 	for _, f := range sd.Fields {
-		if f.UniqueItems {
+		if f.UniqueItems || (f.IsNullable && f.Validators != "") {
 			return true
 		}
 	}
@@ -19,7 +19,7 @@ func TestGenerateStructFromRaml(t *testing.T) {
 		So(err, ShouldBeNil)
 
 		Convey("Simple struct from raml", func() {
-			err = generateStructs(apiDef.Types, targetdir, "main", langGo)
+			err = generateStructs(apiDef.Types, apiDef.Libraries, targetdir, "main", langGo)
 			So(err, ShouldBeNil)
 
 			//first test
@@ -132,6 +132,56 @@ func TestGenerateStructFromRaml(t *testing.T) {
 
 		})
 
+		Convey("annotations of the properties", func() {
+			rt, err := apiDef.TypeResolver().Resolve("ValidationString")
+			So(err, ShouldBeNil)
+
+			sd := newStructDefFromType(rt, "ValidationString", "main")
+			So(sd.Fields["name"].Annotations.Value("label"), ShouldEqual, "user name")
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetdir)
+		})
+	})
+}
+
+func TestNullableTypes(t *testing.T) {
+	Convey("nullable types", t, func() {
+		apiDef := new(raml.APIDefinition)
+		err := raml.ParseFile("./fixtures/nullable/api.raml", apiDef)
+		So(err, ShouldBeNil)
+		targetdir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("Go structs", func() {
+			err = generateStructs(apiDef.Types, apiDef.Libraries, targetdir, "main", langGo)
+			So(err, ShouldBeNil)
+
+			for _, name := range []string{"Person", "Comment"} {
+				s, err := testLoadFile(filepath.Join(targetdir, name+".go"))
+				So(err, ShouldBeNil)
+
+				tmpl, err := testLoadFile(filepath.Join("./fixtures/nullable", name+".txt"))
+				So(err, ShouldBeNil)
+
+				So(s, ShouldEqual, tmpl)
+			}
+		})
+
+		Convey("python classes", func() {
+			err = generatePythonClasses(apiDef.Types, apiDef.Libraries, targetdir)
+			So(err, ShouldBeNil)
+
+			s, err := testLoadFile(filepath.Join(targetdir, "Person.py"))
+			So(err, ShouldBeNil)
+
+			tmpl, err := testLoadFile("./fixtures/nullable/Person.py")
+			So(err, ShouldBeNil)
+
+			So(s, ShouldEqual, tmpl)
+		})
+
 		Reset(func() {
 			os.RemoveAll(targetdir)
 		})
@@ -13,6 +13,8 @@
 // codegen/templates/input_validators_python.tmpl
 // codegen/templates/oauth2_middleware.tmpl
 // codegen/templates/oauth2_middleware_python.tmpl
+// codegen/templates/params_go.tmpl
+// codegen/templates/params_helper_go.tmpl
 // codegen/templates/python_server_resource.tmpl
 // codegen/templates/requirements_python.tmpl
 // codegen/templates/server_main_go.tmpl
@@ -21,6 +23,9 @@
 // codegen/templates/server_resources_interface.tmpl
 // codegen/templates/struct.tmpl
 // codegen/templates/struct_input_validator.tmpl
+// codegen/templates/user_facets_go.tmpl
+// codegen/templates/user_facets_python.tmpl
+// codegen/templates/user_facets_registration_go.tmpl
 // DO NOT EDIT!
 
 package templates
@@ -108,7 +113,7 @@ func templatesBindataGo() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClass_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x64\x90\xcd\x6a\xc3\x30\x10\x84\xef\x7e\x8a\x25\xe4\xd0\x82\x93\x07\x08\xe4\x52\x8a\x21\x10\x72\x30\x86\x1e\x8d\x5a\xaf\x1d\x11\xfd\x55\x92\xdd\x18\xe1\x77\xef\x4a\xfe\x81\x52\x5f\x34\x5a\x7d\x9a\x19\x2b\x84\x06\x5b\xae\x10\x76\x5f\x82\x39\x57\x9b\xd1\xdf\xb5\xda\x4d\x53\xd6\x5a\x2d\xa1\xa5\xe1\xa3\xfe\xf1\x2d\x70\x69\xb4\xf5\x50\x68\x2b\xe7\x23\x1a\x92\x76\xc7\x81\x09\xde\x30\xaf\xad\x5b\x99\x77\xe6\x59\x89\xdf\x3d\xb7\xd8\xe4\x70\x45\xd5\xf9\x7b\x0e\x25\x76\xf8\x34\x39\xdc\x7a\xf9\x89\xb6\x64\xaa\xc3\x1c\xec\x82\xfd\xb1\x5c\x7d\x2a\x7c\xfa\x82\xa3\x20\x93\x18\xbb\xc8\x8b\xf2\xe4\x64\xd7\x03\xa1\xd9\x06\x71\x81\x8b\x7c\xd3\x5a\x20\x53\xcb\x8e\x0a\xe1\xc6\xd0\x72\xe5\xce\xcf\x89\x5c\x99\xde\xd7\xff\x7f\x41\xf6\xc2\x73\x23\xb0\xd6\x6d\x96\x85\x60\x63\x5b\xd8\x3f\x72\xd8\x0f\x70\x3a\xc3\xf1\x92\x30\x07\x07\x7a\xa8\x10\xf6\x43\x5a\x00\x55\x43\x22\x4b\x2f\x09\x21\x1c\x6f\x4c\xe2\x34\xbd\xc4\xf2\xaf\xa7\x0c\xe8\x23\x68\xf5\xc2\x31\xba\x31\x91\xfc\x52\x2d\x47\x97\x67\x28\xce\x97\xdb\x70\x5e\xf7\x1f\x55\x51\x8d\x06\x37\xe8\x10\xf3\x20\x25\xcf\xc1\xbf\x01\x00\x00\xff\xff\xef\xd0\x1b\xa6\xcc\x01\x00\x00")
+var _templatesClass_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x9c\x55\x51\x4f\xdb\x30\x10\x7e\xef\xaf\x38\x55\x95\x9a\x40\xc8\x0f\x40\xaa\x34\x31\x84\x84\x84\xd0\xc4\x10\x7b\x2c\x26\xb9\xb4\x1e\xa9\x9d\xd9\x0e\x50\x45\xf9\xef\x3b\x3b\xb6\xdb\x40\xe9\xd0\xf2\xd0\xda\xbe\xbb\xef\x3e\xdf\x77\xb6\xbb\xae\xc4\x8a\x0b\x84\x69\x51\x33\xad\x97\xcd\xd6\xac\xa5\x98\xf6\xfd\xa4\x52\x72\x03\x15\x2d\x3e\x2f\x5f\x4d\x05\x7c\xd3\x48\x65\xe0\x4a\xaa\xcd\x60\xa2\x45\x1a\xeb\xfc\x85\xd5\xbc\x64\x46\x2a\x1d\x7c\x2e\x99\x61\x77\xf8\xa7\xe5\x0a\xcb\x0c\x6e\x50\xac\xcc\x3a\x83\x3b\x5c\xe1\x5b\x93\xc1\x6d\xbb\x79\x42\x75\xc7\xc4\x0a\x33\x50\xde\xad\xeb\x94\x5d\x80\xfc\x21\xa0\x5d\x3b\x2c\xdd\xf7\x19\x74\x5d\xde\xf7\x5d\x87\xa2\x0c\xb4\x7c\xee\x90\xf0\x1e\xdf\xcc\x15\xc7\x9a\xb2\x59\x7e\x7e\x78\x2d\x0c\xa5\x54\xc1\x50\x4b\x16\x9d\x78\x8d\x7e\x78\x21\x65\x8d\x4c\xf8\x19\x31\xc7\xe8\x43\x7f\x37\x5c\x9b\x48\xcd\xad\x1c\xa5\xc5\x45\xd3\x9a\xe5\xc7\x82\x6c\xda\xda\xf0\xa6\xc6\xa5\xac\x76\x68\xac\x40\xf3\x19\xda\x24\xb8\xcd\x9e\x33\x98\xbd\xc0\xf9\x02\x72\xef\x0b\x67\x64\xef\xba\xd9\x8b\xfb\x03\xef\xef\xc4\xb3\x18\xb7\x6c\x83\x7d\x9f\xd0\xe8\x82\x69\xfc\x6e\x97\x91\xf0\xd3\xf3\x09\xd0\x47\x01\x01\x17\xb7\x16\x99\xd5\x0e\xdb\x6d\x8d\xdc\xbc\x93\x5d\xf7\x48\xb0\x08\xf3\x5f\xf7\x57\xf7\xdb\x06\xa3\xd3\x99\xcd\x0d\x7b\x53\x5e\x01\xa3\x95\x44\x48\x13\x10\x53\x3f\xbb\xe4\xba\x50\x7c\xc3\x85\x2d\x4b\x1a\x82\x1a\x22\x77\x04\x6c\x1c\xb5\x6f\x9c\x09\xe2\xe6\x88\x5b\x92\xd6\xe2\x4c\xdf\x5c\x15\x36\x48\x1d\x5c\xba\x05\x6a\x6d\xd0\xed\x93\x21\xda\x49\x51\xeb\x0c\x68\x1f\x2d\xfa\x5a\xd8\x6f\x3a\x9d\xc6\xb1\x42\xd3\x2a\x61\x8b\xe8\xe0\x69\xeb\x94\x94\x53\xc1\x3d\x02\xbc\xae\xa5\x46\x78\xa4\xd2\x8e\x88\xe5\x3f\x94\x6c\x50\x99\x6d\xdf\x3f\x42\xe3\xc7\xc0\xf5\x90\xec\x60\x2a\xbb\x07\xdf\x06\x63\xa8\x9f\x43\x2a\x1d\x36\xbb\x57\x0d\x3a\xa2\xc3\x6e\x87\xcd\xef\x39\x90\xd1\xa5\x82\xc5\x02\xe6\xc4\xee\xc1\x4e\xfa\x7e\xbe\xdb\xa6\xfd\x5c\x83\xc6\x06\x09\x9d\x19\x17\x46\xbe\x43\x29\x0e\x18\xdf\x09\xf5\xc9\x52\x0c\xf7\x85\x3c\xa2\x8e\x65\xb5\xfc\xad\xa5\x18\xf4\xa1\x63\xc3\x32\x38\x61\x6a\x45\x93\x93\x93\xe7\x57\x3b\xfa\x44\xaf\x42\x21\x9d\xd6\xb1\x5c\x92\xaa\x24\xab\x7d\xd5\x74\x16\x03\xcc\x1a\xc1\x09\x49\xda\xf0\x12\x85\xe1\x15\xc7\x12\x9e\xb6\xce\x32\x94\x90\x82\xbf\x26\xf0\x41\x4a\xa4\x04\x6d\xc3\xe2\xef\x58\xd9\x23\xc1\x35\x17\xda\x30\x51\x60\x32\xec\xb0\xe4\x85\x49\xc7\xfa\x84\x2e\x5b\xec\x62\xf3\xd0\xbb\x36\x28\x5f\xa1\x49\xe6\x47\xb8\xcd\xd3\x74\x04\xc8\x63\xef\x5b\x42\xf6\x18\x46\xe0\x71\xe6\x3d\xc9\x7c\x40\xbe\x93\xe5\xb0\x22\x93\x0f\x71\x44\x22\x89\xf8\x99\x2d\x43\xfa\x6f\x94\xdd\xbd\xe5\x03\xe3\xed\x9d\xc4\x91\x2f\x53\x28\xb3\xbd\xf7\xa1\xb2\x06\xab\xd5\xd7\xc4\xb7\xf2\xba\x38\xaa\x43\x61\x0f\xb1\x00\x56\x14\x52\x95\x5c\xac\xc0\xc8\xff\x96\xdf\x72\x8a\x9d\x4c\x96\x02\xb5\x4e\x34\xd6\x55\xe6\xd2\x79\xa9\xe9\x77\xd1\x0a\x8d\xee\x61\x18\xdd\x3e\xfe\xd4\xc2\x2d\x31\xdf\xef\xa1\x10\x3c\x96\x29\x78\x07\xab\x6b\x08\x9b\x2d\x77\x17\xc2\x29\xb8\xb1\xc6\x86\x29\x77\x5f\x9e\xc2\xf1\x6e\x89\xe0\x58\x53\xce\xaf\xb4\x68\x60\xf0\xc5\x76\x8c\xb1\x8e\x98\xa5\xbd\x1c\xe4\x3e\xd4\xe2\x43\x6d\x76\x21\xe3\x86\xda\x7b\xd5\x2d\x58\x9a\x87\x6a\x8f\xeb\x9c\xbe\x7f\x4d\xc2\x7b\xfa\x17\x00\x00\xff\xff\x03\x00\xd9\x73\xaa\xa0\xe6\x08\x00\x00")
 
 func templatesClass_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -128,7 +133,7 @@ func templatesClass_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x55\x41\x6f\x9b\x4a\x10\x3e\xc3\xaf\x98\x87\xfc\x22\xc8\x23\xf8\xfe\x24\x1f\x5e\x12\xbf\x36\x92\x1b\xa5\x4e\xd2\x1e\x2b\x0c\x43\x4c\x62\xb3\x78\x59\x6c\xb9\x94\xff\xde\x99\x5d\xc0\xd8\x4e\x22\x35\x52\xd4\x4b\x7d\x62\x67\x66\xe7\xfb\xe6\x9b\x99\x75\x55\x9d\x41\x8c\x49\x9a\x21\x38\xd1\x22\xc5\x4c\x7d\x7b\x10\x0e\x9c\xd5\xb5\x9d\x87\xd1\x53\xf8\x80\x50\x55\xc1\x8d\xf9\xbc\x0e\x97\x48\x0e\x3b\x5d\xe6\x42\x2a\x70\x6d\xcb\xc9\x50\x0d\xe7\x4a\xe5\x0e\x7d\x63\x16\x89\x38\xcd\x1e\x86\x8f\x85\xc8\xd8\x90\x2c\x95\x63\xdb\x40\xbf\xaa\x02\x19\x66\x94\x6c\xf0\xe4\xc3\x60\x0d\xff\x8e\x20\x98\xa4\xb3\x2b\x9d\xe8\x26\x54\xf3\x42\x43\x72\xa8\x53\x55\x83\xa7\xba\x76\x9a\x7b\x98\xc5\xda\xe5\xd9\x76\x24\xb2\x42\xa3\x4a\x21\xd4\xfd\x74\x02\x23\x0e\x0e\xce\xc3\x02\xef\xa7\x57\x7c\x85\x82\xe8\x76\x98\xa7\xcc\x54\x83\x34\x94\xd5\x36\xd7\x85\x98\x23\x14\x4a\x96\x91\x82\xca\xb6\x4c\xcd\xc0\x25\x04\x17\xfa\x5b\xe3\xfe\x57\xaa\xf9\x47\x0c\x63\x94\x1c\x4b\x35\xc1\x70\xa8\x8d\x42\xa6\xdf\x43\x95\x8a\x0c\xe6\xda\xed\xc3\x26\x5d\x2c\x60\x86\x50\x70\x1e\xb2\x63\x18\xcd\x41\xe2\xaa\x44\x22\x9b\x26\x90\x09\x05\xb8\xcc\xd5\xd6\x26\xe5\x92\x32\x8b\xe0\x1a\x37\x1d\x15\xd7\x83\xd3\x1d\x2f\x26\xc4\xb4\x33\xdc\xb8\x9d\xd5\x23\x63\xd0\xf0\x1c\xf5\x99\x56\x35\x49\x81\xaa\x94\x19\x44\x9c\xfc\x39\x91\x3f\x21\x71\x8e\x0b\x20\x0d\x7a\xee\x84\xfd\x09\x07\x0c\xd6\xc1\xff\xc4\xe9\x42\x2c\x97\x94\x51\xc7\x51\xa5\x24\xe2\x3a\x61\x3e\x2c\x3f\x99\x98\xb6\x1b\x31\xd3\x56\x5c\xa2\xa5\xa3\x1a\x80\xa6\x18\x6d\xb9\x09\x65\xb8\x2c\x28\xc0\x1c\xa7\x58\xe4\xe7\x22\xde\xea\x74\xac\x07\x42\xcf\x0a\x8e\x43\x98\x7e\x8b\x74\xaa\xcb\x63\x27\xf5\x1a\x7d\x94\x52\x48\x8f\x65\x59\x15\x3a\x2d\x53\x9e\x95\xe9\x22\xfe\x5c\xa2\xdc\xde\xea\xd6\xb8\x2b\xfe\x36\xa8\xa4\x15\x4f\x34\xc1\xe0\x8a\x61\xbe\xa0\x9c\x81\xf3\x61\x7c\xc7\x28\xb6\x65\xbd\xc8\x00\xd6\xa1\x84\x12\x8e\x19\x1b\x01\xe8\x2a\xc9\x12\x49\x0c\x15\x76\xcd\x15\xb3\x47\x8c\x14\xb9\xc8\xe0\x03\x71\x65\x76\xba\x00\xea\xf0\xd4\x04\xb9\x1a\xdc\x87\x76\x64\xf7\x09\x88\x52\x46\xc8\xe3\xdf\x90\xf8\xa7\x05\xec\x88\x74\x11\xda\xdb\xa8\xe0\x43\x96\x2e\xa8\x54\x8b\xeb\x24\xd8\xbf\x46\x6c\x60\x99\x5e\xab\xb0\x19\x95\x52\xdf\xd6\x7c\x4d\x3c\x2e\x0a\xdc\xb9\xf7\x7d\x67\xd0\xee\x9f\x65\xd5\x66\x95\xf9\x47\x10\x51\xd0\xdb\x11\x22\x40\x18\x55\xe7\xe7\x1f\x89\x12\x18\x77\x70\x8b\xa4\xc3\xde\xf6\x90\x22\xfd\x04\x5e\x77\x53\x4b\x9d\x08\x09\x34\xc2\x7a\x82\xcd\xc8\x9a\x65\x2b\xfa\x10\x07\xf9\x29\x9e\x9e\x9b\xe0\x36\xa7\x91\x50\x89\xeb\xfc\xbd\x26\x8c\xb5\x77\x90\x79\x38\x8c\x05\xa8\x79\xd7\x43\xdd\xbc\x22\xef\xba\xd7\x2e\x5a\x70\x29\x5c\x0a\xf9\x0d\x1a\x5b\x16\x3d\xc7\x24\x29\xd3\x0a\x38\x33\x2d\xbb\x28\xd0\xf5\xec\xd7\xc6\x97\x33\xed\xb0\x4d\x49\xfc\x0a\xf3\x24\x5e\x22\x3d\xcb\x28\xdd\x2e\xa3\x17\x18\x93\x7b\x52\x7a\xf6\x8e\x5d\x2f\x87\x49\x40\x34\xed\x03\x82\x26\xf6\x70\xbf\x2e\xc7\x93\xf1\xdd\xd8\xd1\x29\xde\xba\x26\x4d\x8e\x6e\x53\xde\x7d\x51\x8e\x7b\xf1\x3e\xb3\xf7\xf2\xdc\x99\x97\xfb\x68\xe2\x7a\xfd\x78\xf3\x73\xb5\x23\xba\x37\xdc\xb1\x20\xbd\xbf\xa6\x6a\xce\xf1\xae\xa3\xaf\x73\x0b\xe9\xbf\xf3\x57\x85\x7f\x46\x77\x7f\xff\x75\x5b\x35\x74\xeb\xfa\xa4\x09\x36\x96\x1f\x70\x27\x26\x62\x83\x92\x93\x98\x52\xa9\x09\x4d\x5a\xbf\x95\xdb\x6f\x7b\xf8\x67\x07\x8f\x76\x70\x77\xe0\x7f\xf5\xdd\x69\xef\xf0\x33\x00\x00\xff\xff\x03\xc6\x8f\x49\xda\x09\x00\x00")
+var _templatesClient_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x55\x51\x6f\x9b\x40\x0c\x7e\x86\x5f\xe1\xa1\xac\x82\x8e\x92\xf7\x49\x79\x58\xdb\x6c\xab\x94\x4d\x5d\xda\x6e\x8f\x13\x01\xd3\xd0\x26\x1c\x39\x8e\x44\x1d\xe3\xbf\xcf\xbe\x03\x42\x92\xb6\xd2\x2a\x55\x7b\x19\x4f\x60\xfb\x3e\x7f\xfe\x6c\x1f\x55\x75\x02\x31\x26\x69\x86\xe0\x44\x8b\x14\x33\xf5\xf3\x56\x38\x70\x52\xd7\x76\x1e\x46\xf7\xe1\x2d\x42\x55\x05\x97\xe6\xf5\x6b\xb8\x44\x72\xd8\xe9\x32\x17\x52\x81\x6b\x5b\x4e\x86\x6a\x38\x57\x2a\x77\xe8\x1d\xb3\x48\xc4\x69\x76\x3b\xbc\x2b\x44\xc6\x86\x64\xa9\x1c\xdb\x06\x7a\xaa\x0a\x64\x98\x11\xd8\xe0\xde\x87\xc1\x1a\xde\x8f\x20\x98\xa4\xb3\x0b\x0d\x74\x19\xaa\x79\xa1\x53\x72\xa8\x53\x55\x83\xfb\xba\x76\x9a\x73\x98\xc5\xda\xe5\xd9\x76\x24\xb2\x42\x67\x95\x42\xa8\x9b\xe9\x04\x46\x1c\x1c\x9c\x86\x05\xde\x4c\x2f\xf8\x08\x05\xd1\xe9\x30\x4f\x99\xa9\x4e\xd2\x50\x56\x0f\xb9\x2e\xc4\x7c\x42\xa1\x64\x19\x29\xa8\x6c\xcb\xd4\x0c\x5c\x42\x70\xa6\xdf\x75\xde\x0f\xa5\x9a\x7f\xc6\x30\x46\xc9\xb1\x54\x13\x0c\x87\xda\x28\x64\xfa\x2b\x54\xa9\xc8\x60\xae\xdd\x3e\x6c\xd2\xc5\x02\x66\x08\x05\xe3\x90\x1d\xc3\x68\x0e\x12\x57\x25\x12\xd9\x34\x81\x4c\x28\xc0\x65\xae\x1e\x6c\x52\x2e\x29\xb3\x08\xbe\xe2\xa6\xa3\xe2\x7a\x70\xbc\xe5\xc5\x84\x98\x76\x86\x1b\xb7\xb3\x7a\x64\x0c\x1a\x9e\xa3\x3e\xd3\xaa\x26\x29\x50\x95\x32\x83\x88\xc1\x1f\x13\xf9\x0b\x12\xe7\xb8\x00\xd2\xa0\xe7\x4e\xd8\x9f\x70\xc0\x60\x1d\x7c\x24\x4e\x67\x62\xb9\x24\x44\x1d\x47\x95\x92\x88\xeb\x84\xf9\xb0\xfc\x64\x62\xda\x6e\xc4\x4c\x5b\x71\x89\x96\x8e\x6a\x12\x34\xc5\x68\xcb\x65\x28\xc3\x65\x41\x01\xe6\x73\x8a\x45\x7e\x2a\xe2\x07\x0d\xc7\x7a\x20\xf4\xac\xe0\x38\x94\xd3\x6f\x33\x1d\xeb\xf2\xd8\x49\xbd\x46\x1f\xa5\x14\xd2\x63\x59\x56\x85\x86\x65\xca\xb3\x32\x5d\xc4\xdf\x4a\x94\x0f\x57\xba\x35\xee\x8a\xdf\x4d\x56\xd2\xaa\xa2\x89\xa6\x34\xb8\xe2\x34\xdf\x51\xce\xc0\xf9\x34\xbe\xe6\x2c\xb6\x65\x3d\xc9\x00\xd6\xa1\x84\x12\x0e\x19\x1b\x01\xe8\x28\xc9\x12\x49\x0c\x15\x76\xcd\x15\xb3\x3b\x8c\x14\xb9\xc8\xe0\x03\x71\x65\x76\xba\x00\xea\xf0\xd4\x04\xb9\x3a\xb9\x0f\xed\xc8\xee\x12\x10\xa5\x8c\x90\xc7\xbf\x21\xf1\xae\x4d\xd8\x11\xe9\x22\xb4\xb7\x51\xc1\x87\x2c\x5d\x50\xa9\x16\xd7\x49\x69\xdf\x8c\xd8\xc0\x32\x3d\x57\x61\x33\x2a\xa5\x3e\xad\xf9\x9a\x78\x5c\x14\xb8\x75\xef\xfa\x4e\xa0\xdd\x3f\xcb\xaa\xcd\x2a\xf3\x43\x29\xa2\xa0\xb7\x23\x44\x80\x72\x54\x9d\x9f\x1f\x12\x25\x30\xee\xe0\x0a\x49\x87\x9d\xed\x21\x45\xfa\x00\x5e\x77\x52\x4b\x9d\x08\x09\x34\xc2\x7a\x82\xcd\xc8\x9a\x65\x2b\xfa\x29\xf6\xf0\x29\x9e\xae\x9b\xe0\x2a\xa7\x91\x50\x89\xeb\xbc\x5d\x53\x8e\xb5\xb7\x87\x3c\x1c\xc6\x02\xd4\xbc\xeb\xa1\x6e\x5e\x91\x77\xdd\x6b\x17\x2d\x38\x17\x2e\x85\xfc\x03\x8d\x2d\x8b\xae\x63\x92\x94\x69\x05\x8c\x4c\xcb\x2e\x0a\x74\x3d\xfb\xb9\xf1\x65\xa4\x6d\x6e\x53\x12\xdf\xc2\x3c\x89\xe7\x48\xd7\x32\x4a\xb7\x43\xf4\x02\x63\x72\x8f\x4a\xcf\xde\xb2\xeb\x61\x18\x00\xa2\x69\xef\x11\x34\xb1\xfb\xfb\x75\x3e\x9e\x8c\xaf\xc7\x8e\x86\x78\xe9\x9a\x34\x18\xdd\xa6\xbc\xfa\xa2\x1c\xf6\xe2\x75\x66\xef\xe9\xb9\x33\x37\xf7\xc1\xc4\xf5\xfa\xf1\xe2\xeb\x6a\x4b\x74\x67\xb8\x63\x41\x7a\xff\x48\xd5\x9c\xe3\x5d\x47\x1f\xe7\x16\xd2\xbf\xf3\x6f\x85\x7f\x44\x77\x7f\xf7\x76\x5b\x35\x74\xeb\xfa\xa8\x09\x36\x96\xdf\x70\x2d\x26\x62\x83\x92\x41\x4c\xa9\xd4\x84\x06\xd6\x6f\xe5\xf6\xdb\x1e\xfe\xdf\xc1\x83\x1d\xdc\x7e\xf0\x5f\x7d\xfb\xb5\xf3\xf1\x07\x00\x00\xff\xff\x03\x00\x03\xc6\x8f\x49\xda\x09\x00\x00")
 
 func templatesClient_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -148,7 +153,7 @@ func templatesClient_goTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x93\xdd\x6a\xdb\x30\x14\xc7\xef\xf5\x14\x07\x53\xb0\x43\x5b\x3f\x40\x20\x17\x69\xe9\xa0\xb0\x8d\x92\x6e\xbb\x19\x43\xa8\xf1\x71\x22\x66\x4b\x99\x3e\x32\x3a\x4d\xef\xbe\x23\x7f\xd5\x6e\x57\x5d\xd8\x92\xce\x5f\xbf\xf3\x25\x85\x70\x0d\x15\xd6\x52\x21\x64\xfb\x46\xa2\x72\xfc\xf4\xec\x8e\x5a\x65\x70\x1d\x23\x93\xed\x49\x1b\x07\x06\x7f\x79\xb4\xce\xb2\xda\xe8\x16\x06\x9d\x77\xb2\xb1\x30\x28\x9e\xbc\x6c\x2a\x4e\x2a\xf3\xcc\xad\x33\x52\x1d\x18\xbb\xd9\x3e\xde\xf1\xaf\xbb\x7b\xd8\x40\x16\x42\x79\x23\x2c\xd2\x2a\xc6\x8c\x31\xb6\x6f\x84\xb5\x70\xdb\x91\xd6\x0c\x68\x50\x14\xc0\xb9\x54\xd2\x71\x5e\x58\x6c\xea\x55\xbf\x9f\x46\x5a\x96\xde\x34\x44\x1a\xa1\x4b\x9b\x45\x6b\xa5\x56\x64\x1f\x43\x2d\x1f\xfb\xad\x62\xb5\x54\x0a\xef\x8e\xfc\x88\xa2\x42\x43\xea\x3c\xef\xac\x53\x00\x16\x1d\x9f\x29\x8a\xb3\x68\x66\x61\xe4\x79\x9e\x14\x90\x14\xda\xc8\x3f\xc2\x25\x9f\x03\x8c\xa4\x1e\xf3\x01\xf8\x8e\x3b\xd2\xb0\x10\xc0\x08\x75\x40\xb8\xf8\x79\x05\x17\x67\x58\x6f\xa0\xfc\x84\x04\xac\x2c\x50\xc5\xa7\x50\x42\xb8\x38\x0f\x86\xcf\xa2\xc5\x18\x8b\x6e\xe7\x41\x18\xd1\xda\x18\x67\x61\x65\x59\x36\x83\xd6\x89\x5a\x27\x2c\xa9\x3f\x78\xb5\xbf\xd5\x6d\x4b\x55\xee\xe8\xe3\x91\x84\xaa\x63\x0c\x01\x55\x35\xdb\xbe\x77\x20\x2d\xb4\x9d\x53\xa8\xb5\xe9\x83\xf8\x86\xe6\x29\xc6\x7e\x7e\xa7\xaa\x93\x96\xca\xcd\x0e\x91\xfb\x69\x2e\xeb\x37\x69\xbf\xc4\x39\x08\x94\x76\x43\xcd\xec\xd2\x96\xc6\x60\xa0\x5a\x85\x7c\x3b\x2f\x73\xbe\x7e\x43\x8e\x8b\xd3\xd8\x58\x7c\x97\xf7\xfd\x15\xec\x07\x39\x78\x8d\x63\xd3\x61\x6f\xe4\x68\x4f\x97\xee\xb2\x4f\x7d\x87\x56\x7b\xb3\xc7\x07\xe1\x8e\xb3\xf4\x7b\x71\xfa\x5e\xfe\xe7\x15\x14\xfd\xe2\xd4\x75\xed\xe5\x2a\x1a\x74\xde\xa8\xc5\xdd\x2d\xa7\x5a\xc3\x5f\xf8\xa2\x3f\xea\xdf\x94\x61\x2c\x08\xdc\xf7\x7d\xb7\x35\x07\xea\xfb\xd5\x98\xd2\x66\xf8\xaf\xd2\x8d\xa2\x36\xa6\xf6\xa6\xd7\x9c\xa6\xe9\xe9\xfe\x0b\x00\x00\xff\xff\xb9\x8e\xb3\x09\xda\x03\x00\x00")
+var _templatesClient_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x53\xdb\x6e\xdb\x30\x0c\x7d\xd7\x57\x10\x46\x00\x3b\x68\x9b\x0f\x08\x90\x87\xb4\xe8\x80\x02\xdd\x50\xa4\xdb\x5e\x86\x41\x50\x63\x3a\x11\x6a\x4b\x99\x2e\x19\x3a\x4d\xff\x3e\xca\xb7\xda\xed\xaa\x07\x5b\x22\x0f\x0f\x0f\x49\x29\x84\x2b\x28\xb1\x92\x0a\x21\xdb\xd7\x12\x95\xe3\xa7\x17\x77\xd4\x2a\x83\xab\x18\x99\x6c\x4e\xda\x38\x30\xf8\xcb\xa3\x75\x96\x55\x46\x37\xd0\xe3\xbc\x93\xb5\x85\x1e\xf1\xe4\x65\x5d\x72\x42\x99\x17\x6e\x9d\x91\xea\xc0\xd8\xf5\xf6\xf1\x96\x7f\xdb\xdd\xc1\x06\xb2\x10\x56\xd7\xc2\x22\x9d\x62\xcc\x18\x63\xfb\x5a\x58\x0b\x37\x2d\xd3\x9a\x01\x2d\x52\x01\x9c\x4b\x25\x1d\xe7\x85\xc5\xba\x5a\x76\xf6\xb4\xd2\x71\xe5\x4d\x4d\x4c\x03\xe9\xdc\x67\xd1\x5a\xa9\x15\xf9\x07\xa9\xab\xc7\xce\x54\x2c\xe7\x48\xe1\xdd\x91\x1f\x51\x94\x68\x08\x9d\xe7\xad\x77\x14\x60\xd1\xf1\x09\xa2\x38\x8b\x7a\x22\x23\xcf\xf3\x84\x80\x84\xd0\x46\xfe\x11\x2e\xe5\xec\xc9\x08\xea\x31\xef\x09\x3f\x48\x47\x18\x16\x02\x18\xa1\x0e\x08\x8b\xe7\x4b\x58\x9c\x61\xbd\x81\xd5\x67\x24\xc2\xd2\x02\x75\x7c\x94\x12\xc2\xe2\xdc\x3b\xbe\x88\x06\x63\x2c\x5a\xcb\x83\x30\xa2\xb1\x31\x4e\x64\x65\x59\x36\x21\xad\x12\x6b\x95\x68\x09\xfd\xc9\xab\xfd\x8d\x6e\x1a\xea\x72\xcb\x3e\x84\x24\xaa\x2a\xc6\x10\x50\x95\x13\xf3\x9d\x03\x69\xa1\x69\x93\x42\xa5\x4d\x27\xe2\x3b\x9a\xa7\x18\xbb\xfd\xad\x2a\x4f\x5a\x2a\x37\x09\xa2\xf4\xe3\x5e\x56\xef\xca\x7e\xd5\xd9\x03\x94\x76\x7d\xcf\xec\xdc\x97\x56\xef\xa0\x5e\x85\x7c\x3b\x6d\x73\xbe\x7e\xc7\x1c\x67\xd1\x58\x5b\xfc\x90\xef\xc7\x1b\xb2\x9f\x94\xe0\x2d\x1d\x1b\x83\xbd\x91\x83\x3f\x5d\xba\x8b\xae\xf4\x1d\x5a\xed\xcd\x1e\x1f\x84\x3b\x4e\xca\xef\xc0\xe9\x7b\xf1\x9f\x57\x50\x74\x87\x53\x3b\xb5\xd7\xab\x68\xd0\x79\xa3\x66\x77\x77\x35\xf6\x1a\xfe\xc2\x57\x7d\xaf\x7f\x53\x85\xb1\x20\xe2\x6e\xee\xbb\xad\x39\xd0\xdc\x2f\x87\x92\x36\xfd\x7f\x99\x6e\x14\x8d\x31\x8d\x37\xd0\x6b\x4e\xdb\xf4\x74\xff\x01\x00\x00\xff\xff\x03\x00\xb9\x8e\xb3\x09\xda\x03\x00\x00")
 
 func templatesClient_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -168,7 +173,7 @@ func templatesClient_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_utils_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x55\xdf\x6f\xe2\x46\x10\x7e\xc6\x7f\xc5\x74\xa5\x56\x5e\x70\x4c\xab\x3c\x25\x12\xaa\xda\x46\x51\x55\xa9\x69\x1a\x52\xf5\x21\x8a\x92\xc5\x1e\xc2\x36\x78\xd7\xac\xc7\xf4\x38\xc4\xff\x7e\x3b\x6b\xe3\x60\x2e\x17\xe5\xe5\xa4\xe3\xc1\x78\xe7\xc7\xce\xf7\x7d\x33\x03\xdb\xed\x09\xe4\x38\xd7\x06\x41\x64\x4b\x8d\x86\x1e\x6a\xd2\xcb\xea\xe1\xc9\x0a\x38\xd9\xed\xa2\x52\x65\xcf\xea\x09\x61\xbb\x4d\xaf\x9b\xd7\x2b\x55\xa0\x77\x44\xba\x28\xad\x23\x88\xa3\x81\x98\x6d\x08\x2b\xe1\x5f\xd0\x64\x36\xd7\xe6\x69\xfc\x5f\x65\x0d\x1b\xb4\xe5\xa7\x41\x1a\x2f\x88\x4a\x7e\x9f\x17\xc4\x5f\xa4\x0b\x14\x91\x8c\xa2\x79\x6d\x32\x08\x79\xf8\xab\xcd\x37\x71\xae\x48\x81\x36\x84\x6e\xae\x32\xdc\xee\x24\xc4\xda\xa6\x37\xa8\x72\x74\x09\xa0\x73\xd6\x49\xd8\x46\x83\x59\x38\xc0\xf9\x04\xb8\x56\xfa\xa7\x72\xd5\x42\x2d\x43\xba\x8c\x06\x7a\x1e\xbc\xdf\x4d\xc0\xe8\x25\x87\x0f\x1c\x52\xed\x0c\x1f\x43\x62\x34\xd8\x45\x7b\x5b\x80\x9f\x5e\xe1\xff\x4d\x95\x78\x26\x13\x8e\x8b\x76\x2d\xba\x38\x63\xfa\x0d\x6f\x99\xdb\x1b\x5c\xfd\xab\x69\x11\xd0\x16\x48\x0b\x9b\x27\x50\xbb\xe5\x94\x1c\x54\xe4\x3c\xfb\x04\x8e\x49\x24\xb0\x08\x57\x57\x50\xa8\xf2\xae\x89\xba\xef\xf9\x57\xd5\xb5\x72\xaa\x68\x6f\xf0\xa4\x87\x2c\x98\xe7\x5d\x95\xd6\x54\xd8\x63\xee\x0b\x77\xe4\x8f\x94\x7b\x2f\xf5\x68\x30\x1e\x43\xe6\x50\x11\x02\x2d\x10\x1c\xae\x6a\xac\x88\x25\x59\x75\x77\x07\x04\x41\x96\xe0\x3c\x26\x3b\xda\x83\x4e\x80\x21\xbd\xbb\x34\xf8\x8f\x8f\xcc\xd2\x5f\x6a\x5a\xfc\x1e\x74\xe1\x14\x21\x7c\x06\xb4\x1f\x0f\x23\x6d\x5c\xe9\x14\x29\x16\x1c\x6a\x9d\xfe\xa8\x48\xfb\xc1\x4a\x7a\xc9\x32\x64\xf9\x76\xce\xad\x83\xe7\x04\xd6\x8c\xdd\x29\xe3\xa7\x76\xaf\x3a\x43\x19\x1c\x5f\xea\x43\xfd\x30\xa6\xd3\xd2\x2b\x4e\xf3\x58\x7c\xbf\x16\xc9\x5a\xca\x46\x9e\x16\x78\x96\x36\x5b\x91\x5e\xd8\xd8\xa7\xcb\x6e\x26\x66\xb5\x5e\xe6\x7f\xd7\xe8\x36\xd3\xd0\xb1\x66\x6e\x5f\xef\xae\x6c\xbb\xba\x0d\x0a\x2d\xd1\x34\xad\x82\xc9\x04\x7e\x3c\x54\x49\x88\xa6\xf6\x4c\x55\x18\xae\x66\x22\xe2\x67\xf1\x1a\xb3\x50\x8e\x73\x5f\x82\x47\x13\x78\xf6\x4d\x11\x13\xe1\x9f\x2f\xcc\xe2\xb5\x64\xeb\x0f\xa2\x47\xac\x4b\xbb\x3b\x67\x40\xdd\x51\x9e\xfc\x74\xcf\x24\xc7\xe3\x0b\x9e\x0d\x87\xa5\xc3\xca\x0b\x00\x37\x97\xbf\x9d\x9e\x9e\x9d\x71\x61\x8c\x68\x53\x22\x84\x00\xde\xe3\xf4\xd6\x3f\x38\xa5\x5d\xc2\x3f\xa6\x7f\x5d\x81\x5d\xfb\x7e\xeb\x1c\xbd\x26\x9d\xb1\x5d\x27\x82\x21\xe7\x4a\x38\x88\x8f\xfd\xc8\xdf\xdd\xf3\x26\x1e\x8e\x7a\x0b\xb6\x71\xc4\x5d\xad\x78\x48\x32\xbd\xb4\xae\x50\x14\x3f\x8a\x47\x4f\x2f\xb8\x02\xc4\xd3\x33\x7f\xf4\x46\xf9\xb2\xc4\x1d\xb0\x5b\xfc\x40\x9f\x01\x63\xe3\x17\x80\xb1\xeb\xeb\x02\xfb\xc7\x14\xaf\x69\x56\x9b\x37\x54\xeb\xe5\xc4\xb3\x16\x84\x6c\xd0\x31\x38\xaa\xba\x15\x0e\xe5\xfd\x92\x56\xc8\x78\x46\x87\x68\x46\xde\x90\xb4\x93\xe9\x7f\xf2\xde\x5a\xdf\xee\x47\x63\x48\x30\x09\x7d\x8f\xa9\x92\xd1\xc1\x76\x1f\xb1\xe9\x0b\x5d\x9b\x37\xa4\xee\xe5\x7c\x43\x6c\x8e\x60\xb6\x5b\xbe\x5f\xe5\x83\x19\xe8\x37\x7f\x1f\xc7\x57\xf0\x9f\x2b\x9a\x3c\xfc\x8f\x7e\x0a\x00\x00\xff\xff\x29\xfa\x93\x3b\x69\x07\x00\x00")
+var _templatesClient_utils_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xcc\x56\x4b\x8f\xdb\x36\x10\x3e\x4b\xbf\x62\x2a\xa0\x00\xb9\x56\xb4\x01\xf6\xb4\x69\x8d\x22\x6d\x1a\xf4\xb9\x49\xd7\x69\x7b\x08\x82\x84\x96\x46\x6b\x36\x32\x25\x93\x94\x1b\x47\xf0\x7f\xef\x0c\x29\xbb\x96\xbb\x5d\xe4\x52\xa0\x3a\x48\xe4\x70\x1e\xdf\x7c\x33\x1c\x68\x18\x1e\x41\x85\xb5\x36\x08\x59\xd9\x68\x34\xfe\x6d\xef\x75\xe3\xde\xde\xb5\x19\x3c\xda\xef\xd3\x4e\x95\xef\xd5\x1d\xc2\x30\x14\x2f\xe3\xf2\x46\xad\x91\x0e\x52\xbd\xee\x5a\xeb\x41\xa4\x49\xb6\xdc\x79\x74\x19\x2d\xd0\x94\x6d\xa5\xcd\xdd\xe5\x1f\xae\x35\x2c\xd0\x2d\xbf\x0d\xfa\xcb\x95\xf7\xdd\x61\xdd\xdb\x86\x97\xf5\xda\xf3\xc7\x62\xdd\x60\x19\x96\x5e\xaf\x31\x4b\x65\x9a\xd6\xbd\x29\x21\x78\xc3\xaf\xdb\x6a\x27\x2a\xe5\x15\x68\xe3\xd1\xd6\xaa\xc4\x61\x2f\x41\xe8\xb6\xb8\x45\x55\xa1\xcd\x01\xad\x6d\xad\x84\x21\x4d\x96\x61\x03\x4f\xe6\xc0\x08\x8a\x9f\x95\x75\x2b\xd5\x04\x73\x99\x26\xba\x0e\xa7\x9f\xcd\xc1\xe8\x86\xd5\x13\x8b\xbe\xb7\x86\xb7\xc1\x30\x4d\xf6\xe9\x41\x16\x92\x2a\x6e\xf0\xcf\x18\x45\x2c\x65\xce\x7a\xe9\x7e\x44\x27\x4a\x26\x25\xb2\x21\xab\xf6\x16\x37\xbf\x6b\xbf\x0a\x68\xd7\xe8\x57\x6d\x95\x03\xe5\xb9\xf0\x16\x9c\xb7\xc4\x49\x0e\xe7\x49\xe4\xb0\x0a\xae\x1d\xac\x55\xf7\x3a\x6a\xbd\x99\x9c\x6f\xdc\x4b\x65\xd5\x7a\xf4\x40\x49\x5f\x30\x8d\x94\xb7\xeb\x5a\xe3\x70\x92\x39\x05\x3e\x26\x7f\xc6\xdc\xa7\xa6\x9e\x26\x97\x97\x50\x5a\x54\x1e\xc1\xaf\x10\x2c\x6e\x7a\x74\x9e\x29\xd9\x1c\x7d\x07\x04\x81\x96\x70\x78\x9e\xec\xec\x00\x3a\x07\x86\xf4\xc9\xa1\x81\x1e\xd2\x2c\x8b\xa7\xbd\x5f\x7d\x17\x78\x61\x93\x2c\x23\x0b\x18\x1f\x82\x51\xc4\xa3\x62\x81\x5e\x64\xac\xda\x5a\xfd\x51\x79\x4d\xed\x96\x4f\x8c\x65\xb0\xa2\x72\xd6\xad\x85\xf7\x39\x6c\x19\xbb\x55\x86\x7a\xf9\xc0\x3a\x43\x49\xce\x9d\x92\x2a\xf5\x65\xb1\xe8\x88\x71\x5f\x8b\xec\xf3\x6d\x96\x6f\xa5\x8c\xf4\x8c\xc0\xcb\x22\xde\x95\xe2\x59\x2b\xc8\x5c\x72\x4f\x10\x73\xcb\x5e\x37\xd5\x2f\x3d\xda\xdd\x22\xd4\x2b\x0a\x5c\xa0\x72\xc3\xe2\xb1\x8e\xd0\xd6\xa7\xf4\xe6\x6c\xab\x0c\x28\x6b\xd5\x0e\xb6\xaa\xe9\x11\xb4\x03\x47\x01\x40\x39\x50\xa4\xd7\x71\x49\x2a\xe8\x98\x57\xa4\xfe\x88\x1d\x78\x1e\x2f\xde\x92\xfb\x7b\x49\x8e\xb1\x87\x50\x8f\x06\x4d\x6c\x0c\x98\xcf\xe1\xf1\x69\x4d\xb2\x2c\x66\x1a\xf1\x12\x65\x54\xd6\xe2\x37\xc6\xe4\x86\x7b\xc9\x0c\x31\x83\x83\x28\x8c\x97\x39\x9a\xbc\xa8\xc5\x96\x98\xe3\x88\x76\x5b\xfc\xa8\x4d\x25\x24\x17\xf5\xa0\xb4\x68\x74\x89\xb1\x0c\x21\x5e\xf1\xb4\xaa\xa6\x05\x10\x81\xfa\x24\x29\x5b\xe3\xb5\xe9\x91\xd6\x84\x22\xc0\xd0\x1c\xee\xf1\x17\xf4\xfd\x92\xbd\xff\x44\x29\x49\xda\xcd\x66\x0f\x3b\x24\xd5\xef\x4d\x85\x1f\x84\x96\xb4\x18\xf9\x11\x32\x84\xd9\x4f\xaa\x9c\x7d\x95\x71\x37\x07\x3f\xdf\x86\x0b\x25\xc6\x4a\x3f\xe3\x0b\x42\x55\xb1\x18\x8a\x74\xfb\xfc\x9b\xab\xab\xeb\x6b\xa6\x02\x53\xbf\xeb\x10\x82\x02\x0f\xb3\xe2\x15\xbd\xd8\x64\x9c\x44\x3f\x2c\x5e\xdc\x40\xbb\xa5\xa6\xd7\x15\x52\xa9\x8e\xc2\x71\xa6\x78\xb8\x60\x5b\x09\x27\xfa\xc4\x99\x78\xfd\x86\xc7\xd1\xe9\x7d\x1f\x41\xc6\x03\x71\x8c\x25\x2e\xbc\x2c\x9e\xb7\x76\xad\xbc\x78\x97\xbd\xa3\x04\xc2\x51\x80\x78\x75\x4d\x5b\x12\xca\xbf\x27\xd9\x11\xd8\x2b\xfc\xe0\xff\x01\x8c\x85\xff\x02\x8c\x8f\xfe\x5b\x60\xbf\x9a\xf5\x7d\x9c\xf5\xe6\x01\xd6\x26\x36\x62\x39\x82\x90\x11\x1d\x83\xf3\xee\x38\xc7\x42\x78\x9a\x54\x0e\x19\xcf\xec\x14\xcd\x8c\x04\xf9\x78\x61\x68\xee\x3f\x34\xc3\x8e\x93\xf3\xc2\xc3\x3c\xd4\x5d\x78\x27\xd3\x93\x11\x77\x96\xcd\x94\xe8\xde\x3c\x40\xf5\xc4\xe6\x7f\x94\xcd\x19\xcc\x71\xf8\x1c\x26\xcc\x49\x0f\x4c\x8b\x7f\xd0\x63\x17\x03\xfd\x77\xa0\xa9\xc2\x2f\xc6\x5f\x00\x00\x00\xff\xff\x03\x00\x46\x62\x9f\x69\x84\x08\x00\x00")
 
 func templatesClient_utils_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -188,7 +193,7 @@ func templatesClient_utils_goTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesClient_utils_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x56\xcd\x6e\xdb\x46\x10\xbe\xeb\x29\x06\x0c\x14\x93\xb5\xa8\xd0\x52\x90\x1f\x21\x6a\x0e\x45\x51\xf4\x92\x93\x73\x0a\x02\x79\x45\x0e\x2d\xd6\xe4\x2e\xbd\xbb\xb4\xc3\x04\x79\xf7\xce\xec\x92\x14\x29\x2b\x69\x0e\x35\x0c\x6a\xb5\xf3\xff\xcd\x7c\x43\x7d\xfb\x16\x43\x86\x79\x21\x11\x82\xb4\x2c\x50\xda\x5d\x63\x8b\xd2\xec\xea\xd6\x1e\x94\x0c\x20\xfe\xfe\x7d\x56\x54\xb5\xd2\x16\x32\x61\xd1\x16\x15\xf6\xdf\xdd\x79\x36\x23\x73\xd8\x37\x45\x99\xed\xee\x1b\xd4\xed\xce\x58\x5d\xc8\xdb\xd0\x7f\xa9\x85\x16\x95\xd9\x7e\x50\x12\xa3\xcd\x0c\xe8\x2f\x08\x02\xf7\xe9\x4c\xc0\x69\x81\xd3\x42\x8b\xda\x49\x0a\x59\x37\x16\xe0\x66\xec\xe1\x06\x36\x90\x15\xa9\x2d\x94\x14\xba\x75\x6a\xaa\xb1\xac\xe7\xc3\xc1\x63\x61\x0f\x90\x2b\x5d\x09\x0b\x37\xef\xef\xb0\xdd\x3e\x88\xf2\x39\x7d\xae\xf8\xb0\xba\x99\xc4\x2e\x72\x18\x3b\x87\xc2\x00\x67\xe8\x13\xe4\x3f\x8d\xb6\xd1\x92\xf4\x67\xee\xea\xde\xc0\x16\x82\xf7\xde\x98\x82\x00\xf9\x5d\x00\x96\x58\x51\xb2\x13\x57\xcb\xc2\x62\x65\xc2\xe8\xe8\x8a\x6c\x2f\xb7\x6c\x00\x97\x10\x6c\x03\x7a\x52\xc6\x21\xdb\x46\x7c\xf3\xbc\x0b\xd1\x45\xbc\x37\x9f\x36\xf1\xd5\xe7\x0e\xd6\x5b\x94\xa8\x09\xf5\x9d\xce\xd3\xf5\x7a\xfd\x36\xcc\x16\x50\xaa\x54\x94\x3b\xfb\x75\x7b\xad\x9b\x53\x4c\x7b\x7d\xe8\xf4\x5d\x8b\x3a\x54\x46\xd0\x7a\xa3\x8c\x6a\xe2\x96\x82\x6d\x6b\x74\x37\xbd\x6b\x12\x34\x06\xfd\x57\xef\xe2\x2b\xa1\xc3\xa8\x59\x8a\xb9\xf0\xe8\xdb\x03\xea\xc7\x82\xd4\x2a\xa1\xef\x40\x18\x68\x6c\x3a\x1b\x37\xc6\x47\xe9\x33\xe9\xda\xe4\x02\xfa\x84\x96\x80\x5f\xa8\xab\x37\xab\x24\x79\x13\x27\x2f\xe3\x64\x75\xbd\x4a\x36\x09\xff\x5f\x26\xaf\xe9\x39\xed\x99\xd5\xed\x11\x54\x4a\xa5\x4f\xf6\x78\x39\x2e\x8a\xb3\x5e\x0e\x87\x5c\xab\x8a\x0f\xc6\x8a\xaa\x0e\xb3\x68\x30\xc1\xd2\xe0\xaf\x38\xa0\xda\xce\xfa\xc0\x2f\x29\xd6\x16\xae\x09\xc1\x3f\xb5\x56\xfa\xe8\xab\x16\xc6\xcc\xfa\x61\x93\xca\xd2\x8c\x15\x92\x6c\x65\x8a\xdc\xc5\x49\x88\xd1\xb4\x68\xc1\x90\x0e\xfe\xc2\x8b\x0f\xca\x13\xcd\x85\x05\x1a\x3d\x87\xa0\xda\xff\x83\x29\x21\xf8\x17\x49\xe7\x7a\x79\x01\x73\xd7\x45\x4a\x2b\xfa\xd5\xa0\x7c\x18\x05\x3e\x5b\x77\xf8\x5b\xb6\xe4\x4f\xdb\xd4\x25\x86\xd1\xa7\xcd\xfa\x73\x34\x19\xd7\xf0\x62\x9e\xbc\xcc\xe2\x79\xb2\xf2\x8f\x6b\x7e\x6c\x86\xc7\xdc\x50\x66\x13\x78\xc3\x6c\xd9\xa2\xd0\x94\xcb\xb2\x52\xd2\x1e\xf8\x90\x89\x96\x3f\x0e\xaa\xf1\xf7\x85\x6c\x2c\xf2\xc9\x60\xaa\x64\xb6\x98\x38\x80\xdd\x40\x0a\xce\x8c\x07\x73\xcc\x8a\x88\x01\x70\xdc\xd9\xd1\x45\xda\x94\xac\xa8\xf2\xdc\xa0\x0d\xb9\xaa\x91\xe6\x94\x3a\x13\x66\x30\xc4\x9b\x1f\x91\x63\x33\x10\xc1\xb1\xc4\xb4\x86\x28\x0f\x7d\x32\x8b\x11\x33\x3a\x90\x92\x09\x64\x24\xed\x7a\x98\xc3\xc7\xeb\x3f\xc0\x27\xb7\x74\x2a\x7f\xe7\x5e\x94\x29\x34\xae\x7f\x07\xf1\x80\x20\x64\x3b\xb8\xa7\x3c\x73\xb5\x80\x47\x3c\x61\xa8\x0b\x7d\x42\xcc\x21\xfc\xa4\xce\x73\xcc\x79\x76\x10\x32\x2b\x11\xb8\x35\xb0\x47\xe2\x27\xc2\xd5\xdb\xd7\x09\x54\xca\xd0\x86\x6d\x5d\x81\xe4\x16\x79\x53\x4a\x35\xcd\x86\xb9\x4c\x6b\x79\x6c\xb7\x1c\x13\x95\x2b\x72\x4d\x87\x77\x4e\x38\xe5\xdb\x33\xf8\x68\x9c\xd1\x8a\x1c\xa4\xa2\x31\x5d\x64\x86\x40\x5e\xf4\x08\x40\x89\xa2\x26\x4f\xed\xc4\xd6\xd2\xcc\xba\x79\xad\xee\xdc\xb4\xba\x48\x1a\xeb\x52\xd0\xbc\x73\xc4\x2d\xfb\x8d\x8e\x23\xfc\x33\xe2\x9f\x75\x36\x1a\xfe\x6e\xf0\x7d\xce\x1d\x5e\x94\x50\x59\xdc\x1e\xac\x11\x0f\xb4\xdc\x16\xbe\xda\xf1\x95\xeb\x92\x28\xed\xe9\x64\xf0\x75\x0f\xe2\x18\x2b\x97\x80\xeb\x8f\xcb\xc1\x52\xee\xd5\xae\x30\x99\xb1\xd3\x64\xbb\xd6\xc6\x4e\xbf\xf3\xff\x93\xda\x26\xea\x93\xb8\x53\xdd\xe3\xc0\x7a\x0a\x9d\xa1\xda\xff\xc5\xa1\xbd\x52\x65\xf7\xba\x70\x04\x18\xde\x5d\x19\xf0\xa6\x7d\x4a\xdf\xb1\x2e\xbd\x74\xfd\x6e\x30\xe3\xdb\x2d\x24\x10\xff\x0e\x97\xee\x15\x32\x15\x5c\xbd\x49\x06\xd9\xfa\x44\x16\xaf\x5f\x79\x61\x9c\x5c\xf5\x86\x7d\x45\x83\xd2\x7f\x6e\x13\x5f\x0b\xef\x30\xd2\x16\x7b\x13\x7a\xb5\x08\x5e\xbc\x00\x0e\xe0\xc4\x7e\xb5\x9d\x28\xcc\x9d\x9c\xf5\x5e\x25\xc3\xf2\xee\x02\xbf\x83\xe4\x49\x77\x2e\xe6\xe9\xb0\x60\x79\xf1\x87\x41\x1c\x2c\xc0\x6f\x4f\x1f\x20\xfa\x71\x6b\x9f\x1a\x5f\x3e\x31\xe6\xdf\x84\x28\x33\xf7\xd3\xef\xdf\x00\x00\x00\xff\xff\xb3\xbe\x38\x65\x20\x0a\x00\x00")
+var _templatesClient_utils_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x56\x4b\x93\x9b\x38\x10\xbe\xfb\x57\x74\x91\x72\x06\x62\xe3\x30\x76\x2a\x0f\x57\xbc\x39\x6c\x6d\x6d\xed\x25\x27\xe7\x94\x4a\xd9\x32\x34\x63\xed\x80\xc4\x48\x62\x26\x64\x2a\xff\x7d\x5b\x12\x60\xb0\x27\xd9\x1c\xe2\x72\x81\x50\x3f\xf5\x75\x7f\x0d\x8f\x8f\x31\x64\x98\x73\x81\x10\xa4\x05\x47\x61\x76\xb5\xe1\x85\xde\x55\x8d\x39\x4a\x11\x40\xfc\xfd\xfb\x84\x97\x95\x54\x06\x32\x66\xd0\xf0\x12\xbb\x67\xb7\x9e\x4c\xc8\x1c\x0e\x35\x2f\xb2\xdd\x5d\x8d\xaa\xd9\x69\xa3\xb8\xb8\x09\xfd\x43\xc5\x14\x2b\xf5\xe6\xa3\x14\x18\xad\x27\x40\xbf\x20\x08\xdc\xdd\x99\x80\xd3\x02\xa7\x85\x06\x95\x93\x70\x51\xd5\x06\x60\x3f\xf4\xb0\x87\x35\x64\x3c\x35\x5c\x0a\xa6\x1a\xa7\x26\x6b\x63\xf5\x7c\x38\x78\xe0\xe6\x08\xb9\x54\x25\x33\xb0\xff\x70\x8b\xcd\xe6\x9e\x15\xcf\xe9\xbe\xb4\x8b\xe5\x7e\x14\x9b\xe7\x30\x74\x0e\x5c\x83\xcd\xd0\x27\x68\x7f\x0a\x4d\xad\x04\xe9\x4f\xdc\xd6\x9d\x86\x0d\x04\x1f\xbc\x31\x05\x01\xf2\x3b\x07\x2c\xb0\xa4\x64\x47\xae\x16\xdc\x60\xa9\xc3\xe8\xe4\x8a\x6c\x67\x1b\x6b\x00\x33\x08\x36\x01\x5d\x29\xe3\xd0\xda\x46\x76\xe7\x79\x1b\xa2\x8d\x78\xa7\x3f\xaf\xe3\xeb\x2f\x2d\xac\x37\x28\x50\x11\xea\x3b\x95\xa7\xab\xd5\xea\x5d\x98\xcd\xa1\x90\x29\x2b\x76\xe6\xdb\x66\xab\xea\x73\x4c\x3b\x7d\x68\xf5\x5d\x89\x5a\x54\x06\xd0\x7a\xa3\x8c\xce\x64\x4b\x0a\xa6\xa9\xd0\xed\x74\xae\x49\x50\x6b\xf4\x8f\xde\xc5\x37\x42\xc7\xa2\x66\x28\xe6\xdc\xa3\x6f\x8e\xa8\x1e\x38\xa9\x95\x4c\xdd\x02\xd3\x50\x9b\x74\x32\x2c\x8c\x8f\xd2\x65\xd2\x96\xc9\x05\xf4\x09\x2d\x00\xbf\x52\x55\xf7\xcb\x24\x79\x1b\x27\xaf\xe2\x64\xb9\x5d\x26\xeb\xc4\xfe\x67\xc9\x1b\xba\x8e\x6b\x66\x54\x73\x02\x95\x52\xe9\x92\x3d\x6d\x0e\x0f\x65\xb3\x5e\xf4\x8b\x5c\xc9\xd2\x2e\xb4\x61\x65\x15\x66\x51\x6f\x82\x85\xc6\x5f\x71\x40\x67\x7b\xd2\x07\x7e\x4d\xb1\x32\xb0\x25\x04\xff\x52\x4a\xaa\x93\xaf\x8a\x69\x3d\xe9\x9a\x4d\x48\x43\x3d\xc6\x05\xd9\x8a\x14\x6d\x15\x47\x21\x06\xdd\xa2\x98\x85\xb4\xf7\x17\x5e\x7d\x94\x9e\x68\x2e\x2c\x50\xeb\x39\x04\xe5\xe1\x5f\x4c\x09\xc1\xbf\x49\x3a\x55\x8b\x2b\x98\xba\x2a\x52\x5a\xd1\xaf\x06\xb5\x8b\x41\xe0\x27\xcf\x1d\xbe\xc8\x16\xf6\x6e\xea\xaa\xc0\x30\xfa\xbc\x5e\x7d\x89\x46\xed\x1a\x5e\x4d\x93\x57\x59\x3c\x4d\x96\xfe\xb2\xb5\x97\x75\x7f\x99\x6a\xca\x6c\x04\x6f\x98\x2d\x1a\x64\x8a\x72\x59\x94\x52\x98\xa3\x5d\x64\xac\xb1\xb7\xa3\xac\xfd\x3e\x17\xb5\x41\xbb\xd2\x98\x4a\x91\xcd\x47\x0e\x60\xd7\x93\xc2\x66\x66\x1b\x73\xc8\x8a\xc8\x02\xe0\xb8\xb3\xa3\x8d\xb4\x2e\xac\xa2\xcc\x73\x8d\x26\xb4\xa7\x1a\x68\x8e\xa9\x33\x62\x86\x85\x78\xfd\x23\x72\xac\x7b\x22\x38\x96\xe8\x46\x13\xe5\xa1\x4b\x66\x3e\x60\x46\x0b\x52\x32\x82\x8c\xa4\x6d\x0d\x73\xf8\xb4\xfd\x13\x7c\x72\x0b\xa7\xf2\x4f\xee\x45\x99\x44\xed\xea\x77\x64\xf7\x08\x4c\x34\xbd\x7b\xca\x33\x97\x73\x78\xc0\x33\x86\xba\xd0\x67\xc4\xec\xc3\x9f\x8d\xbe\x4b\xe6\x3c\x3b\x32\x91\x15\x08\xb6\x34\x70\x40\xe2\x27\xc2\xf5\xbb\x37\x09\x94\x52\xd3\x84\x6d\xdc\x01\xc9\x2d\xda\x49\x29\xe4\x38\x1b\xcb\x65\x1a\xcb\x43\xbb\xc5\x90\xa8\xf6\x44\xae\xe8\xf0\xde\x09\xc7\x7c\x7b\x06\x9f\xb4\x33\x5a\x92\x83\x94\xd5\xba\x8d\x6c\x21\x10\x57\x1d\x02\x50\x20\xab\xc8\x53\x33\xb2\x35\xd4\xb3\xae\x5f\xcb\x5b\xd7\xad\x2e\x92\xc2\xaa\x60\xd4\xef\x36\xe2\xc6\xfa\x8d\x4e\x2d\xfc\x33\xe2\x3f\xe9\x6c\xd0\xfc\x6d\xe3\xfb\x9c\x5b\xbc\x28\xa1\x82\xdf\x1c\x8d\x66\xf7\x34\xdc\xe6\xfe\xb4\xc3\x2d\x57\x25\x56\x98\xf3\xce\xb0\xdb\x1d\x88\x43\xac\x5c\x02\xae\x3e\x2e\x07\x43\xb9\x97\x3b\xae\x33\x6d\xc6\xc9\xb6\xa5\x8d\x9d\x7e\xeb\xff\x27\x67\x1b\xa9\x8f\xe2\x8e\x75\x4f\x0d\xeb\x29\xf4\x04\xd5\x7e\x17\x87\x0e\x52\x16\xed\xeb\xc2\x11\xa0\x7f\x77\x65\x60\x27\xed\x25\x7d\x87\xba\xf4\xd2\xf5\xb3\x41\x0f\x77\x37\x90\x40\xfc\x07\xcc\xdc\x2b\x64\x2c\xb8\x7e\x9b\xf4\xb2\xd5\x99\x2c\x5e\xbd\xf6\xc2\x38\xb9\xee\x0c\xbb\x13\xf5\x4a\xff\x3b\x4d\xfc\x59\xec\x0c\x23\x6d\x76\xd0\xa1\x57\x8b\xe0\xe5\x4b\xb0\x01\x9c\xd8\x8f\xb6\x33\x85\xa9\x93\x5b\xbd\xd7\x49\x3f\xbc\xdb\xc0\xef\x21\xb9\xa8\xce\xd5\x34\xed\x07\xac\x1d\xfc\x61\x10\x07\x73\xf0\xd3\xd3\x07\x88\x7e\x5c\xda\x4b\xe3\xd9\x85\xf1\x23\x7d\x13\xa2\xc8\xdc\xa7\xdf\x7f\x00\x00\x00\xff\xff\x03\x00\xb3\xbe\x38\x65\x20\x0a\x00\x00")
 
 func templatesClient_utils_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -208,7 +213,7 @@ func templatesClient_utils_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesDateTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\x49\x2c\x49\x55\xaa\xad\xe5\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\xa8\xae\xd6\x0b\x80\x30\xfd\x12\x73\x53\x81\x12\x5c\x40\x11\xe7\xfc\xbc\x92\xd4\xbc\x12\x08\x2f\x35\x2f\x05\xc8\x00\x04\x00\x00\xff\xff\x38\x57\x68\x75\x42\x00\x00\x00")
+var _templatesDateTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\x49\x2c\x49\x55\xaa\xad\xe5\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\xa8\xae\xd6\x0b\x80\x30\xfd\x12\x73\x53\x81\x12\x5c\x40\x11\xe7\xfc\xbc\x92\xd4\xbc\x12\x08\x2f\x35\x2f\x05\xc8\x00\x00\x00\x00\xff\xff\x03\x00\x38\x57\x68\x75\x42\x00\x00\x00")
 
 func templatesDateTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -228,7 +233,7 @@ func templatesDateTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesGeneric_mainTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\x4f\xcd\x4b\x2d\xca\x4c\x8e\xcf\x4d\xcc\xcc\x8b\x2f\x49\xcd\x2d\xc8\x49\x2c\x49\x55\xaa\xad\xe5\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\x00\x49\x70\x71\xa5\x95\xe6\x25\x83\x99\x1a\x9a\xd5\x5c\x5c\xb5\x5c\xd5\xd5\xa9\x79\x29\x40\x55\x80\x00\x00\x00\xff\xff\xdc\x57\x73\x81\x49\x00\x00\x00")
+var _templatesGeneric_mainTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\x4e\x49\x4d\xcb\xcc\x4b\x55\x50\x4a\x4f\xcd\x4b\x2d\xca\x4c\x8e\xcf\x4d\xcc\xcc\x8b\x2f\x49\xcd\x2d\xc8\x49\x2c\x49\x55\xaa\xad\xe5\x2a\x48\x4c\xce\x4e\x4c\x4f\x55\x00\x49\x70\x71\xa5\x95\xe6\x25\x83\x99\x1a\x9a\xd5\x5c\x5c\xb5\x5c\xd5\xd5\xa9\x79\x29\x40\x55\x00\x00\x00\x00\xff\xff\x03\x00\xdc\x57\x73\x81\x49\x00\x00\x00")
 
 func templatesGeneric_mainTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -248,7 +253,7 @@ func templatesGeneric_mainTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesIndexHtmlTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x91\xc1\x6e\xb5\x20\x10\x85\xf7\xf7\x29\x26\xee\x7f\xc8\x7f\x97\x0d\x97\xa6\x49\x37\xed\xaa\x49\xef\x0b\xa0\x8c\x42\x03\x62\x10\x9b\x1a\xe2\xbb\x77\x94\x36\xb8\xa8\xab\xcf\x61\xce\xcc\xe1\x90\xb3\xc6\xde\x8e\x08\x8d\x1d\x35\x7e\x31\x93\xbc\x6b\xb6\xed\x22\x76\x90\x17\xa0\x4f\x18\x54\xba\xe0\xf1\x9b\x6c\x72\x28\x73\x66\xf7\x1d\xb6\x0d\xde\x31\x7e\x62\x14\xbc\x1c\x14\x0d\xaf\x22\xd1\x06\xbd\x9e\xf4\xe6\xbf\x84\x3f\xd4\x54\xae\x3d\x53\xe5\xbb\xb1\x33\xcc\x47\x13\x10\xa9\x25\x05\xaf\x92\xed\x94\x73\x2b\x0c\x38\x62\x54\x09\x35\xf4\x31\x78\x60\x51\x79\x07\xbd\x75\x08\xed\x0a\x42\x81\x89\xd8\xdf\x1a\x93\xd2\x34\x3f\x70\x3e\xd8\x64\x96\x96\x75\xc1\xf3\xd7\xc5\x4f\x33\xcd\x40\x3e\x84\x7f\xbb\xaa\x91\x3f\x20\xb8\x92\x30\xc5\xf0\x81\x5d\x62\xd5\x11\x3f\x59\x22\xb3\xf1\x7c\xa1\xab\xac\xbb\xd4\x64\x75\xe8\x66\x5e\xe3\x7c\xdc\xa7\xde\xa8\xce\xca\x9e\xa7\xb7\x17\x78\xa6\x16\xd8\x37\xd1\xa8\xeb\x6f\x64\x25\x27\xaa\x1c\xd1\xe7\x8c\xa3\xa6\x97\xf8\x0e\x00\x00\xff\xff\x56\x0e\xa1\x8a\xa2\x01\x00\x00")
+var _templatesIndexHtmlTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x51\x31\x6e\xc4\x20\x10\xec\xef\x15\x2b\xf7\x01\xe5\xca\x13\x47\x14\x29\xcd\xa5\x8a\x74\xf7\x01\x6c\xd6\x86\x08\x8c\x05\x38\x8a\x85\xfc\xf7\x60\x93\x08\x17\xa1\x1a\x96\x9d\x99\xdd\x21\x25\x89\xbd\x1e\x11\x1a\x3d\x4a\xfc\x26\x2a\x5a\xd3\xac\xeb\x89\x6d\x80\x9f\x20\x1f\xa6\x50\xc8\x02\xf7\x6b\xd4\xd1\x20\x4f\x89\x3c\x36\xb0\xae\x70\x47\xff\x85\x9e\xd1\xf2\x50\x38\xb4\x92\x58\xeb\xe4\x72\xe0\xab\x67\x0e\xff\xb0\x73\xb9\xf6\x4c\x15\x3f\x94\x0e\x10\xf6\x26\xc8\x48\xcc\xd1\x59\x11\x75\x27\x8c\x59\x60\xc0\x11\xbd\x88\x28\xa1\xf7\xce\x02\xf1\xc2\x1a\xe8\xb5\x41\x68\x17\x60\x02\x94\xc7\xfe\xda\xa8\x18\xa7\x70\xa1\x74\xd0\x51\xcd\x2d\xe9\x9c\xa5\xef\xb3\x9d\x42\xd6\x40\x3a\xb8\xa7\x8d\xd5\xf0\x5f\xc0\xa8\xe0\x30\x79\xf7\x89\x5d\x24\x75\x22\x7a\x18\x29\x0f\xeb\x8f\x0b\x9d\x79\xf5\x12\x93\x96\xae\x0b\xb4\xc6\xf9\xb2\xa9\x5e\x73\x9d\x14\x9f\xd7\x8f\x1b\xbc\xe5\x16\xd8\x9c\xb2\xd4\xf9\x2f\xb2\x92\x53\xae\xec\xd1\xa7\x84\xa3\xcc\x3f\xf1\x03\x00\x00\xff\xff\x03\x00\x56\x0e\xa1\x8a\xa2\x01\x00\x00")
 
 func templatesIndexHtmlTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -268,7 +273,7 @@ func templatesIndexHtmlTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesInit_pyTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\xd6\x55\x48\x49\x4d\xcb\xcc\x4b\x55\x50\xca\xcc\xcb\x2c\x89\x2f\xa8\x54\x52\xd0\xad\xad\xe5\x02\x49\xa4\xe6\xa5\x80\xd9\x80\x00\x00\x00\xff\xff\x6d\xcf\x4b\xe3\x25\x00\x00\x00")
+var _templatesInit_pyTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xaa\xae\xd6\x55\x48\x49\x4d\xcb\xcc\x4b\x55\x50\xca\xcc\xcb\x2c\x89\x2f\xa8\x54\x52\xd0\xad\xad\xe5\xaa\x06\x4a\xa4\xe6\xa5\x80\xd9\x00\x00\x00\x00\xff\xff\x03\x00\x6d\xcf\x4b\xe3\x25\x00\x00\x00")
 
 func templatesInit_pyTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -288,7 +293,7 @@ func templatesInit_pyTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesInput_validators_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x90\x4f\x4b\x03\x31\x10\xc5\xef\xf9\x14\xcf\x42\x49\x05\x29\x9e\x85\x1e\x3d\x7a\xf5\xba\xc4\x66\x62\x07\x37\x99\x65\x92\x28\xb2\xf4\xbb\x9b\x74\x17\xab\x7d\xa7\x65\xf8\xbd\x3f\x9b\x79\xf6\x14\x38\x11\x36\x9c\xa6\x5a\x86\x4f\x37\xb2\x77\x45\x34\x0f\xd3\x77\x39\x49\xda\x9c\xcf\x26\xa8\x44\x7c\x95\x20\x1a\xf3\xfe\x4a\x80\xe3\x24\x5a\xf0\xba\x5c\x58\xd2\xb3\xaa\xa8\x31\x2d\x12\xb1\x8e\x85\xa7\x91\x06\x09\xbb\xfe\x7d\xff\x64\xd0\x64\xad\xc5\xf1\x44\xc7\x0f\x70\x40\x8b\xaa\x04\xce\x2b\x4c\x90\xc5\xd7\x20\x73\xa1\x23\xe5\xec\xde\x09\x07\xd8\x97\x9a\x0b\xde\xe8\x37\xb7\xb3\x5b\x6f\xb1\xc5\x12\xbf\x18\x7a\xf3\xf0\xb7\xba\x6f\x7e\x40\x60\x1a\xfd\xba\xa0\xab\x75\x5f\x4e\xfb\x36\xdb\xb5\x88\xee\xc0\xdd\x01\x8f\x57\xa4\x4b\x1d\x67\xba\xfd\xbd\xdd\x3a\x6a\x6d\x54\x2a\x55\xd3\xbf\x52\x33\xcf\x94\x7c\x7b\xb7\x9f\x00\x00\x00\xff\xff\x71\x8f\x4e\xd5\x5d\x01\x00\x00")
+var _templatesInput_validators_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x50\xc1\x4e\xc3\x30\x0c\xbd\xf7\x2b\xcc\xd8\xd4\x21\x6d\x15\xe7\x49\xbb\x01\x37\x38\x21\xae\x55\xd6\x38\x9d\x45\x9b\x4c\x8e\xbb\x31\x55\xfb\x77\x9c\xb6\x63\x80\x2f\xb1\x9e\xde\xf3\x7b\x79\x7d\x6f\xd1\x91\x47\x98\x91\x3f\x74\x52\x1e\x4d\x43\xd6\x48\xe0\x58\x1e\xce\xb2\x0f\x7e\x76\xb9\x64\xf7\xf0\xbe\xa7\x08\x8e\x1a\x04\x7d\x4d\x27\x61\x5d\xa3\x47\x36\x82\x16\x76\x67\xa8\xc3\x9a\x4d\xdb\x28\xf1\x29\x80\x0f\x02\x68\x49\x40\x7e\x44\x4a\xd9\x1b\x6f\x21\x92\xaf\xf4\x84\xc0\x89\x9a\x06\x76\x08\xe1\x88\x7c\x62\x12\x41\x0f\xb6\x63\xf2\xb5\xaa\x10\x3c\x7e\x09\x4c\x0e\x14\x7c\x96\x39\x0e\x2d\x9c\xc4\x05\x6e\x63\x71\xcb\x08\xd4\x1e\x02\x0b\x7c\x8c\x88\x52\x9f\x99\x03\x67\x7d\xbf\x06\x72\x50\xbc\x98\x0a\x25\xea\x0f\x06\x7d\x17\x91\x4b\x37\x40\x57\x61\xdf\xb3\xf1\x35\xc2\x9c\x56\x30\x77\xb0\xd9\xde\x34\x7d\xaf\x17\xe6\x74\xb9\xac\x94\x85\xde\x26\x64\xee\x8a\x37\xd3\x62\x5a\x07\x64\x30\x1a\xb7\x4c\x7b\x84\xb6\x6b\x84\x0e\x0d\x96\xc1\x2d\xd3\xfe\xb0\xc9\x40\x27\xcf\x73\xa8\xf6\x58\x7d\xa6\x50\x9a\xbe\x1b\x6a\x1c\xc9\xda\xc1\xa8\x53\x52\x36\xb0\x5b\x8c\xd1\x68\xa6\x2d\xe4\xaf\x5d\x94\x54\xd3\xf5\x6e\xe2\x2e\x6c\x0e\x0b\x18\xcf\x8f\x82\xe4\x5c\xfe\xb6\x4e\x35\xad\xb4\x79\x6c\xec\x94\x20\x8d\x7a\x0f\x50\xa1\x4d\x19\x3d\x91\x14\x70\xb7\x85\xc7\x1b\x25\x0d\x1b\x8a\xf8\xbf\xd1\xe5\x14\x6a\x72\x64\x94\x8e\xfd\x1f\xd3\xec\x5a\xc9\x37\x00\x00\x00\xff\xff\x03\x00\xc8\x3a\x57\x9f\x52\x02\x00\x00")
 
 func templatesInput_validators_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -308,7 +313,7 @@ func templatesInput_validators_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesOauth2_middlewareTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x55\x5b\x4f\xdb\x4c\x10\x7d\xce\xfe\x8a\xf9\xfc\xf0\xd5\x46\x60\xe8\xe5\x09\x29\x2f\xad\xda\x52\xa9\xa5\x14\x52\xf1\x50\x55\x68\xb1\xc7\xd8\xc2\xd9\x75\x77\x37\xb8\xc8\xf2\x7f\xef\xec\x2d\x04\x12\xd3\x46\x49\x64\xcf\xe5\x9c\xd9\x33\x33\xf6\x30\x1c\x40\x89\x55\x23\x10\x12\xc9\x57\xa6\x7e\x75\xb5\x6c\xca\xb2\xc5\x9e\x2b\x4c\xe0\x60\x1c\x59\xc7\x8b\x5b\x7e\x83\x30\x0c\xf9\x99\xbf\x3c\xe5\x4b\x24\x07\x6b\x96\x9d\x54\x06\x52\x36\x4b\x04\x9a\xc3\xda\x98\x2e\x61\x19\x63\x87\x87\xf0\xd5\x61\x51\x8a\x8f\xfd\xb2\xc6\x84\x46\x83\x27\x82\x07\x22\xa8\xa4\x82\x75\x30\x33\xf7\x1d\x3e\x83\xa0\x8d\x5a\x15\x06\x06\x36\x2b\x51\x17\xaa\xb9\xc6\xf2\xed\xbd\xb5\x36\xe2\x86\xcd\xaa\x06\xdb\x12\xfc\x27\xda\x74\x21\x3b\xd4\xde\xf6\xe3\x67\xb0\x8e\xae\xd0\x53\xec\x27\x99\x0a\x85\xdc\x20\x08\xec\xff\x5a\x0d\xab\x56\xa2\x78\x16\x2c\x0d\x45\x44\xfe\x0c\xf6\xa6\x41\x07\x66\x4b\x95\x4b\x38\x9e\x4f\x53\x93\x00\xe1\x64\xc7\xe1\xb8\xee\x66\x9f\xcd\x46\x97\x3e\x0c\xd0\x54\x90\x9f\x20\x2f\x51\x8d\x63\x80\xcc\x37\x45\x9b\x43\x52\x3b\xb7\x4e\xa2\xdb\xeb\x47\x0e\x62\xf4\xa9\x81\x38\x89\xa0\xd8\x6a\x74\xc8\xdf\x56\xa8\xee\xcf\xb8\xe2\x4b\x0d\x93\xf8\xbf\xd6\x41\x68\xa6\x78\x36\x80\xb6\xc8\x44\x19\xb1\x15\x9a\x95\x12\xf0\xbf\x5c\x86\xde\xbd\xab\xb1\xb8\xbd\xf0\xb2\x16\xf6\x5a\x43\x5f\xa3\xa9\x51\xc1\x4a\xd3\x5f\xcd\x35\x35\x0f\x4b\x2c\x83\x34\xbe\x4b\x29\xe9\x3a\xad\x7d\xb6\x09\xbb\xdd\xb4\x6b\x29\x5b\x3b\x7a\x74\xfe\x16\x05\x41\xe5\x3e\x24\x83\xf9\x1c\x8e\xac\x67\x16\xea\xa4\xb9\x40\xdb\x0a\x1a\x49\x9a\xee\xab\x7d\xe0\x6d\x2b\x7b\xaa\x85\x7a\xaa\xb8\xa0\x8d\x5a\x27\xbb\xb4\x10\xe5\x2c\x0f\x31\x1b\x01\x96\xd3\x7b\x89\x2a\x82\x39\xc7\x63\xca\x19\x91\xba\x1f\x7d\x83\xa3\xe2\xd4\xb3\xa0\xda\x09\x17\x74\x54\x15\xe5\x3c\x59\x2c\xce\x48\xa9\x68\xeb\x14\x6a\x14\x86\x9b\x46\x0a\x90\x15\x98\x9a\x16\xf6\x61\x53\xff\x4d\xc1\x40\x91\x0a\xfc\x6d\xc0\x3e\x16\xf2\x60\xc9\x1e\xdd\xd9\xe2\x43\x15\x9b\xe6\x0f\x44\x91\x5a\x9e\xb4\xf7\xf6\x73\xd4\x9d\x14\x1a\x2f\x55\x43\x23\xb4\x0f\x0a\xf6\x82\x9d\x86\x4b\x9b\xcc\x89\x70\xc7\x15\xf0\xa2\x40\xad\x17\xf2\x16\x45\xdc\x7d\xf2\xd0\x99\xbd\x03\x8c\xf3\xb8\x51\x71\xcf\x05\xab\xe8\xd3\x89\xdd\x31\xb2\x5e\xe4\x4d\x70\xea\x4e\xfe\xfd\xfc\xb3\x1f\xdc\x34\xcb\x3f\xa2\x49\xe3\x4c\x67\x56\xfc\xf5\x92\xec\x80\x8f\x1b\xb7\x1b\x36\xec\xdc\x16\xa2\xaf\xf6\x51\x34\x61\x05\x90\x3e\x77\xda\xf8\xdc\xf4\xcd\xd1\x4b\x9b\x12\xb4\x75\xc9\x5e\x86\xcb\xf3\x4f\x8b\xf7\x50\x48\xaa\x88\xb4\xf0\x42\xb8\x55\x79\xa1\xe3\x86\x78\x21\x9f\x8c\x7d\x48\xf7\xf1\xeb\x40\x2a\xe7\x3f\x2a\x71\x7b\x5d\xb2\xdd\x45\xbd\xde\x51\x94\x9d\x90\xfc\x02\xd5\x1d\xda\x39\x4c\x7b\x6a\x2e\x45\x8d\x19\xcd\xaa\x7d\x35\xd9\xed\xb7\xef\xa0\x3f\x01\x00\x00\xff\xff\xa1\x9b\x07\xa6\xa7\x06\x00\x00")
+var _templatesOauth2_middlewareTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x55\xdb\x6e\xd3\x40\x10\x7d\x8e\xbf\x62\xf0\x03\xd8\x55\xea\x94\xcb\x53\xa5\xbc\x80\x80\x20\x41\x09\x69\x50\x1f\x10\xaa\xb6\xf6\xb8\xb6\xe2\xec\x9a\xdd\x4d\xdd\xc8\xf2\xbf\x33\x7b\x71\x2e\x4d\x52\x88\x92\xc8\x9e\xcb\x39\xb3\x67\x66\xec\xb6\x3d\x87\x0c\xf3\x92\x23\x84\x82\xad\x74\xf1\xe6\x76\x59\x66\x59\x85\x0d\x93\x18\xc2\x79\xd7\x05\x35\x4b\x17\xec\x1e\xa1\x6d\x93\xa9\xbb\xbc\x62\x4b\x24\x47\x50\x2e\x6b\x21\x35\x44\xc1\x20\xe4\xa8\x47\x85\xd6\x75\x18\xc4\x41\x30\x1a\xc1\x77\x8b\x45\x29\x2e\xf6\xdb\x06\x13\x4a\x05\x8e\x08\xb6\x44\x90\x0b\x09\x9b\xe0\x40\xaf\x6b\x7c\x06\x41\x69\xb9\x4a\x35\xb4\xc1\x20\x43\x95\xca\xf2\x0e\xb3\xf7\x6b\x63\x2d\xf9\x7d\x30\xc8\x4b\xac\x32\x70\x9f\xde\xa6\x52\x51\xa3\x72\xb6\x5f\xbf\xbd\xb5\xb3\x85\x5e\x61\x73\x92\x29\x95\xc8\x34\x02\xc7\xe6\x9f\xd5\x04\xf9\x8a\xa7\xcf\x82\x45\xbe\x88\x9e\x3f\x86\xb3\xd3\xa0\x6d\x60\x4a\x15\x4b\xb8\x1c\x9f\xa6\x26\x01\xfc\xc9\x2e\xfd\x71\xed\xcd\x30\x18\x74\x36\xbd\x6d\xa1\xcc\x21\x99\x20\xcb\x50\x76\x9d\x87\x4c\x76\x45\x1b\x43\x58\x58\xb7\x0a\x7b\xb7\xd3\x8f\x1c\xc4\xe8\x52\x3d\x71\xd8\x83\x62\xa5\xd0\x22\xff\x58\xa1\x5c\x4f\x99\x64\x4b\x05\x27\xf1\xff\x6c\x82\x50\x9f\xe2\xd9\x01\x3a\x20\xe3\x59\x8f\x2d\x51\xaf\x24\x87\x97\x62\xe9\x7b\xf7\xa1\xc0\x74\x71\xed\x64\x4d\xcd\xb5\x82\xa6\x40\x5d\xa0\x84\x95\xa2\xbf\x82\x29\x6a\x1e\x66\x98\x79\x69\x5c\x97\x22\xd2\xf5\xb4\xf6\xf1\x2e\xec\x61\xd3\xee\x84\xa8\xcc\xe8\xd1\xf9\x2b\xe4\x04\x95\xb8\x90\x18\xc6\x63\xb8\x30\x9e\x81\xaf\x93\xe6\x02\x4d\x2b\x68\x24\x69\xba\x6f\x87\xc0\xaa\x4a\x34\x54\x0b\xf5\x54\x32\x4e\x1b\xb5\x49\xb6\x69\x3e\xca\x5a\xb6\x31\x3b\x01\x86\xd3\x79\x89\xaa\x07\xb3\x8e\x7d\xca\x01\x91\xda\x1f\x7d\xbd\x23\x67\xd4\x33\xaf\xda\x84\x71\x3a\xaa\xec\xe5\x9c\xcc\xe7\x53\x52\xaa\xb7\xd5\x12\x15\x72\xcd\x74\x29\x38\x88\x1c\x74\x41\x0b\xbb\xdd\xd4\xff\x53\xd0\x53\x44\x1c\x1f\x35\x98\xc7\x42\xe2\x2d\xf1\xde\x9d\x29\xde\x57\xb1\x6b\xfe\x44\x14\x91\xe1\x89\x1a\x67\x9f\xa1\xaa\x05\x57\x78\x23\x4b\x1a\xa1\x21\x48\x38\xf3\x76\x1a\x2e\xa5\x63\x2b\xc2\x03\x93\xc0\xd2\x14\x95\x9a\x8b\x05\xf2\x7e\xf7\xc9\x43\x67\x76\x0e\xd0\xd6\x63\x47\xc5\x3e\x17\x8c\xa2\x4f\x27\xf6\xc8\xc8\x3a\x91\x77\xc1\xa9\x3b\xc9\xcf\xd9\x57\x37\xb8\x51\x9c\x7c\x46\x1d\xf5\x33\x1d\x1b\xf1\x37\x4b\x72\x04\xbe\xdf\xb8\xe3\xb0\x7e\xe7\x0e\x10\x5d\xb5\x7b\xd1\x84\xe5\x41\x9a\xc4\x6a\xe3\x72\xa3\x77\x17\xaf\x4d\x8a\xd7\xd6\x26\x3b\x19\x6e\x66\x5f\xe6\x1f\x21\x15\x54\x11\x69\xe1\x84\xb0\xab\xf2\x4a\xf5\x1b\xe2\x84\x7c\x32\xf6\x3e\xdd\xc5\x6f\x02\xa9\x9c\x17\x54\xe2\xe1\xba\xc4\xc7\x8b\x7a\x7b\xa4\x28\x33\x21\xc9\x35\xca\x07\x34\x73\x18\x35\xd4\x5c\x8a\xea\x62\x9a\xd5\x96\x5e\x4d\x66\xfb\xcd\x3b\xe8\x2f\x00\x00\x00\xff\xff\x03\x00\xa1\x9b\x07\xa6\xa7\x06\x00\x00")
 
 func templatesOauth2_middlewareTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -328,7 +333,7 @@ func templatesOauth2_middlewareTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesOauth2_middleware_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x54\x4d\x8b\xdb\x30\x10\xbd\xfb\x57\x0c\xee\xc5\x09\x5e\xb3\xfd\x38\x2d\x2c\xf4\x54\x7a\x5a\x5a\xe8\xad\x14\xa3\x95\x46\x89\x1a\x45\xca\x4a\x72\x43\x30\xf9\xef\x95\x64\x39\x91\x63\x2f\x2c\xac\x0f\x89\x91\xde\xbc\xf7\xe6\x69\xac\xbe\xbf\x03\x86\x5c\x28\x84\x52\x93\xce\x6d\x3f\xb5\x7b\xc1\x98\xc4\x23\x31\xd8\x1e\x4e\x6e\xab\x55\x09\x77\xe7\x73\xc1\x8d\xde\x03\xef\x14\x75\x5a\x4b\x0b\x62\x7f\xd0\xc6\xc1\xd1\x90\x83\x4d\x7b\x92\xd8\xdd\xb8\xbe\xa9\xc1\xe0\x4b\x87\xd6\xd5\xf0\xd7\x6a\x25\xf8\xa9\x28\xa8\x47\x58\x48\x32\x7d\xdf\x3c\x91\x3d\x9e\xcf\x0f\x05\xf8\xc7\x9b\x80\xb6\x15\x4a\xb8\xb6\xad\x2c\x4a\x5e\x83\xa5\xfa\x80\xf6\xf1\x49\x2b\x5c\x0d\xa0\xf0\xf4\xbd\xe0\xd0\x7c\x47\xc2\xd0\x78\x5b\xe3\x72\x28\x69\x18\x5a\x6a\xc4\x33\xb2\xf6\xf9\x04\x8f\x50\x6e\x23\xca\x96\x53\x14\x17\x28\x59\xd8\xf6\x16\x06\x9e\xe4\xa4\xcc\x44\x50\x5a\x84\xa0\xf4\xb3\x43\x73\xfa\x41\x0c\xd9\xdb\xf7\xcb\x65\x64\x0b\x9a\x80\x8a\xc1\xad\x08\x91\x52\x1f\xbd\xc4\x90\x86\xe7\x19\x5e\x8a\x2c\x35\xea\x31\x97\xd4\x78\x96\xd5\xd7\x78\x3c\x15\x5f\x5d\x56\x42\x01\x43\xaa\x0d\x71\x9e\x33\x1e\xa7\xd0\xaa\x5a\x13\xb3\xb1\x35\xac\xd7\xbb\x63\x78\xcb\x28\xc2\xe3\xf4\x0e\x55\xe8\xa0\x9c\x2c\xfb\x74\x16\x62\xc8\x72\x98\xb2\xe4\x4c\x69\x36\x9a\x84\x6c\x36\xe8\xaa\x6b\x58\xb5\x57\x5a\x4d\x6a\x51\xbe\x2a\xf6\x72\x89\x14\xdd\xdb\x44\x43\x87\x51\xb1\x24\x94\xa2\xb5\x6d\x04\x94\x83\xea\x6d\x87\xa9\x38\x34\x3f\x67\x36\xe8\x3a\xa3\xc6\x01\xaf\x56\x35\x7c\xb9\xff\x38\xa5\xd8\x34\xb9\x88\x77\x11\xff\xa7\x98\x0f\x70\x30\xfa\x9f\x60\x08\x54\xfb\x1f\xa7\x81\x6e\x91\xee\xd2\x49\x83\xf6\x2e\xb6\x08\x39\xcf\xa4\xfc\x32\x19\xbf\xff\xcc\xec\xc7\xcc\x22\x5b\x1a\xa0\x6a\xf8\x5b\x85\x96\xbe\x11\x3f\xe4\x6f\xeb\xea\x73\xb1\x80\xe0\xb3\xb9\x29\x6e\x10\xf3\x51\xbb\xce\xed\xd4\x55\xf6\xc5\x67\xd3\x37\x76\x70\xf3\x11\x08\x0b\xe1\x56\x00\x6d\x40\xa2\xaa\x16\x20\xb1\xbf\xfb\x87\x25\xd7\xbf\x4c\x87\xd7\x9c\xb8\xe7\x48\xa5\x20\xd4\x92\xda\x94\x24\xe0\x6d\x44\x2e\x6c\x8e\x96\x83\x78\x22\x99\x03\x5e\xb5\x92\x16\xe3\xa9\x14\xe1\x62\x0e\xd7\x41\xb8\x7a\xff\x07\x00\x00\xff\xff\xfb\x91\x93\x2b\xa5\x05\x00\x00")
+var _templatesOauth2_middleware_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xa4\x54\x4d\x8b\xdb\x30\x10\xbd\xfb\x57\x0c\xee\xc5\x09\x5e\xb3\xfd\x38\x2d\x2c\xf4\x54\x7a\x5a\x5a\xe8\xad\x14\xa3\x95\x47\xb1\x1a\x59\xf2\x4a\x72\x43\x30\xf9\xef\x95\x64\x39\x91\x13\x2f\x2c\xac\x0e\xb6\x19\xbd\x79\xef\xcd\x68\xac\x71\xbc\x83\x06\x19\x97\x08\xb9\x22\x83\x6d\x3f\xd5\x1d\x6f\x1a\x81\x07\xa2\xb1\xee\x8f\xb6\x55\x32\x87\xbb\xd3\x29\x63\x5a\x75\xc0\x06\x49\xad\x52\xc2\x00\xef\x7a\xa5\x2d\x1c\x34\xe9\x4d\xdc\x13\xc4\xec\xe7\xf8\xae\x04\x8d\x2f\x03\x1a\x5b\xc2\x5f\xa3\x24\x67\xc7\x2c\xa3\x0e\x61\x20\xca\x8c\x63\xf5\x44\x3a\x3c\x9d\x1e\x32\x70\xcb\x99\x80\xba\xe6\x92\xdb\xba\x2e\x0c\x0a\x56\x82\xa1\xaa\x47\xf3\xf8\xa4\x24\x6e\x26\x90\x5f\xe3\xc8\x19\x54\xdf\x91\x34\xa8\x9d\xad\x39\xec\x53\xaa\x06\x0d\xd5\xfc\x19\x9b\xfa\xf9\x08\x8f\x90\xb7\x01\x65\xf2\x25\x8a\x71\x14\x8d\xdf\x76\x16\x26\x9e\xe8\x24\x4f\x44\x50\x18\x04\xaf\xf4\x73\x40\x7d\xfc\x41\x34\xe9\xcc\xfb\xe5\x12\xb2\x15\x4d\x40\xd9\xc0\xb5\x08\x11\x42\x1d\x9c\xc4\xd4\x0d\xc7\x33\x7d\x64\x49\xd7\xa8\xc3\x9c\xbb\xc6\x92\x5e\x7d\x0d\xc7\x53\xb0\xcd\x39\xe2\x13\x1a\xa4\x4a\x13\xeb\x38\xc3\x71\x72\x25\x8b\x2d\xd1\x3b\x53\xc2\x76\xbb\x3f\xf8\xaf\x84\xc2\x2f\xab\xf6\x28\x7d\x05\xf9\x22\xec\xba\xb3\xd2\x86\xa4\x0f\x4b\x96\x94\x29\xce\x46\x15\x91\xd5\x0e\x6d\x71\x69\x56\xe9\x94\x36\x8b\x5c\x14\xaf\x8a\xbd\x9c\x5b\x8a\xf6\x6d\xa2\xbe\xc2\xa0\x98\x13\x4a\xd1\x98\x3a\x00\xf2\x49\xf5\xba\xc2\x98\xec\x8b\xbf\x65\xd6\x68\x07\x2d\xe7\x01\x2f\x36\x25\x7c\xb9\xff\xb8\xa4\xd8\x55\xa9\x88\x73\x11\xde\x4b\xcc\x07\xe8\xb5\xfa\xc7\x1b\x04\xaa\xdc\xc3\x2a\xa0\x2d\xd2\x7d\x3c\x69\x50\xce\x45\x8b\x90\xf2\x2c\xd2\xcf\x93\xf1\xfb\x4f\xb6\x7a\x40\x81\x2d\x0e\x50\x31\xbd\x36\xbe\xa4\x6f\xc4\x0d\xf9\xdb\xaa\xfa\x9c\xad\x20\xd8\xcd\xdc\x64\x57\x88\xdb\x51\xbb\xcc\xed\xd2\x55\xf2\xc7\x27\xd3\x37\x57\x70\xf5\x13\x70\x03\xfe\x56\x00\xa5\x41\xa0\x2c\x56\x20\xa1\xbe\xfb\x87\x35\xd7\xbf\xf4\x80\x97\x3e\x31\xc7\x11\x53\x81\xcb\x35\xb5\x25\x89\xc7\x9b\x80\x5c\xd9\x9c\x2d\x7b\xf1\x48\x72\x0b\x78\xd5\x4a\x0c\x86\x53\xc9\x46\x77\x31\xfb\xeb\xc0\x5f\xbd\xff\x01\x00\x00\xff\xff\x03\x00\xfb\x91\x93\x2b\xa5\x05\x00\x00")
 
 func templatesOauth2_middleware_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -348,7 +353,47 @@ func templatesOauth2_middleware_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesPython_server_resourceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x53\x4d\x6b\xdb\x40\x10\xbd\xe7\x57\x0c\xc1\x20\x09\x64\x91\x43\x4f\x01\x41\x93\xd2\x42\xa0\x2d\xa1\x94\x5e\x42\x11\xdb\x68\x64\x6f\xa3\xdd\x55\x66\x57\x0e\xc6\xd5\x7f\xef\xec\x57\xe3\x3a\xd6\xc5\xb3\xf3\xf1\xde\xbc\xe1\xf9\x70\x58\x43\x8f\x83\xd4\x08\x97\x84\xd6\xcc\xf4\x88\xdd\xb4\x77\x5b\xa3\x3b\x87\x6a\x1a\x85\xc3\x4b\x58\x2f\xcb\x85\xef\x5c\x89\x49\x7e\x15\x0a\xe1\xba\x85\x26\x04\xbe\x32\x90\x51\x30\x8c\xc2\x3e\x81\x54\x93\x21\x07\xb7\xe3\x8c\x13\x49\xed\x6a\xf8\x6d\x8d\x96\xc3\xbe\x06\xc2\xe7\x19\xad\x63\x1c\x20\xa1\x37\x08\xab\xa7\x1a\x56\xbb\x00\xf5\x45\xf6\xfd\x88\x2f\x82\x37\xb8\x21\x0a\xa0\x09\xe9\x70\x58\xed\x9a\xbb\x10\xdf\x0b\xb7\x5d\x16\x10\x36\x26\x3d\x7d\x58\x0b\x50\xf7\x10\xa3\x37\xc0\xdf\xf0\xf9\xd6\xf4\x12\x2d\xe4\x3d\xfd\x2c\xa3\x1c\xc1\xff\x07\xc2\x61\x14\xf6\x07\xbe\x9b\xcf\xe6\x05\x89\xb3\x1d\xcb\x86\xf6\x55\x55\x59\xbc\xe9\x8a\x4d\x45\x0d\x5d\xa7\xb9\xd0\x75\xd5\x79\xa1\xc8\x97\xed\xc3\x36\x17\xef\x99\x3c\xdf\xf3\x04\xa7\x21\x33\x3b\xf4\x34\x2c\xf4\xa3\xee\x27\xc3\xac\xcb\xc2\xf0\x2a\x02\xb4\x0f\xb1\xf6\x03\xe9\x17\xe7\x7f\x7a\xb6\x4c\xa6\x3c\x9b\xf2\x74\xdc\x70\xe6\xb2\x9e\x77\xa7\xd2\xfd\x9a\xe3\x47\xf9\x10\x5f\x37\xb4\xb1\xcb\x12\x40\xfd\x59\xfc\x10\x7b\x24\x9e\x3d\x2a\x48\xfd\x21\x73\x2f\x48\x28\xee\xaf\xae\x2f\x80\xbf\xa2\x28\xc2\xef\xeb\x42\x83\x5f\x68\x48\x0b\x7d\x9a\xf5\xe3\x07\xa3\x14\x6a\x67\x03\x72\xec\xe5\x86\x7f\x71\xe6\xf4\xaf\x3b\x07\xd2\xc2\x56\x68\x56\x41\x30\x18\x82\x23\xdd\x70\x72\x9f\x13\x7e\x90\x43\x76\xc0\x1e\x52\x55\xea\x69\x66\xe2\x96\xcb\xb9\xc4\x47\xf0\xce\xe8\xbc\x53\xcb\xe4\xd2\x66\x83\x2e\x26\xaa\x2a\xce\x0d\xa0\x8d\x4b\xe3\xcd\x4e\x8c\xb2\xe7\x7f\x46\x99\x34\xfb\x8f\xd0\xcd\xa4\xb3\xdf\x4b\x24\x32\x64\xdb\x34\x10\x5f\x55\x0d\xef\xae\xae\xf2\x76\xc9\x71\x67\x66\xab\x6c\xc8\x75\x74\x64\x0e\xff\x06\x00\x00\xff\xff\xce\xc6\xbe\xec\xad\x03\x00\x00")
+var _templatesParams_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x56\x4b\x6f\xd3\x40\x10\xbe\xe7\x57\x0c\x56\x41\x36\x4a\xdc\x7b\x51\x4f\xa8\x88\x48\x08\x95\x96\xf6\x52\x55\xd5\xd6\x19\x27\x56\xfc\xca\x7a\x6d\xa8\x56\xfe\xef\xcc\x3e\x6c\xaf\xd3\xa4\x49\x00\x9f\xf6\x31\xcf\xef\x9b\x99\xb5\x94\x33\x58\x60\x9c\xe4\x08\x5e\xc9\x38\xcb\xaa\xa7\x65\xe1\xc1\xac\x6d\x27\x25\x8b\xd6\x6c\x89\x20\x65\x78\x6d\x96\xdf\x59\x86\x74\x31\x49\xb2\xb2\xe0\x02\xfc\x09\xd0\x27\x25\x70\x96\x93\xdc\xd9\x7a\x0a\x67\x0d\x5c\x5c\x42\x38\xd7\x02\xd7\x4c\xac\x2a\x6d\x0a\xec\xe7\x91\xf0\xd9\x1a\xda\xd6\xeb\x54\x31\x5f\x68\x89\x60\x32\x39\x3f\x57\xae\x8c\x0f\x58\x15\xe9\xa2\x02\xb1\x42\xd0\x51\xa1\x40\x5e\x41\x11\x2b\x89\x7b\xe4\xcf\x24\x41\xab\xab\x7c\x51\x16\x49\x2e\x48\x5f\xbc\x94\xe8\xa8\x57\x82\xd7\x91\x00\x69\xdd\xcc\x6c\x88\xe1\x97\x04\x95\x5d\x1b\xd2\x20\x4f\xab\x9f\x64\x81\x56\x26\x8a\x79\x4e\xcb\xde\xb3\x81\x80\x36\x6d\x2b\x65\x12\x43\x78\x83\x9b\x3a\xe1\xb8\x68\xdb\x29\x70\xbb\x96\x92\x72\xe9\x0d\xcf\x74\x66\xb4\x6d\x75\x62\xf7\x2c\x4d\x16\x4c\x20\x44\x2b\x8c\xd6\x26\xb1\x86\xa5\x35\xea\xa4\xc6\x69\x4e\xe2\x3a\x8f\xc0\x2f\x87\xf0\x82\x5e\xdf\x0f\x00\x39\x2f\xf8\xe1\xcc\x66\x40\x81\x92\x60\x68\x55\x0b\xc2\x8f\x00\xab\x33\x08\xef\xf2\x64\x53\xe3\x5c\x60\xe6\xc8\x2b\xf1\x70\x5e\x5d\x2b\x3c\x29\xe3\x8e\x35\x3a\x2d\xc3\x01\xa7\x77\x97\x90\x27\xa9\xe3\x1d\xd3\x0a\x7b\x61\xb9\x9d\x7c\xc7\xbb\x0d\xc7\x8d\xc5\xb9\xa5\x1b\x4a\x4a\x15\x4e\xd3\xdd\x1b\x49\x5f\xb1\xad\x50\x52\x38\x7b\x66\x63\xf5\xa9\x84\x82\x4f\x5a\x6d\x14\x52\xf7\x71\x14\x35\xcf\x21\xce\x44\x78\xa5\xf0\x8a\x7d\x6f\xe0\xf0\x02\xde\x37\xde\x54\x29\x07\xbd\xd2\x38\xd6\x3d\xe1\x6b\xf8\x76\x9c\xcf\xab\xdb\x34\x89\xd0\xbd\x8a\x09\xfa\xa7\x29\xe8\x76\x30\x0c\xb9\x30\x8e\x83\xad\x7e\x25\x22\x5a\x91\xec\xf8\x38\x62\x15\x9a\x2a\xaf\xb3\xcf\xb4\xa6\x9c\x2f\x46\x02\xd4\xb7\xac\x4e\xc5\xf8\x70\x77\xf6\xd6\x8c\xde\x29\x30\x9b\x60\xa4\xd4\xee\xc3\x41\xb1\xeb\x64\x65\x23\x1d\x68\x71\x42\x7e\x33\xdc\x9d\xa1\x1e\x0e\x73\x70\x74\x02\x53\x7b\xc8\xdb\x51\xf5\xea\xcb\x14\x43\x19\x2b\x1f\x74\xdd\xc7\x2c\x42\xd9\x3e\x9a\xf1\x21\x5b\xf9\x77\x84\x66\x0f\xcd\x23\x5c\xc2\x0e\x2b\xa3\xa2\x4f\x31\xf7\xb3\x40\x15\xb0\x5a\x39\xe6\x82\xd3\xaa\x39\xd1\x39\x65\x75\x25\xe0\x19\xa1\xd6\x79\x7a\x47\x00\xd6\xee\x6a\xd7\xad\xad\x75\x4d\x1d\x46\x93\xcc\xa9\x77\xe4\x0d\x4d\x89\xd6\x4c\x37\x8a\xa4\xc2\x01\x8c\x52\x6d\x4f\x18\xde\x10\xf3\x22\x03\xa6\x27\x29\x52\x12\x8c\xdc\xdb\x49\x60\xcc\x64\x66\x24\x8e\xdd\xf8\x1c\x3e\xae\x84\x28\xf5\x30\x26\xb5\x00\xfc\xfe\x6e\x6a\xa6\x64\x87\x63\xc3\x38\x38\xe3\xd4\x9d\x90\xe1\x8f\x1a\xf9\x4b\x97\xed\x46\x6f\x14\xc3\xe1\xdd\xcd\x37\x73\xe7\x07\xfb\x60\x52\xea\x5f\x59\x75\x77\x33\xd7\x54\xf4\x65\x45\xee\x2a\x5d\x55\xf5\x6f\x2a\x5f\x5e\xf9\x7c\x8f\x89\xdd\xb3\x7b\x18\x87\xcb\x82\xcc\xa6\xa1\xce\x5b\xbb\x70\x79\xf7\x74\x7b\xdc\x16\x35\x8f\x74\xc6\x1f\x9c\x02\xd2\x57\xc3\x23\xb5\x6f\x52\x5a\x72\x4b\x0d\xd7\xfe\x92\x18\xc4\xca\x70\x78\x88\x6c\x3d\x74\x23\xe2\x15\x9e\xaa\x30\xf4\xc6\x82\x63\xac\x98\xb2\x30\x38\x8f\x8b\x43\x9d\xdb\x0a\xd8\xf5\x02\x3a\xa6\xe8\x11\x54\x0d\x4b\xed\x95\xe4\xcb\x47\xa7\x6f\x6d\x6a\x9b\xb2\xeb\xe9\xd7\x22\xb2\xdd\x7e\x38\x47\x05\xe0\xbc\x9b\xc3\x5b\xb8\x3d\xdf\xdf\x7a\x13\x8d\xff\x07\x97\x27\x35\x0a\x2c\x1d\x94\x9b\x9d\x67\xdb\x68\x3b\x83\xf6\x68\xf5\xa3\x3a\x77\x53\x76\x44\x99\xab\xbe\x70\x91\x2d\xd0\xbc\xc3\x8a\xa9\x6e\xeb\xb2\xb4\xb2\x67\x87\xc9\xb1\xda\x87\x88\xe9\x0c\x9e\xc0\x8e\x13\xe6\x7f\xe3\xc7\x86\xf1\x4f\x24\x9d\x66\xe3\x28\xa6\xac\xc9\xd7\x74\xf5\xcb\xfe\x6f\xf9\x0f\x00\x00\x00\xff\xff\x03\x00\x95\x9d\xab\x6e\xb6\x0b\x00\x00")
+
+func templatesParams_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesParams_goTmpl,
+		"templates/params_go.tmpl",
+	)
+}
+
+func templatesParams_goTmpl() (*asset, error) {
+	bytes, err := templatesParams_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/params_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesParams_helper_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x57\xdb\x6e\xdb\x46\x10\x7d\x16\xbf\x62\x4b\xc0\x01\xe9\xd0\xb4\xe3\x14\x41\xe1\x4b\x81\x04\x8d\xdb\xb4\xb5\xe3\xc6\x4e\x5f\x0c\x23\x58\x89\x43\x69\x6b\x72\xa9\x2c\x97\x72\x5c\x45\xff\xde\x99\xd9\x25\x45\xca\x72\xd1\xa2\x7d\x30\xb4\x97\xb9\x9c\x99\x9d\x39\x43\x2f\x97\x7b\x22\x83\x5c\x69\x10\xe1\x5c\x1a\x59\xd6\x9f\x66\x50\xcc\xc1\x7c\x9a\x56\xa1\xd8\x5b\xad\x82\xb9\x9c\xdc\xc9\x29\x88\xe5\x32\xbd\x74\xcb\x0b\x59\x02\x5e\x04\xaa\x9c\x57\xc6\x8a\x28\x18\x85\xa0\x27\x55\xa6\xf4\x74\xff\x8f\xba\xd2\x21\x1e\xe4\xa5\xa5\x9f\x52\x95\xb0\x5f\x36\x85\x55\x68\x9c\x4f\x34\xd8\xfd\x99\xb5\x73\x5a\x1b\xc8\x0b\x98\xf0\x71\x6d\xcd\xa4\xd2\x0b\xbf\x44\x4b\x75\x18\xc4\x41\xb0\xbf\x2f\x4a\xf9\xe5\xac\x32\xe5\x39\x94\x95\x79\x10\xaa\x16\x76\x06\x74\xa8\xca\xa6\x14\xb5\xfa\x13\x44\x95\x0b\x29\x3a\x27\x22\x47\x69\x71\x3f\x53\x93\x19\x49\xdf\xc1\xdc\x0a\xa5\x45\xc9\xfa\x09\x59\x24\x03\x06\x6a\x4b\x8a\xb4\xce\x55\x01\x35\xc9\xd6\xb6\x32\x90\x89\x4a\x8b\x4c\xd5\x77\x01\x02\x42\xa1\xa1\xff\x53\xf1\xf2\x50\x9c\x9c\x88\xc3\x03\x06\x77\x29\x4d\x0d\x97\x94\x37\x31\xa7\xa5\x43\xb7\x90\x45\x83\x4b\xc6\x65\xe0\x73\x43\xbe\x38\xb9\x60\xc1\x20\x18\x5b\x89\x45\x4a\xea\x0b\xf2\x2a\xc5\xbc\xc2\x33\xbc\xc1\x73\x29\x5c\xf8\x09\xae\x74\x53\x8e\xc1\xd0\x6a\x5c\x55\x05\x48\x4d\x4b\xfb\x30\x07\x1f\xdd\x44\x6a\x31\x06\x7c\x3e\xcc\x3d\x64\x64\x2f\x37\x55\x89\x32\x3f\x5f\xbd\xbf\xf0\x76\x44\x04\xe9\x34\xc5\xb3\x4c\x5a\x88\x93\xa1\xb3\x4a\x83\xcf\x41\x0d\x6c\xb8\x26\x23\x95\x21\x14\x85\x9a\x6c\x5e\x32\xe4\xd7\x1c\x91\xa2\x3c\xad\x43\x2a\x1b\x8c\x70\x26\x17\x80\x9a\x1c\x7c\x1a\xe4\x8d\x9e\xf4\xd2\x13\x69\x14\xed\x62\xf3\x09\xba\xb9\xed\x0e\x04\x83\xca\xe5\x04\x96\xab\x64\xed\x82\x02\x8f\x05\x18\x83\xa0\x96\xc1\x48\xe5\xa2\x00\x1d\x39\xf5\x58\x9c\x9e\x8a\x03\x3a\xa6\xf3\x4e\x85\xf6\x23\x03\xb6\x31\x5a\x60\x15\xa6\x6f\x49\x39\x8f\xc2\x1d\x4e\x76\x2b\x16\x26\x82\x10\xc5\x28\xbc\x0a\x3a\x79\xad\x8a\x00\xf7\xc1\x08\x3d\x88\xa3\x53\xe1\x0b\x34\xfd\x9d\x1c\xbe\xcf\xa3\x45\x9c\xbe\x2d\xa0\x8c\x62\x86\x82\x42\xe9\x2f\x4a\x67\x11\x23\x69\x65\x2f\x2d\x43\x25\x13\xe9\x15\xd8\xa8\x3d\xbf\x80\x7b\x02\x9e\x5e\x63\x2a\xa3\xd6\x4e\x1c\x3b\x49\xac\x2b\xba\x6b\x8d\x13\x84\xa1\x83\x6f\xd6\x0e\xae\xf8\x69\x7c\xd8\x98\x1a\x02\xca\xc5\xc7\x28\xc9\x47\x9b\xe0\x9b\x83\xdb\xf8\x98\x45\x50\x1d\x63\x7b\x32\x37\x4a\xa3\x82\xca\x9c\x9a\xd8\xf9\x4c\x0f\xbf\xb3\x38\xc2\xbf\xb0\x67\xcb\xa5\x2c\x21\x83\x4f\xe5\x4d\x59\x28\xeb\x7e\xe6\xce\xe5\x1d\x30\xe0\x5e\xec\x49\xff\x11\x07\x1b\x34\x8b\xdd\x2b\x54\x22\x9c\x11\xa9\xa7\x5d\x37\x3d\x19\x30\xfb\x4c\xdf\xe9\x0c\xbe\x44\x0a\xed\xd5\xff\x43\xcc\xf5\xe3\x58\x57\x41\xf7\xa4\xec\x11\x8f\x7b\xd1\xaf\x98\x0e\x7e\xe0\x56\x24\xba\xf0\x5d\xe9\xf8\xe0\xe3\x87\x5f\xf7\x98\x21\x89\x5c\x8c\x23\xb0\x21\x5d\xf5\xa9\x82\x0c\x31\x47\x90\x1c\x36\x48\x33\xb1\xbe\x69\xb1\x1f\x1e\x3c\x73\x5c\x0f\x68\x86\x59\x8c\xec\x48\x03\x1b\x0c\xe4\x08\x0e\x8a\xac\xdb\xd5\xd4\x89\x4c\x11\x14\x62\x67\xcd\xb1\xe0\x36\x2e\xfd\x3b\xa3\x4c\x18\xb9\x63\xa5\xdd\x4e\x2f\x3d\x43\x63\x3f\x81\xcc\x90\x1b\x30\xe0\x9b\xdb\xad\x57\x9e\x24\xd6\x49\x8b\x8c\xd8\xa5\xd9\x90\x7e\x70\x99\xd8\xe0\x85\x21\x11\xf8\x42\x30\x29\x93\xcc\x79\x6b\x9f\xed\x0c\x38\x7b\x58\x0e\xcf\x9e\xb5\x3b\xf6\x84\xd5\x70\x51\xd9\x4e\x9b\xab\xc5\x3f\x2b\x8a\xfd\x53\x32\xc0\xf0\x49\x62\x5d\xe2\xbe\x8e\xe9\xf0\xe0\x18\x7f\x4f\x28\x43\xe9\x45\x53\x9e\x51\xd6\x22\x84\xa4\x9e\x3f\x67\x67\xcc\x8b\x28\xe6\xa7\x5e\x7a\x35\x2f\x94\x8d\x48\xda\x89\xaa\x38\xbd\x96\xd3\xf4\x47\x2c\xbb\x90\x87\x2b\x56\x78\x98\x84\x31\xf6\xa3\x6b\x08\x36\x80\xfc\x13\x86\xe2\xeb\xd7\xf5\x6e\x2f\x74\x95\x8f\x43\xcc\x2a\xdd\x80\x6f\xd8\xfa\x5e\x59\x1c\x1d\xfc\x78\x2d\xe4\xce\xd1\xeb\x2c\x33\x48\x4d\xef\xda\x94\xe3\x9a\x90\x40\xcc\xa6\x26\x12\x07\xc1\xee\xd6\x97\x3c\x22\x4f\x88\xc5\xd5\x10\x9a\xa5\xba\xa1\xeb\x3a\x32\x9e\x68\x8f\xb9\xcf\x59\x20\x16\xdf\x7b\xde\x1e\x8d\x76\x1d\x92\x53\xa7\xea\x82\x62\xa0\xce\xdb\x13\x95\xc3\xfe\xd6\xaa\x8f\x9c\xe1\x35\x7e\xd4\x48\xd4\x6c\x91\xf9\x6a\xd9\x18\x48\x38\x69\x52\x2a\x94\x1b\xda\x20\xbf\xb1\x45\xfc\x91\x45\x0d\x5b\x58\x64\x50\x19\x0e\xa6\x23\x86\x47\x44\xd0\x41\x12\xee\xaa\xee\x7d\x68\x70\x8b\x39\xec\x5b\xba\xcd\xf5\x44\x2f\xa4\xcd\x96\xe8\x0d\xd2\xf8\xa9\xce\xf2\x2d\x62\xd2\x41\x5b\x50\x5d\xb4\xb1\x0c\x89\xbb\xdd\x6e\x28\xb0\x45\x97\x1b\x1f\xd7\x9a\x76\xdb\xef\x9d\xf6\x83\xc5\x51\x96\x1f\xff\x2e\x88\xfe\x50\x1a\x76\x0f\xf1\x7b\x1b\x42\xd7\xd3\xbe\x34\x17\xed\xc8\xc3\x23\x2e\x82\x6e\xee\xb1\x02\x3d\xe8\x82\x58\xd8\x6d\x23\xe2\xe1\x81\xd8\x1b\xfc\x64\x20\xa1\x71\xd2\x3e\xba\xff\xb6\x74\x44\x41\xd7\xac\xd4\x56\xc5\x96\x31\xe1\xde\x77\xd5\x7a\x62\x95\xf1\xa6\x1f\xec\x92\xa4\xbf\xf9\x6e\xb0\x7b\xf1\x6a\xb0\x7d\x79\x38\xd8\xbe\xfa\x96\x10\xaa\xed\x08\xf1\x3e\xc2\xf9\xf3\xe2\x00\xe9\xaf\xfd\x5a\x78\xa3\x6c\x1d\xc5\xff\x0a\x35\x99\x51\x9b\xa0\xcf\x8a\x4a\x0e\xc0\xf0\x81\x83\x93\x6f\x87\xc3\x12\x04\xe8\xbf\x80\x71\x46\xf2\x2d\x39\x74\x4c\xd3\xbd\x6a\xb4\xc9\xb2\xfc\x51\xd0\x6b\x66\x6f\x9f\xa8\x30\xfd\xa8\x4b\x44\x38\x93\x45\x74\x73\x3b\x7e\xb0\x10\xb5\xc0\x7f\x6b\x2a\xda\xc5\x31\xa1\x7e\xcc\x6a\xf1\xe3\xa6\x5d\x2e\x05\xe8\x8c\xff\xe1\xf9\x0b\x00\x00\xff\xff\x03\x00\x1f\xa1\x2d\xbb\x13\x0d\x00\x00")
+
+func templatesParams_helper_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesParams_helper_goTmpl,
+		"templates/params_helper_go.tmpl",
+	)
+}
+
+func templatesParams_helper_goTmpl() (*asset, error) {
+	bytes, err := templatesParams_helper_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/params_helper_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesPython_server_resourceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x53\x4d\x6b\xdb\x40\x10\xbd\xfb\x57\x0c\xc6\x60\x0b\x64\x91\x43\x4f\x01\x43\x93\x92\x42\xa0\x2d\xa1\x2d\xbd\x84\x22\xb6\xd6\xc8\xde\x46\xbb\x2b\xcf\xae\x1c\x8c\xab\xff\xde\xd9\x0f\xd9\xaa\x13\x5a\xaa\x8b\x67\x67\xdf\xbc\x99\xf7\x3c\x7b\x3c\x2e\xa1\xc2\x5a\x6a\x84\x29\xa1\x35\x1d\xad\xb1\x6c\x0f\x6e\x6b\x74\xe9\x50\xb5\x8d\x70\x38\x85\x65\xdf\x4f\x8e\x8c\x9c\x89\x56\x7e\x12\x0a\xe1\x7a\x05\x45\x08\xfc\x4d\x4d\x46\x41\xdd\x08\xfb\x04\x52\xb5\x86\x1c\xdc\x36\x1d\xb6\x24\xb5\xcb\xe1\xa7\x35\x5a\xd6\x87\x1c\x08\x77\x1d\x5a\xc7\x3c\x40\x42\x6f\x10\x66\x4f\x39\xcc\xf6\x81\xea\xa3\xac\xaa\x06\x9f\x05\x4f\x70\x43\x14\x48\x13\xd3\xf1\x38\xdb\x17\xf7\x21\x7e\x10\x6e\xdb\xf7\x20\x6c\x4c\xfa\xf6\x61\x2c\x40\x5d\x41\x8c\x5e\x10\x7f\xc6\xdd\xad\xa9\x24\x5a\x18\xe6\xf4\xb5\xcc\x32\xa2\xff\x83\x84\xc3\x28\xec\x17\x7c\x35\x1f\xcc\x33\x12\x67\x4b\x96\x0d\xab\xb3\xaa\xc5\xfc\x05\x2a\x82\xe6\x39\x94\xa5\xe6\x8b\xb2\xcc\x5e\x17\x8a\xec\x6c\x15\xa6\x99\xbc\xe5\xe6\x83\x9f\x17\x3c\x05\x99\xce\xa1\x6f\xc3\x42\xef\x74\xd5\x1a\xee\xda\xf7\x4c\xaf\x22\xc1\xea\x31\xde\x7d\x43\xfa\xc1\xf9\xef\xbe\xdb\xd0\x4c\xf9\x6e\xca\xb7\x63\xc0\x2b\xce\xfa\xbe\x7b\x95\xfc\x2b\xc6\x87\xc5\x63\x3c\xdd\xd0\xc6\xf6\x7d\x20\xf5\xb6\xf8\x22\xde\x91\x68\x7b\x54\x90\xf0\x21\xf3\x20\x48\x28\xc6\x67\xd7\x13\xe0\x6f\x3e\x9f\x87\xdf\xf3\x40\xb5\x1f\xa8\x4e\x03\xbd\xef\xf4\xfa\x9d\x51\x0a\xb5\xb3\x81\x39\x62\x19\x70\x8a\x87\x9e\xfe\x74\xef\x40\x5a\xd8\x0a\xcd\x2a\x08\x6a\x43\x30\xd2\x0d\x17\xfe\x5c\xf4\x07\x59\x43\x9a\xee\x8b\xa3\x6e\xed\x20\x41\xda\x90\xe3\x7f\x94\xff\xc6\xf1\x3d\x2b\x4a\x5b\x5a\x08\xb6\x20\x0b\x60\x26\xd1\xc6\xa5\x9a\x62\x2f\x1a\x59\xf1\x9b\x58\x24\xb5\xfe\x23\x74\x1d\xe9\x61\xd3\x17\x48\x64\xc8\xae\x52\x41\x3c\x65\x39\xbc\xb9\xba\x1a\xe6\x4a\xbb\x16\x4f\xcb\x30\x66\x5c\xd4\xc3\x45\x1a\x77\xa7\x9b\x3b\xbd\xe6\x45\xd6\x1b\x98\xb2\x09\x6a\x3a\x00\xa5\x6e\x3b\x97\xa4\x24\xe4\x48\x85\x87\x66\x27\x42\x6c\x2c\xfe\xb5\xae\xf0\x2f\xa4\xf4\x3a\x4e\x0c\x1b\x74\x31\x91\x8d\x78\xce\xe3\x27\x73\x22\xdb\x7f\x98\x93\x0a\xfe\x61\xce\x45\x6d\x36\xbc\xd3\x65\x7c\xa8\x43\xf8\x1b\x00\x00\xff\xff\x03\x00\xae\x8e\x76\x37\xc4\x04\x00\x00")
 
 func templatesPython_server_resourceTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -368,7 +413,7 @@ func templatesPython_server_resourceTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesRequirements_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x34\x8f\xcd\x6a\xc3\x30\x10\x84\xef\xfb\x14\x26\x77\x09\x4b\x4e\xa1\x17\x5d\x03\x0d\xb4\x3d\xa4\x90\x63\x11\xf1\xda\x51\x1c\xaf\x5c\xad\x44\x7f\x8c\xdf\xbd\xb2\xdc\x82\x2e\x3b\xfa\xf8\x98\x99\x67\x51\xb5\xd8\x39\xc2\x6a\x17\xf0\x23\xb9\x80\x23\x52\xe4\xf7\xe9\x3b\x5e\x3d\xed\x2a\xb1\x2c\x70\xb8\x5b\x1e\x8c\xa9\xa5\xca\x6f\xbb\xc4\x13\x4d\x29\xf2\x1a\x6a\x59\xff\x65\xe7\xb7\x43\xa1\x34\x1c\x1d\xdd\xac\x36\x46\xcb\x47\x78\xb6\x61\x48\xd3\xc9\x76\x58\xe8\x06\x32\xe6\xc3\xc8\xeb\xaf\xfa\x3f\xc4\xf1\xf4\xfa\xb2\xd9\x54\x0d\x67\x0c\xc3\x0f\xa6\xbe\xd8\x94\xdc\x43\x97\xe8\x12\xbd\xbf\x73\x93\xa5\x4d\x86\x1a\xa1\xc1\x45\x6e\x2d\xf5\x18\x7c\xda\x8a\xec\xe1\xc6\x9e\xf8\x72\xc5\xd1\xae\xf6\x87\xec\x67\xf7\x65\x8c\x5a\x9b\xd7\xf0\xc9\x7d\xde\xd7\x15\xab\xd4\x30\xcf\x48\x6d\x19\xf8\x1b\x00\x00\xff\xff\x6e\x92\x79\xc4\x06\x01\x00\x00")
+var _templatesRequirements_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x34\x8f\xcd\x6a\xc3\x30\x10\x84\xef\xfb\x14\x26\x77\x09\x4b\x4e\xa1\x17\x5d\x03\x0d\xb4\x39\xa4\x90\x63\x11\xf1\xda\x51\x1c\xaf\x1c\xad\x44\x7f\x4c\xde\xbd\xb2\xdc\xc2\x5e\x66\x76\xf8\x98\x99\x67\x51\xb5\xd8\x39\xc2\x6a\x13\xf0\x9e\x5c\xc0\x11\x29\xf2\xc7\xf4\x1d\x2f\x9e\x36\x95\x78\x3c\x60\x77\xb3\x3c\x18\x53\x4b\x95\x6f\x55\xe2\x85\xa6\x14\x79\x31\xb5\xac\xff\xbc\xd3\xfb\xae\xa4\x34\xec\x1d\x5d\xad\x36\x46\xcb\x67\x78\xb5\x61\x48\xd3\xd1\x76\x58\xd2\x0d\xe4\x98\x0f\x23\x2f\x5f\xf5\x2f\xc4\xfe\x78\x78\x5b\x69\xaa\x86\x13\x86\xe1\x07\x53\x5f\x68\x4a\x6e\xa1\x4b\x74\x8e\xde\xdf\xb8\xc9\xd0\x26\x87\x1a\xa1\xc1\x45\x6e\x2d\xf5\x18\x7c\x5a\x8b\x6c\xe1\xca\x9e\xf8\x7c\xc1\xd1\x2e\xf4\xa7\xcc\x67\xf7\x65\x8c\x5a\x9a\xd7\xf0\xc9\x7d\xde\xd7\x15\xaa\xd4\x30\xcf\x48\x6d\x19\xf8\x0b\x00\x00\xff\xff\x03\x00\x6e\x92\x79\xc4\x06\x01\x00\x00")
 
 func templatesRequirements_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -388,7 +433,7 @@ func templatesRequirements_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_main_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x53\xc1\x8a\xdb\x30\x10\x3d\x5b\x5f\x31\x88\x3d\xc8\x25\x2b\x87\x42\x2f\x0b\x3d\x2c\x84\xa5\x81\x76\x1b\xb2\xd0\x1e\x17\xd5\x1e\x3b\x43\x6c\x49\x95\xe5\x24\x60\xf2\xef\x1d\xd9\xde\x6c\x2f\xab\x8b\xa5\xd1\x9b\x37\x4f\x6f\xc6\xe3\x78\x0f\x15\xd6\x64\x11\x64\x8f\xe1\x84\xe1\xb5\x33\x64\x5f\x1b\x27\xe1\xfe\x7a\x15\xde\x94\x47\xd3\x20\x8c\xa3\xde\xcd\xdb\x67\xd3\x21\x5f\x08\xea\xbc\x0b\x11\x94\xc8\x64\xeb\x1a\xc9\x1f\x8b\xb1\x38\xc4\xe8\xa5\x10\xc0\x4b\x72\xce\xde\xb9\xb8\x9d\x80\x3b\x13\x0f\xd7\x6b\xd1\xb8\x60\xba\x96\x11\x99\x6c\x28\x1e\x86\x3f\xba\x74\x5d\x8a\x52\xdb\x9a\xa2\x1b\x2e\x72\xce\x6d\x9c\x3f\x36\x9a\x6c\x71\x32\x2d\x55\x26\xba\xa0\x4f\x9f\xa5\xc8\x85\xa8\x07\x5b\x42\xd2\xa8\x72\x18\x27\x70\x51\x00\x59\x3f\x44\xb8\x61\xa7\xf0\x7b\xe6\x0b\xc6\x5f\xf3\x81\x9c\x7d\xe2\x7c\x25\xbb\xa1\x8d\xe4\x5b\xfc\x59\xcb\x15\xcc\xa2\xf4\x8f\x5b\x8c\xcb\x64\x01\x1e\xbe\x02\x0b\xd2\xcf\x78\xde\xbb\x21\x62\x50\xb9\x78\xab\x77\x70\x1d\x82\x67\x37\x18\xa7\xbf\x19\x5b\xb5\x38\xf3\x16\x4c\x97\x14\xaa\x33\x24\x2b\xf4\x1e\x7b\xef\x6c\x8f\xbf\x03\x31\xc3\x0a\x02\x7c\x5a\xe2\x7f\x07\xec\x63\x7a\x42\x96\x4d\x91\x97\xe4\xfe\x13\xb5\xa8\xce\x0c\x5b\x81\x24\x5b\xe1\x45\x1f\x22\xdb\x95\x8b\xec\xba\x14\x1f\x47\xa0\x1a\xf4\xe3\x6e\xbb\x71\x65\xbf\xa1\x00\xdc\x8c\x45\x95\xf1\x54\x71\x70\x3a\x06\x9d\x1c\xdf\x05\xee\xed\x85\x65\x71\x2f\xde\x53\xb8\x0f\x32\x5f\x64\x07\x35\x57\x8f\x81\xfc\xc7\xe8\xd5\xfc\x9a\x24\x6f\xd2\xb9\x64\xf1\xad\x92\xba\x58\xea\x32\x29\xaf\x37\x95\x68\xab\x24\x4d\x64\xbc\x0f\xc6\xf2\x0c\xdd\x1d\x57\x70\x77\x4a\xb6\x26\x5b\xdc\x10\x4a\xec\x37\x58\x27\x14\x83\xf4\x3c\x58\x5b\xcb\x3e\xd5\xa6\xc4\xc9\xf3\x5e\xb1\x13\xb7\x3b\xd6\x34\xb2\x0f\xd9\xff\xec\x3c\x7b\x7a\x17\xc8\xc6\xd6\x2a\xd9\x47\x13\x22\xd9\x06\xe6\x51\x4e\xbe\x4d\x3a\xbf\x53\x1f\xd1\x3e\xda\x6a\x12\xaf\xe4\xc3\x97\xf5\x7a\xcd\x8f\x0a\xb9\x60\x8e\xf4\x0f\x24\xbe\x34\xef\xff\x02\x00\x00\xff\xff\xcc\x4a\x63\xcd\x10\x03\x00\x00")
+var _templatesServer_main_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x74\x53\xc1\x6a\xdc\x30\x10\x3d\x5b\x5f\x31\x88\x1c\xe4\xe2\xc8\x4b\xa1\x97\x40\x0f\x81\x25\x74\xa1\x4d\xcd\x06\xda\x63\x50\xed\xb1\x2d\x56\x96\x5c\x59\xde\x5d\x30\xfe\xf7\x8e\x6c\x67\x93\x4b\x75\xb1\x34\x7a\xf3\xe6\xe9\xcd\x78\x9a\xee\xa1\xc2\x5a\x5b\x04\x3e\xa0\x3f\xa3\x7f\xed\x94\xb6\xaf\x8d\xe3\x70\x3f\xcf\xac\x57\xe5\x49\x35\x08\xd3\x24\x8b\x75\xfb\xac\x3a\xa4\x0b\xa6\xbb\xde\xf9\x00\x82\x25\xdc\xb8\x86\xd3\xc7\x62\xc8\xdb\x10\x7a\xce\x18\xd0\xe2\x94\x73\x74\x2e\x1c\x16\x60\xa1\x42\x3b\xcf\x79\xe3\xbc\xea\x0c\x21\x12\xde\xe8\xd0\x8e\x7f\x64\xe9\xba\x18\xd5\xc6\xa8\xbc\x1b\xaf\x7c\xcd\x6d\x5c\x7f\x6a\xa4\xb6\xf9\x59\x19\x5d\xa9\xe0\xbc\x3c\x7f\xe6\x2c\x65\xac\x1e\x6d\x09\x51\xa3\x48\x61\x5a\xc0\x79\x0e\xda\xf6\x63\x80\x1b\x76\x09\xbf\x67\xbe\x60\xf8\xb5\x1e\xb4\xb3\x4f\x94\x2f\x78\x37\x9a\xa0\x7b\x83\x3f\x6b\x9e\xc1\x2a\x4a\xfe\xb8\xc5\xa8\x4c\xe2\xe1\xe1\x2b\x90\x20\xf9\x8c\x97\xa3\x1b\x03\x7a\x91\xb2\xb7\x7a\xad\xeb\x10\x7a\x72\x83\x70\xf2\x9b\xb2\x95\xc1\x95\x37\x27\xba\xa8\x50\x5c\x20\x5a\x21\x8f\x38\xf4\xce\x0e\xf8\xdb\x6b\x62\xc8\xc0\xc3\xa7\x2d\xfe\x77\xc4\x21\xc4\x27\x24\xc9\x12\x79\x89\xee\x3f\x69\x83\xe2\x42\xb0\x0c\xb8\xb6\x15\x5e\x65\x1b\xc8\xae\x94\x25\xf3\x56\x7c\x9a\x40\xd7\x20\x1f\x8b\xc3\xde\x95\xc3\x5e\x7b\xa0\x66\x6c\xaa\x54\xaf\x2b\x0a\x2e\x47\x2f\xa3\xe3\x85\xa7\xde\x5e\x49\x16\xf5\xe2\x3d\x85\xfa\xc0\xd3\x4d\xb6\x17\x6b\xf5\xe0\x75\xff\x7f\x74\xb6\xbe\x26\xca\x5b\x74\x6e\x59\x74\x2b\xb8\xcc\xb7\xba\x44\x4a\xeb\x4d\x25\xda\x2a\x4a\x63\x09\xed\xbd\xb2\x34\x43\x77\xa7\x0c\xee\xce\xd1\xd6\x68\x8b\x1b\x7d\x89\xc3\x1e\xeb\x88\x22\x90\x5c\x07\xeb\x60\xc9\xa7\x5a\x95\xb8\x78\x3e\x08\x72\xe2\x76\x47\x9a\x26\xf2\x21\xf9\xc8\x4e\xb3\x27\x0b\xaf\x6d\x30\x56\xf0\x21\x28\x1f\xb4\x6d\x60\x1d\xe5\xe8\xdb\xa2\xf3\xbb\x1e\x02\xda\x47\x5b\x2d\xe2\x05\x7f\xf8\xb2\xdb\xed\xe8\x51\x3e\x65\xc4\x31\xd1\x3f\x10\xf9\xe2\xbc\xff\x03\x00\x00\xff\xff\x03\x00\xcc\x4a\x63\xcd\x10\x03\x00\x00")
 
 func templatesServer_main_goTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -408,7 +453,7 @@ func templatesServer_main_goTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_main_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x52\xcd\x6a\xdc\x30\x10\xbe\xeb\x29\x06\x13\xb0\x16\x12\xe7\xbe\xd4\xa5\x69\x77\xb7\x14\x42\x28\xe9\x42\x0f\x21\x08\x65\x3d\xb6\x95\x5a\x92\x91\xe4\xa4\x41\xf5\xbb\x77\x64\xc7\x6c\x7f\x16\xe2\x83\xc1\x33\xf3\xfd\xf8\x9b\x89\xf1\x02\x2a\xac\x95\x41\xc8\x3c\xba\x27\x74\x42\x4b\x65\x44\xff\x12\x5a\x6b\x32\xb8\x18\x47\x56\x3b\xab\xa1\xee\xa4\xff\x01\x4a\xf7\xd6\x05\xd8\xa5\x8f\x73\xf0\x68\x2a\x91\xba\xa2\x52\x0e\x0f\xc1\xba\x97\xa5\xa8\x3a\x64\xaf\xc3\xcf\xa1\xb6\x4e\x7b\xf1\xe8\xad\x61\x31\x82\x93\xa6\x41\x38\x23\xfc\xd9\x13\xac\x4b\x28\x6e\xd1\xdb\xc1\x1d\xd0\x6f\xb0\x3e\x0a\xc6\x58\xdc\x48\x8d\xf0\x0b\xf6\xf6\xda\x3e\xa3\x1b\xc7\x45\xfe\x44\x4b\xc8\x5e\x11\x39\x69\x03\x11\x30\xd9\xf7\x50\xce\x36\xb9\x10\x86\x86\x85\x58\x4d\xe5\xe2\x60\x4d\xad\x9a\xbb\xec\xfb\x7e\x27\x3e\x7d\xbb\xdd\x89\xed\xcd\xd5\xc7\xeb\xed\x26\xbb\x4f\x08\xd9\x79\x64\x7f\x3a\x2e\x94\x51\x81\x13\x36\xc6\xb7\x8d\x27\x7e\x87\x8d\xf2\x81\x72\x7c\xe8\x06\xec\x9d\x32\x81\xff\xe7\x17\x66\xc3\xab\xd9\x71\x32\x4c\xb9\xa8\x1a\x8a\xab\xaf\x5f\x36\xf6\xe0\x37\x2a\x8d\xb0\x0f\x13\x9f\x1d\x02\xf2\xfc\x92\x48\x8e\xdd\x71\xbc\x7c\xd7\xcb\xd0\xae\xd3\xeb\x7d\xbe\x62\xb4\xc3\x39\xf9\x47\xcf\x53\x6d\xb5\x66\x40\x8f\xc3\x30\x38\x73\x6a\x51\x3c\xff\x87\x30\x3f\x87\x09\x98\xac\x2c\x31\xfe\x65\x80\x06\x34\xd2\x55\x54\xbe\xbc\xcb\x3f\x6f\xf7\xf9\xfd\x2c\xdb\x5a\x8d\xfc\x94\x1e\xdd\x00\xcf\x95\xa9\xf0\x67\xd1\x06\xdd\x91\x4b\x46\xff\xb8\xac\x03\xca\x12\x32\x31\x5f\x9b\xc8\x66\xf8\x24\x37\x18\x5e\xe1\xc3\xd0\x94\x7b\x37\xe0\x6b\x44\x53\xba\xbf\x03\x00\x00\xff\xff\xae\x29\x40\x74\xac\x02\x00\x00")
+var _templatesServer_main_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x84\x52\x6d\x6b\xc2\x30\x10\xfe\x9e\x5f\x71\x14\xa1\x11\xb4\x7e\x97\x75\xcc\x4d\x1d\x03\x91\xe1\x84\x7d\x10\x09\xd5\x5e\x35\xae\x4d\x4a\x92\xea\x46\xd7\xff\xbe\xa4\xb5\xb8\x17\x61\xfd\x50\xe8\xdd\x3d\x2f\x7d\xee\xca\xb2\x0f\x31\x26\x5c\x20\x78\x1a\xd5\x11\x15\xcb\x22\x2e\x58\xfe\x61\xf6\x52\x78\xd0\xaf\x2a\x92\x28\x99\x41\x92\x46\xfa\x0d\x78\x96\x4b\x65\x60\xea\x3e\x7a\xa0\x51\xc4\xcc\x75\x59\xcc\x15\x6e\x8d\x54\x1f\x6d\x91\xa7\x48\xce\xc3\x27\x93\x48\x95\x69\x76\xd0\x52\x90\xb2\x04\x15\x89\x1d\x42\xc7\xe2\x3b\x47\x18\x86\x10\x2c\x50\xcb\x42\x6d\x51\x8f\x31\xb9\x08\x96\x65\x30\x8f\x32\x84\x4f\x58\xca\x99\x3c\xa1\xaa\xaa\x56\xfe\x4a\x8b\x45\x39\xb7\xe4\x56\x1b\x2c\x01\x89\xf2\x1c\xc2\xc6\x26\x65\x4c\xd8\x61\xc6\xba\x75\x39\xd8\x4a\x91\xf0\xdd\xca\x7b\x5d\x4e\xd9\xc3\xcb\x62\xca\x26\xf3\xd1\xfd\x6c\x32\xf6\xd6\x0e\x11\xa5\x1a\xc9\x77\xc7\x01\x17\xdc\x50\x8b\x2d\xcb\xff\x8d\x3b\x7e\x85\x3b\xae\x8d\xcd\x71\x93\x16\x98\x2b\x2e\x0c\xfd\xe3\x17\x1a\xc3\xdd\xc6\xb1\x33\x6c\x73\xe1\x09\x04\xa3\xe7\xa7\xb1\xdc\xea\x31\x77\x23\xe4\xae\xe6\x93\x85\x41\xea\x0f\x2c\xc9\xa5\x5b\x55\x83\x9b\x3c\x32\xfb\xa1\x7b\xdd\xfa\x5d\x62\x77\xd8\x24\x7f\xd0\xd4\xd5\xba\x43\x02\xf6\x51\x68\x0a\x25\xae\x2d\x8a\xfa\xbf\x08\xfd\x1e\xd4\x40\x67\xa5\x8d\xf1\x87\x01\x3b\x90\xa1\xbd\x8a\x58\x87\x2b\xff\x71\xb2\xf4\xd7\x8d\xec\x5e\x66\x48\xaf\xe9\xd9\x1b\xa0\x3e\x17\x31\xbe\x07\x7b\x93\xa5\xd6\x25\xb1\xff\xd8\xae\x03\xc2\x10\x3c\xd6\x5c\x1b\xf3\x1a\x78\x2d\x57\x08\x1a\xe3\xa6\xd8\x85\x4b\x55\xe0\x39\xa2\x3a\xdd\x2f\x00\x00\x00\xff\xff\x03\x00\xae\x29\x40\x74\xac\x02\x00\x00")
 
 func templatesServer_main_pythonTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -428,7 +473,7 @@ func templatesServer_main_pythonTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_resources_apiTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x6c\x54\x4d\x6f\xdb\x30\x0c\x3d\xc7\xbf\x82\x13\x82\xc1\x5e\x5b\xb5\x87\x9d\x5a\xf4\xb0\x8f\x16\x2b\xb0\x15\xd9\x06\x74\x87\x61\x68\x94\x98\x5e\xbc\x38\x92\x23\xcb\x09\x02\xc3\xff\x7d\xa4\x24\xcf\x4d\xd7\x5c\x4c\x89\xe4\x7b\x8f\x4f\x52\xba\xee\x0c\x72\x2c\x4a\x8d\x20\x2c\x36\xa6\xb5\x4b\x7c\x54\x75\xf9\xe8\x70\x53\x57\xca\xa1\x80\xb3\xbe\x4f\x6a\xb5\x5c\xab\xdf\x08\x5d\x27\x67\x21\xbc\x57\x1b\xa4\x44\x52\x6e\x6a\x63\x1d\xa4\x09\xd0\xaf\xeb\xc0\x2a\x4d\x75\xd3\xf5\x29\x4c\x77\x70\x79\x0d\xf2\xdd\xec\xee\x73\xb9\xb8\xf3\x65\x33\xe5\x56\x8d\x07\x84\xf8\x13\x5d\x37\xdd\xf5\xbd\x18\xda\x51\xe7\x3e\x9f\x25\x94\x20\x1d\x4c\xe3\x61\x22\xdf\xf9\x39\x6b\x08\x0b\x42\x86\xb2\x01\xff\x21\xb1\xb8\x41\xed\x94\x2b\x8d\x06\x53\x70\xd5\x8d\xce\x6b\x53\x6a\xd7\xf7\x60\x8d\x71\x8c\xed\xd7\x89\x3b\xd4\x78\x0c\xd3\x38\xdb\x2e\x1d\x74\x09\x4d\x94\xbc\x34\xc5\x17\x74\x2b\x93\x37\x30\x48\x98\xee\xe2\x56\xc0\x60\x1d\x6e\x85\xb0\x52\x3a\xaf\xd0\x42\x61\x6c\x28\x7a\x40\xbb\xa0\xb4\x8f\x47\x3d\x09\xdb\x3e\x70\x14\x4c\x52\x30\x0b\xd5\xdc\xb6\x7a\xf9\xc1\x6c\x78\x94\x66\xe4\x2a\xfa\xbe\xeb\x48\x3e\xed\x14\x54\x90\x92\x31\x30\xfa\xe3\x47\xc8\xfe\x17\x95\xee\x61\xe5\x5c\x2d\xbf\x61\x53\x1b\xdd\xe0\x0f\x5b\x3a\xb4\xa7\x60\xe1\x4d\xdc\xdf\xb6\xd8\x38\xea\x4c\x26\x4f\x05\x6d\x59\xd0\x36\x0a\xfa\xda\xa2\x3d\xcc\x94\x25\x44\x6a\x1e\x0f\x2f\x28\x5b\x6f\x69\x38\x2a\xb4\xb8\x95\xb7\xc6\x6e\x1e\x54\xd5\x62\x2a\x62\x46\x64\x01\x98\x0f\x95\xda\x7c\x5c\x16\xc0\xc4\xef\x4d\x7e\xf0\x58\x93\x9d\xb2\xdc\xee\x37\xe8\x4c\x62\x8e\xaf\x56\xa4\xc9\x71\x69\x72\xe4\x1a\x56\x9b\x4c\x08\x01\xad\x65\xd6\x3f\x8d\xd1\xf2\x1e\xf7\x1f\x7d\x85\x4d\xad\xe4\xd6\x4c\x86\x75\xfa\x3a\xc2\x66\x57\xbe\xe1\xd5\x35\xe8\xb2\xe2\x61\x27\x7b\xe9\xbd\xf8\x84\x8a\xdb\xde\x5e\x5c\x90\xd0\x89\x45\xd7\x5a\x9d\x4c\x46\xe6\x9d\xaa\xca\x9c\xde\xc0\x3f\x6e\xde\x1f\xe9\x23\xbc\x7c\x88\x65\xe9\x73\xa2\xe1\x92\xbf\x40\xf7\x2c\x95\xfe\xfc\xb5\x38\xd0\x67\xde\x09\x42\x30\x56\x5c\x8a\xf9\x09\x45\xf2\x86\x57\x69\x76\x32\x17\xfd\x3c\x1b\xdb\xa2\x56\x0e\xfb\x23\x8f\x9f\x9a\xdc\xd4\xde\xd4\xd1\xe4\xb8\xe1\x5d\x0e\x31\xe7\x06\x17\x6f\x74\x70\x71\x9f\xc9\x10\xb2\x81\xa1\x2c\xbb\x3a\x3e\x48\xf2\x86\xae\x61\xb8\xa6\xb0\xc0\xca\xec\xa1\xe2\xbf\x10\x67\x40\xe5\x39\xac\xfc\xa0\xbe\x6c\x2f\xe3\xd4\x99\xfc\x8e\x2e\x15\x6b\x3c\x88\x53\xb1\xe3\x6b\x42\xb7\x23\x3c\x84\xe1\xcd\x1f\x2d\xfe\x06\x00\x00\xff\xff\xa7\x39\xf1\xd8\x99\x04\x00\x00")
+var _templatesServer_resources_apiTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x55\x51\x4f\xdb\x30\x10\x7e\x6e\x7e\xc5\x2d\xaa\x50\x32\xb5\x81\x87\x3d\x81\x78\xd8\x18\x68\x48\x1b\xaa\x60\x62\x0f\xd3\x84\x4c\x73\xa1\x19\x89\x1d\x6c\xb7\x1d\x8a\xf2\xdf\x77\x67\x3b\x0d\x2d\xa0\x49\x68\x7d\x89\x7d\x3e\xdf\xf7\x7d\x77\x5f\x93\xb6\x9d\x42\x8e\x45\x29\x11\x62\x8d\x46\x2d\xf5\x1c\x6f\x44\x53\xde\x58\xac\x9b\x4a\x58\x8c\x61\xda\x75\x51\x23\xe6\xf7\xe2\x0e\xa1\x6d\xb3\x99\x5f\x5e\x88\x1a\xe9\x20\x2a\xeb\x46\x69\x0b\x49\x04\xf4\x6b\x5b\xd0\x42\x52\xde\xf8\x7e\x02\xe3\x15\x1c\x1e\x43\xf6\x71\x76\xfe\xb5\xbc\x3d\x77\x69\x33\x61\x17\xc6\x15\x84\xf0\x8b\xdb\x76\xbc\xea\xba\xb8\xbf\x8e\x32\x77\xe7\x69\x44\x07\xc4\x83\x61\x5c\x99\x80\xb7\xbf\xcf\x1c\xfc\x86\x2a\x43\x69\xc0\x3d\x88\x2c\xd6\x28\xad\xb0\xa5\x92\xa0\x0a\xce\x3a\x95\x79\xa3\x4a\x69\xbb\x0e\xb4\x52\x96\x6b\xbb\x7d\x64\x1f\x1b\xdc\x2e\x63\xac\x5e\xce\x2d\xb4\x11\x29\x8a\x5e\x52\xf1\x0d\xed\x42\xe5\x06\x7a\x0a\xe3\x55\x08\xf9\x1a\xcc\xc3\x2e\x10\x16\x42\xe6\x15\x6a\x28\x94\xf6\x49\xd7\xa8\x6f\xe9\xd8\xad\x07\x3e\x04\x31\xdd\x60\x14\x0c\x52\x30\x0a\xe5\x9c\x2d\xe5\xfc\x44\xd5\x2c\xc5\x0c\x58\x45\xd7\xb5\x2d\xd1\xa7\x48\x41\x09\x09\x35\x06\x86\xfe\x38\x09\xe9\x73\x52\xc9\x1a\x16\xd6\x36\xd9\x25\x9a\x46\x49\x83\x3f\x74\x69\x51\x4f\x40\xc3\xfb\x10\x7f\x58\xa2\xb1\x74\x33\x1a\x31\xa1\xb2\x00\x9a\xae\x16\xb5\xb9\xf2\xed\x20\xb8\x11\x31\x68\x84\x36\x08\xa4\x0c\x56\xa2\x2a\x73\x32\x85\xd3\xda\x70\x2a\x52\x45\x13\x8d\xdc\xda\x4c\x00\xb5\x66\x21\x33\xbe\xe1\xbc\x32\x54\x23\x3e\x3a\x8d\x46\x04\xc2\x49\xef\x8e\x41\x96\x15\x23\x8f\xd6\xd9\x17\x14\x39\xea\x24\xcd\xae\xd0\x26\xf1\x89\x92\x96\xe4\x4f\xbf\xd3\x94\xe2\x09\xc4\xa2\x69\xaa\x72\xee\x06\xbb\xff\xdb\x28\x19\xa7\xee\x92\x53\x13\x6e\x7e\x38\x38\xe0\x20\x9f\x66\x17\xb8\x3e\x95\x73\xc5\xe1\x75\x9a\xf9\x65\x52\x8b\xe6\x27\xcd\xb8\x94\x77\xbf\xfc\xa3\x8d\x89\x85\xd2\xf1\x21\xb3\xc9\x4e\x79\x9d\xa4\x1d\x17\xd1\x68\x97\x5a\x46\x23\xd2\x7e\x03\xc7\x5e\xa5\xe1\x06\x39\x6f\x72\x4b\xfa\x5e\x51\xff\x3e\xa9\xfc\xd1\xf9\x75\xb4\x12\x1a\x74\x08\x90\xf2\x70\xc6\xff\x10\x76\x36\x75\x31\x47\x66\xc2\x39\xdc\xf4\x4d\x15\x7c\xd8\x14\x72\x5c\x89\x1b\xc4\x7f\xea\x2a\x76\x50\xa1\x5b\xd4\x52\x0a\xb1\xb4\xcf\xe8\xa5\xe9\x8c\x6f\xa4\x99\xdf\x27\x7b\x01\x3a\x3d\xda\xe9\x2e\xa3\x60\x45\xe3\x7b\x0d\x8a\x9c\x5a\xef\x62\xdd\x29\xd2\x5c\x85\xda\x67\x94\x90\x90\x6b\xfe\x0d\xb1\x5d\xa4\x1f\xc6\x9b\x18\x87\x4e\xbf\x3c\xe6\x61\x42\x7d\x6f\x37\xbe\xec\xbb\xcb\xf1\x81\x4a\x80\xca\xae\x43\x5a\xb2\x0b\xda\xbf\x8d\xde\x60\xc5\xe1\xea\x33\xa6\xfd\xd1\x7f\xb0\x65\x5f\x2a\x48\xe7\x65\xb7\xd5\xaa\xa7\xae\x34\x8d\x73\xe1\xe0\xca\x10\x70\xb6\xf4\xeb\x27\x36\xf6\xae\xf0\xe1\x17\x1c\x18\x7c\xf7\x9c\xfb\x5e\x5f\x37\xdd\xb1\xc0\xeb\x72\x87\x2b\x47\xdb\x73\x7e\xba\xa6\x71\xd2\x2b\xce\xbf\x02\xe1\x16\x2b\xb5\x86\x8a\x3f\x4f\x56\x81\xc8\x73\x58\xb8\x06\xbb\xb4\xdd\x69\xdd\xe3\x63\x3c\x89\xc9\x0b\x4b\xa4\xc1\xf8\x97\x6c\xff\x3d\xd9\xda\xfc\x05\x00\x00\xff\xff\x03\x00\x95\x97\x3b\x91\xf5\x06\x00\x00")
 
 func templatesServer_resources_apiTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -448,7 +493,7 @@ func templatesServer_resources_apiTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesServer_resources_interfaceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x53\xcb\x6e\xdb\x30\x10\x3c\x8b\x5f\xb1\x10\x72\x90\x02\x5b\xb9\x17\xe8\xa9\x0f\x34\x87\x06\x41\x10\xb4\xc7\x80\x96\x56\x16\x11\x89\x54\xc9\x95\x9d\x80\xe0\xbf\x77\x49\xc9\x8e\xd3\x38\x6d\x79\xf1\x92\x1c\xce\xce\xce\x58\xde\xaf\xa1\xc1\x56\x69\x84\xdc\xa2\x33\x93\xad\xf1\x41\xb5\x0f\x84\xc3\xd8\x4b\xc2\x1c\xd6\x21\x88\x51\xd6\x8f\x72\x8b\xe0\x7d\x75\x3b\x97\x37\x72\x40\xbe\x10\x57\x57\xf7\x9d\x72\xd0\xaa\x1e\x81\x7f\xe5\x44\x66\xbd\x45\x8d\x96\xdf\x36\xb0\x79\x86\xad\x59\x5b\x39\xf4\x0c\xfc\x6c\x40\x1b\x02\x6c\x14\x01\x1d\x1f\x31\xa4\x93\xba\x01\xa7\x74\xcd\x14\x04\x7b\xd5\xf7\xb0\x41\x30\x3b\xb4\x7b\xab\x88\x50\x43\x33\x59\xa5\xb7\xfc\x0a\x41\xe3\x13\xc1\xd2\x41\x19\x2d\x84\x1a\x46\x63\x09\x0a\x01\xbc\xbc\x07\x2b\x35\x2b\xbd\x78\x5c\xc1\xc5\x0e\x3e\x7c\x84\xea\x5a\x13\xda\x56\xd6\x78\x9d\x90\xb7\x92\x3a\x97\xa6\x82\x65\xe5\xde\x5f\xec\x42\xc8\x0f\x0c\xc8\x72\xe2\x7d\x19\xc7\x8b\x33\xcf\xc3\x1e\x79\xe2\xa0\xea\xb8\x69\x8d\x8d\x98\x2f\xba\x19\x0d\x9f\x86\x00\xd6\xc4\x31\x97\xbd\xa0\xe7\x11\xcf\x92\x1c\x2a\x7f\x14\x12\xc3\x78\x23\xff\x3b\x52\x67\x9a\xd7\x8a\x93\x2c\x06\x2c\x97\x33\x75\x94\x15\x1d\x8a\x76\xf6\x68\x17\x61\x0c\xfa\x81\x76\xc3\xd7\xa9\x7e\x91\x29\x32\x80\xec\xb4\x63\x1b\x5b\xb6\xb1\x27\xe3\xbe\x4e\xba\xfe\x64\x86\x01\x35\xb9\x05\x9b\x9a\x32\x22\x04\xef\x79\xba\x78\x9a\x25\xce\x53\x11\x45\x47\x34\x56\x77\xe8\x46\xa3\x1d\xfe\xe4\xfc\xd0\xae\xe0\x72\x39\xfd\x35\xa1\xa3\x52\x64\x8b\xc9\x4c\x11\xde\xf1\xf8\xce\x4c\x84\x2e\x8e\x64\xb9\x8a\xe1\xff\xcb\xe7\x96\x15\xbf\x4b\x54\x58\xb8\x1c\xa6\xa7\x2a\xed\x58\x90\x3a\x83\x2c\x39\x00\x91\xfd\x35\x83\x93\x08\x22\x4e\xb5\x29\x03\xd5\xb0\xdf\x7b\xc9\x9f\xcf\x29\xc0\x56\xdf\x52\x10\x45\xfe\x87\xf1\xf9\x0a\x64\xaf\x6a\xac\x6e\x70\x5f\xcc\x06\xbe\x30\x84\x50\x56\xf7\x1d\xea\xd9\xc7\x99\xc1\xc6\x30\x0a\x55\xbd\x31\xbb\x2c\xcb\x83\xb4\xa5\xcb\x1c\x75\x5e\xbe\x92\x89\xbd\x43\x48\x71\x1d\x34\x25\xc2\x33\xba\xce\xf5\xf8\xaf\x0e\x73\x98\xd9\x49\x1d\xc4\x61\x13\xff\xb8\xbf\x03\x00\x00\xff\xff\xd6\x21\x2f\x89\x67\x04\x00\x00")
+var _templatesServer_resources_interfaceTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x8c\x53\xc1\x6e\x9c\x30\x10\x3d\xe3\xaf\x18\xa1\x3d\x40\xb4\x4b\xee\x95\x7a\x6a\x53\x35\x87\x46\x51\x14\xb5\xc7\xc8\x0b\xc3\x62\x05\x6c\x6a\x86\xdd\x44\x96\xff\xbd\x63\xc3\x6e\x48\xb3\x69\xc3\x85\xb1\xfd\xfc\xe6\xcd\x7b\xe0\xdc\x06\x2a\xac\x95\x46\x48\x2d\x0e\x66\xb4\x25\x3e\xa8\xfa\x81\xb0\xeb\x5b\x49\x98\xc2\xc6\x7b\xd1\xcb\xf2\x51\xee\x10\x9c\x2b\x6e\xa7\xf2\x46\x76\xc8\x07\xe2\xf2\xf2\xbe\x51\x03\xd4\xaa\x45\xe0\xb7\x1c\xc9\x6c\x76\xa8\xd1\xf2\xdd\x0a\xb6\xcf\xb0\x33\x1b\x2b\xbb\x96\x81\x5f\x0d\x68\x43\x80\x95\x22\xa0\xd3\x25\x86\x34\x52\x57\x30\x28\x5d\x32\x05\xc1\x41\xb5\x2d\x6c\x11\xcc\x1e\xed\xc1\x2a\x22\xd4\x50\x8d\x56\xe9\x1d\xdf\x42\xd0\xf8\x44\x30\x77\x50\x46\x0b\xa1\xba\xde\x58\x82\x4c\x00\x3f\xce\x81\x95\x9a\x95\xae\x1e\xd7\xb0\xda\xc3\xa7\xcf\x50\x5c\x6b\x42\x5b\xcb\x12\xaf\x23\xf2\x56\x52\x33\xc4\xa9\x60\x7e\x52\xe7\x56\x7b\xef\xd3\x23\x03\xb2\x9c\x70\x9e\x87\xf1\xc2\xcc\xd3\xb0\x27\x9e\x30\xa8\x3a\x2d\x6a\x63\x03\xe6\x4a\x57\xbd\xe1\x5d\xef\xc1\x9a\x30\xe6\xbc\x16\xf4\xdc\xe3\x59\x92\x63\xe5\x4e\x42\x1c\x87\xf1\x46\xfe\x0f\xa4\xc6\x54\xaf\x15\x47\x59\x0c\x98\x0f\x27\xea\x20\x2b\x38\x14\xec\x6c\xd1\xce\xc2\x18\xf4\x13\xed\x96\x8f\x63\xfd\x22\x53\x24\x00\xc9\xb2\x63\x1d\x5a\xd6\xa1\x27\xe3\xbe\x8d\xba\xfc\x62\xba\x0e\x35\x0d\x33\x36\x36\x65\x84\xf7\xce\xf1\x74\x61\x37\x89\x9c\x4b\x11\x59\x43\xd4\x17\x77\x38\xf4\x46\x0f\xf8\x8b\xf3\x43\xbb\x86\x8b\x79\xf7\xf7\x88\x03\xe5\x22\x99\x4d\x66\x0a\xff\x8e\xc7\x77\x66\x24\x1c\xc2\x48\x96\xab\x10\xfe\xff\x7c\xae\x59\xf1\xbb\x44\x99\x85\x8b\x6e\x7c\x2a\xe2\x8a\x05\xa9\x33\xc8\x9c\x03\x10\xc9\x3f\x33\x58\x44\x10\x70\xaa\x8e\x19\xa8\x8a\xfd\x3e\x48\xfe\x7d\x96\x00\x5b\x7c\x8f\x41\x64\xe9\x5f\xc6\xa7\x6b\x90\xad\x2a\xb1\xb8\xc1\x43\x36\x19\xf8\xc2\xe0\x7d\x5e\xdc\x37\xa8\x27\x1f\x27\x06\x1b\xc2\xc8\x54\xf1\xc6\xec\x3c\xcf\x8f\xd2\xe6\x2e\x53\xd4\x69\xfe\x4a\x26\xb6\x03\x42\x8c\xeb\xa8\x29\x12\x9e\xd1\x75\xae\xc7\x87\x3a\x4c\x61\x26\x8b\xda\x8b\xe3\x22\x7c\xb8\x7f\x00\x00\x00\xff\xff\x03\x00\xd6\x21\x2f\x89\x67\x04\x00\x00")
 
 func templatesServer_resources_interfaceTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -468,7 +513,7 @@ func templatesServer_resources_interfaceTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesStructTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x55\x5f\x4f\xdb\x3e\x14\x7d\x6e\x3f\xc5\xfd\x45\x7d\x68\x10\x4d\xdf\x11\xe5\xe1\x37\x36\xa9\xd2\x06\x3c\xb0\xbd\x20\x04\x5e\x7a\x03\x5e\x1d\xa7\xd8\x4e\x34\x14\xe5\xbb\xef\xfa\x4f\x5a\x37\xe9\x36\x2d\x42\x10\xfb\xfe\xf1\x39\xe7\x9e\x98\xb6\xdd\x60\xc1\x25\x42\xa2\x8d\xaa\x73\xf3\x64\xb0\xdc\x09\x66\x30\xe9\xba\xe9\x8e\xe5\x5b\xf6\x82\xd0\xb6\xd9\x9d\x7f\xbd\x61\x25\x52\x60\xca\xcb\x5d\xa5\x0c\xcc\xa7\x40\x4f\xdb\x82\x62\x92\xf2\x66\xdb\x73\x98\x35\x70\xb1\x82\x6c\xed\x12\xee\x98\x79\xd5\xb0\xa0\x0a\x08\x4f\x42\xc9\xb3\x2d\x74\x5d\xd2\x97\xa2\xdc\xb8\x8c\x74\x3a\x3d\x34\xf2\x4d\xae\x51\xe7\x8a\xef\x0c\xaf\x24\x55\x4c\x97\x4b\xca\x9f\x35\x5d\x47\x7f\xa8\x8a\x76\xa8\x80\x17\x90\xdd\x4a\xfc\x4c\x1c\xae\xb1\x70\x9d\x68\x37\xde\x72\x3b\x0b\x40\xa1\xd1\x85\xcd\xfb\xce\x52\x82\xcc\x92\xa1\x28\x78\xe6\xd0\x0e\xc9\xe0\xbb\xa5\xc3\x44\x8d\x0e\xcd\x27\x8e\x62\xa3\x21\x22\x63\xd1\xd8\x70\xe6\x65\xb1\x1b\x04\x07\xdf\x42\x55\xb6\xd6\x1f\x2a\xd2\x41\x73\xc7\xa0\x60\x84\xc0\x81\x0f\xe1\x7b\x02\x42\xeb\xe7\x1f\xba\x92\x17\x24\x8c\x3d\xb1\xeb\x46\x3d\x6e\x4b\x6e\x0c\x6e\x80\x50\x52\xfa\x79\x45\x4b\x9a\x91\x79\x0f\x22\x24\xae\x20\x64\x7f\x63\x82\x6f\x98\xa9\x94\xa6\xc6\x8d\x5f\xa0\xeb\x3d\x8e\x27\xa1\xc1\xf3\x5e\x4e\xcf\x69\x01\x7e\x15\x64\x73\xef\xbd\xd2\x37\x95\xf9\x9f\x29\x5c\x4b\x83\xaa\x60\xb9\xf5\x42\x51\xcb\x1c\xe6\xda\x9a\xc4\xcb\x90\x42\x38\x05\xe7\x29\xa0\x52\x95\xda\x4b\xbb\x3c\x83\xc2\xaa\x08\x02\x1b\x14\x3d\x40\x2b\xce\xd9\x72\x7f\xfe\xd8\x4c\xc7\xca\x7b\x28\xb3\x26\xfb\x2a\xf9\x5b\x8d\x6b\x52\x63\x1f\x2b\x2d\xd3\x7e\x1c\x54\x5a\xb2\xdd\x03\xef\xc1\xb6\xdd\xa3\x1f\x75\xdb\xb5\x3e\xbd\x20\x6c\x4f\xe7\xe0\x4e\xf1\xa7\xea\x2c\xee\xd0\xee\x47\x1d\x37\x7e\x68\x1e\x61\x05\x83\x56\xfe\x37\x01\x13\x28\xe7\x71\x76\x0a\xff\xad\xdc\xe6\x51\xeb\x34\xea\xad\xd0\xd4\x8a\xfc\x51\x9a\xec\xa3\x95\xab\x98\x27\x31\x88\xb2\xd6\x06\xbe\x23\xd4\x8e\x6e\x92\x46\xc7\xf9\xcf\xa7\x8b\x17\x07\x95\x48\xec\xb3\xe8\x01\xe7\xfb\xb1\xf0\x21\x1a\x0d\xc0\x0e\xfa\x3e\xfb\xc2\xa5\x97\xb6\xff\x7c\x03\x37\x9d\xc2\xa5\x1d\xf6\x21\xe3\x48\xa8\x13\x64\xa8\x0a\xf4\x6b\x55\xd3\xdc\x89\xc6\xd5\x0a\x86\xe5\x03\x4e\x8b\x23\x52\x01\x0d\xfb\xf9\x3b\x34\x57\xa1\x5d\xc8\xf8\x37\x34\x97\xab\x61\xf5\x1f\xc1\x80\x47\x33\x70\xde\x84\x6e\xa6\x92\x6d\xc9\x3d\xb5\x42\xe0\x06\xb8\x0e\xd3\xf2\xa6\xfc\x8b\x13\x27\x27\x6c\x48\x1c\x26\x93\x72\xe4\xb4\x09\xfd\xf4\x1e\x3b\x18\x2b\x75\xd9\x27\xb8\xe6\x95\x10\x98\xbb\x39\x13\x24\x59\x99\x83\x89\x26\x47\x04\x8f\x5c\x93\x33\x21\xe0\xa5\x5a\x34\xfd\x65\xd1\x5f\x1b\x68\xe9\xdb\x2e\xd1\xed\x3a\xf0\xcd\xe0\x2a\x8e\x66\x20\xb9\xd8\xdb\xb4\xbf\x8a\xa3\xf0\xf8\x30\xe2\x35\xfc\x1f\xe1\xee\xf6\x00\xb7\xbf\xb4\x7e\x05\x00\x00\xff\xff\xc3\xc1\x18\x45\xc2\x06\x00\x00")
+var _templatesStructTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xac\x57\x6d\x6f\xdb\x36\x10\xfe\x6c\xff\x8a\x9b\xe1\x0d\x56\x60\x2b\xdf\xb3\xa6\x1f\xb6\xac\x43\x86\xe6\x05\xc8\x5a\x0c\x08\x82\x86\x91\xe8\x98\x8b\x44\xb9\x24\xa5\xd6\x10\xf4\xdf\x7b\x7c\x91\x44\x51\xb6\xb3\x75\x33\x82\x58\x22\xef\x8e\xcf\xdd\x3d\x77\x3c\xd7\x75\x4a\xd7\x8c\x53\x98\x49\x25\xca\x44\x7d\x52\x34\xdf\x66\x44\xd1\x59\xd3\x4c\xb7\x24\x79\x21\xcf\x14\xea\x3a\xbe\xb5\x8f\xd7\x24\xa7\xb8\x31\x65\xf9\xb6\x10\x0a\x16\x53\xc0\x4f\x5d\x83\x20\x1c\xe5\xe6\x2f\x4b\x98\x57\x70\x76\x0e\xf1\xa5\x11\xb8\x25\x6a\x23\x61\x85\x1a\xe0\x3e\x33\x14\x9e\xbf\x40\xd3\xcc\x5a\x55\xca\x53\x23\x11\x4d\xa7\xbd\x21\x6b\xe4\x82\xca\x44\xb0\xad\x62\x05\x47\x8d\xe9\xe9\x29\xca\xcf\xab\xa6\xc1\x2f\xd4\xc2\x15\x54\x60\x6b\x88\x6f\x38\x7d\x8f\x3e\x5c\xd0\xb5\xb1\x84\xab\xfe\x92\x59\x59\x01\xcd\x24\x35\xdb\x6a\xb7\xd5\x2e\x41\xac\x9d\xc1\x5d\xb0\x9e\x43\x1d\x3a\x43\x77\xda\x1d\x92\x95\xd4\xa0\x79\xc7\x68\x96\x4a\xf0\x9c\xd1\x68\xf4\x76\x6c\xc3\xa2\x17\x10\x0e\xfd\xec\xb4\xe2\x4b\xf9\x6b\x81\x71\x90\xcc\x78\xb0\x26\x88\xc0\x80\x77\xdb\x7f\x22\x10\x7c\x7f\xfc\x5b\x16\xfc\x0c\x03\xa3\x4f\x6c\x9a\x91\x8d\x9b\x9c\x29\x45\x53\x40\x94\x28\xbe\x2c\xf0\x15\x73\xa4\x76\x2e\x08\x33\xa3\x30\x47\xc1\xbf\xae\xde\xa3\xb9\xaf\x79\xf6\x9f\x8c\x99\x6f\xa3\x47\x30\x33\x4e\xf1\x23\xc9\x58\x4a\x54\x21\x24\x2c\x78\xa1\x7a\x7b\xd7\x65\x96\x91\xa7\x8c\x46\x78\x72\x65\x85\xa8\x39\x3e\xd4\xeb\x6d\x3f\x76\xe9\xb3\x31\x5c\x81\x7d\x73\x69\x32\xcf\x6d\x66\xaf\x0b\xf5\x0b\x11\xf4\x92\x2b\x2a\xd6\x24\xd1\xdc\x5b\x97\x3c\x81\x85\xd4\xa4\xb4\x61\x8f\xc0\x9d\x42\x17\x11\x50\x21\x0a\xd1\xa5\xf2\xf4\x04\xd6\x3a\x6b\x90\xd1\x8a\x66\x2d\x40\x9d\x8c\x93\xd3\xee\xfc\x31\x79\x87\x99\xb6\x50\xe6\x55\xfc\x81\xb3\xcf\x25\xbd\xc4\x80\x75\x7b\xb9\xf6\xb4\x4d\x3f\xaa\xe6\x64\x7b\xcf\x5a\xb0\x75\xf3\x60\xa9\x55\x37\xb5\x15\x5f\x23\xb6\x4f\x4b\x30\xa7\xd8\x53\x65\xec\x5b\xa8\x3b\x6a\xf9\x86\xef\xab\x07\x38\x87\xc0\x94\xfd\x8f\xc0\x32\xca\x17\xbe\x74\x04\x3f\x9c\x9b\xc5\x81\xe9\xc8\xb3\x2d\xa8\x2a\x05\xf2\x31\x57\xf1\x6f\x3a\x5c\xeb\xc5\xcc\x07\x91\x97\x52\xc1\x13\x85\xd2\xb8\x3b\x8b\xbc\xe3\x6c\xb9\x0e\xe2\x62\x49\xe2\x31\x41\xbf\x79\x74\x69\x3a\xa0\x43\x57\x11\x24\x67\x99\x87\x4a\x13\x55\x08\x1d\x99\xaa\xd5\xb6\x76\x16\x46\xed\xa3\xe6\x13\x32\x16\x66\xed\x6b\x4f\xac\xe8\x67\xa3\x3a\x32\x79\xd8\xd9\x97\xa6\x39\x83\x1f\xab\xd9\x52\x2b\x46\x9d\x42\x73\xc4\x57\xdd\xa7\xba\x37\x24\xd6\x89\xf7\x01\xd3\x53\xc6\x24\x73\xbb\x1e\xd9\x34\xa9\xdf\x21\x37\x94\x8c\xaf\x18\xb7\x5c\x5a\x35\x83\x64\xca\x08\xde\x68\x76\x07\x62\x03\x7a\xec\xf1\x0a\x55\x41\x6e\x8a\x12\xd9\x8e\xc9\x7b\x7b\x0e\x7b\x6d\x04\xe9\x5c\x0d\x7c\xf4\xc1\x91\xaf\x87\xc0\xbd\xf5\x0d\x3b\xb1\x7f\x07\xee\xcd\xf9\x5e\x13\x47\xb1\x81\x07\x2e\x28\xc4\x09\x5e\x0c\x39\x79\xc1\x62\x2a\x05\x05\xa6\x80\x49\x47\x5e\x5b\xa3\xaf\x14\xe6\x64\x4f\x55\xa2\x37\x93\x49\x3e\x2a\xbc\x09\xfe\xb5\x25\xd7\xd7\x59\x64\xa4\xf7\x78\x9d\x14\x59\x46\x13\x43\x05\x84\xa4\xfb\x66\x57\x53\x93\x81\x97\x03\x62\xad\x8d\x93\x50\x60\x6d\x01\x6f\x8b\x4a\x26\x24\x23\xc2\xf2\x2c\xa0\xd3\x9d\xd9\xf2\x4a\xce\x4b\xd9\x81\x8a\x92\xa6\x8c\x46\x9a\x47\x4a\xc9\x79\x87\x7b\x47\x72\x84\xe0\x4b\x49\xc5\xca\x8e\x14\xa9\xe7\x89\xda\xd0\xbd\xd8\x4d\x42\xbf\x07\x7a\xa0\xf8\x3f\x20\xc7\x58\x64\xf0\x5c\xac\x82\x03\xf1\x5a\xd1\x68\x74\xf2\xbc\x99\x22\x70\x23\x18\x40\xbc\x63\x11\x4b\xd7\x40\xda\x01\xc4\xdb\x1e\x1f\x86\x74\x0a\x27\x23\x33\xd1\x38\x96\x68\xe0\xfa\xc4\x0b\xa6\x47\xa3\x9c\x71\xad\xdc\x6e\xcc\xb9\x9e\x68\xf4\x0d\xe6\x46\x1b\x37\x32\x71\xd3\x72\xbb\x4b\x54\x93\x11\x07\xb8\x8c\xe6\x94\xeb\x59\xe0\x69\xd7\x0b\x99\x7e\xde\x66\x4b\xc2\x97\x0d\x4b\x36\xc0\xf8\x86\x0a\xac\xab\xb5\x28\x72\xac\xaf\x76\x82\x1a\xdb\xed\x9e\x6c\xf8\x7f\xa7\xaa\x93\xc2\xbb\xb9\x7b\x36\x9b\xe1\xa5\x8d\x6e\x6a\xb8\xbe\x8e\x8b\x92\x34\x80\xfa\x55\x53\x19\x76\x2e\xc3\xc7\x31\x0e\x6f\x44\x70\x5b\xd1\x41\x28\x0e\xa9\x4b\x87\x74\x20\x3e\xf0\x9c\x08\xb9\x21\x59\x2f\x97\xd2\xa4\x48\xa9\xf4\x61\x08\x28\xb8\x81\xc0\x90\xe4\xb2\x7c\xb2\x21\x33\x41\xfa\xe3\xee\xe6\x7a\xa9\x2d\x75\xc4\xd7\x31\x4f\x31\xde\x0c\x07\x12\x13\x72\xbd\xd3\x39\xf1\x88\x94\x1e\xa4\x34\xbe\x15\xc5\x96\x0a\xb5\xd3\xf3\xd2\xd6\x3d\xc7\xd6\xb3\x31\xba\x05\x06\x92\xc0\xfd\xc3\xd3\x4e\xd1\x08\x16\xe3\x90\x2c\x6d\x8c\xdb\x29\xa0\xc2\x56\x92\x0e\x18\x34\x98\x81\x5d\x7a\x10\x1a\x2e\x33\xfe\xdc\x8f\xa8\x07\x41\xce\x1e\x87\x53\x89\xab\x5e\xad\x17\x77\x78\x0d\xcc\x25\xfc\x34\x38\xfa\x95\xba\xc5\xc5\x65\x50\xbc\xf2\x0b\x53\xc8\xca\x81\x15\x3b\x1f\x74\x83\xdf\xca\x35\xf2\x00\xef\x5d\x9b\x24\xc7\xc1\x84\x60\x49\x6a\xb7\xdc\x74\x31\x3b\xeb\x8e\xd7\x21\xaa\xfa\x21\xb3\x5b\x3f\xea\x58\x15\x85\xf0\xab\x1e\x7c\xd0\xeb\xc3\x36\xb1\x1c\x5c\x1d\x8c\x9b\xd6\x00\x47\x42\x3e\x20\xbf\x9b\x66\xf6\xc4\x24\x72\x9c\xee\x44\x6d\xa4\x36\x85\x1e\x71\x5f\x63\xb3\xe1\xb0\xbd\x51\x6d\x01\xa4\x40\x92\xa4\x10\xa9\x66\x85\x2a\xbe\x8f\xc4\xc3\xf6\xd1\x11\xcd\xff\x0d\x36\x2a\xe9\xa0\x2e\x75\x7d\xf5\x3d\x4c\x06\xe9\xa0\xa2\x6f\x45\xae\x19\x54\x70\x32\x3c\x31\x1a\x1a\x1b\x56\x90\xff\x2b\x02\xdd\x5b\xb6\x59\x3f\x50\x79\x91\xcf\xfa\x7f\x76\x01\x55\xf1\x9e\xfe\x69\x6e\xbb\xf0\xf6\xb0\x9e\x5f\x1d\xf1\xfb\xea\x88\xd7\xa1\xd3\x9e\x1d\x6c\x83\x0b\xeb\x70\xd0\x1d\xdc\xe1\xbe\xed\xc5\x3e\xbc\x51\xff\x93\xcd\xde\x40\xf6\x46\xfd\x06\x00\x00\xff\xff\x03\x00\x6b\x41\xa0\x20\x53\x10\x00\x00")
 
 func templatesStructTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -488,7 +533,7 @@ func templatesStructTmpl() (*asset, error) {
 	return a, nil
 }
 
-var _templatesStruct_input_validatorTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x91\xc1\x8a\xe3\x30\x0c\x86\xcf\xf1\x53\x68\x03\x65\x63\x28\xe9\x2e\x94\x1e\x0a\x3d\xee\xde\x76\x67\xde\xa0\x78\x1c\xb9\x35\x93\x38\xc1\x91\x3d\x0c\x26\xef\x3e\x72\x92\x76\x4a\x2f\x6d\x90\xf4\xff\xfa\x3f\x39\xa5\x06\x8d\x75\x08\xe5\x48\x3e\x68\x3a\x5b\x37\x04\x3a\x47\xd5\xda\x46\x51\xef\xcf\x84\xdd\xd0\x2a\xc2\x72\x9a\xc4\xa0\xf4\xbb\xba\x20\xa4\x54\xbf\x2e\x9f\xff\x55\x87\xdc\x10\xb6\x1b\x7a\x4f\x50\x89\xa2\x34\x1d\x95\xfc\xc7\x76\xba\x77\xb1\x14\x52\x08\x13\x9c\x86\x7f\xa1\x25\x3b\xb4\xf8\x62\x2a\x17\x3a\xb0\x8e\xd0\x1b\xa5\x31\x4d\x5b\xe0\x75\x01\x81\x25\xd6\x5d\x24\xa0\xf7\xbd\x87\x24\x8a\xa8\x3c\xf0\xec\xdf\xb6\x57\x04\x26\xff\x1e\xf6\x42\x14\xe3\x87\x25\x7d\x85\x08\xc7\x53\x6e\xd7\x15\x7d\x0e\x28\xb3\x40\xab\x11\xb3\xf3\x51\x14\xc5\x5d\x78\xba\x49\xab\x28\xd7\x91\xb5\xf0\x34\x16\x45\xc1\xc7\x50\x9c\x33\x37\x3c\x52\xf0\x0e\x18\xa7\xfe\x93\x03\x99\xaa\xdc\x44\xd0\xca\xfd\x24\x78\x43\x08\x23\x36\xc0\x41\xae\xd0\xdd\xc1\x4a\x26\xe1\x15\x7c\x8f\x62\x26\x9a\x8d\xb7\x99\x27\x47\x5d\x2f\xc2\xa7\xf3\xe3\xd2\xaa\xe6\xa9\x2d\x1c\xf6\xac\xb2\x66\x1e\xfc\xc1\x4c\xb6\xcd\x30\xb7\x04\x5c\x5d\x3c\x3d\x8e\x2b\xf2\x92\x78\x07\xdf\x5b\x66\x7d\x95\x27\x76\x77\x5c\x3e\x44\xae\x48\x29\xb3\xeb\xef\xfa\xd7\xa3\xeb\x13\x97\x1d\xc1\xf5\xf4\xc0\x02\x9b\xc8\x38\xbc\x6b\x7d\x1d\x79\xcb\x30\xab\x39\xa2\x98\x44\x4a\xe8\x1a\x7e\xfe\xaf\x00\x00\x00\xff\xff\x16\xec\x5e\x2d\x45\x02\x00\x00")
+var _templatesStruct_input_validatorTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x5c\x91\x4d\x6a\xc3\x30\x10\x85\xd7\xd6\x29\xa6\x86\x50\x0b\x82\xd3\x42\xc8\x22\x90\x65\xbb\xeb\xcf\x0d\x82\x2a\x8f\x13\x51\x5b\x36\xf2\x48\xa5\x08\xdf\xbd\x23\xdb\x49\x43\x36\x96\xd1\xcc\x7b\xf3\xbe\x51\x8c\x15\xd6\xc6\x22\xe4\x03\x39\xaf\xe9\x68\x6c\xef\xe9\x18\x54\x63\x2a\x45\x9d\x3b\x12\xb6\x7d\xa3\x08\xf3\x71\x14\xbd\xd2\xdf\xea\x84\x10\x63\xf9\x39\xff\xbe\xab\x16\xb9\x20\x4c\xdb\x77\x8e\xa0\x10\x59\x5e\xb7\x94\xf3\xc1\x76\xba\xb3\x21\x17\x52\x88\xda\x5b\x0d\x6f\xbe\x21\xd3\x37\xf8\x51\x17\xd6\xb7\x60\x2c\xa1\xab\x95\xc6\x38\xae\x81\xc7\x79\x04\x96\x18\x7b\x92\x80\xce\x75\x0e\xa2\xc8\x82\x72\xc0\xbd\xaf\x4d\xa7\x08\xea\xf4\xdd\x6d\x85\xc8\x86\x1f\x43\xfa\x0c\x01\xf6\x87\x54\x2e\x0b\xfa\xed\x51\x26\x81\x56\x03\x26\xe7\xbd\xc8\xb2\xab\xf0\x70\x91\x16\x41\x2e\x2d\xcb\xc5\x5d\x5b\x10\x19\x2f\x43\x71\xce\x54\x70\x48\xde\x59\x60\x9c\xf2\x25\x05\xaa\x8b\x7c\x15\x40\x2b\xfb\x48\xf0\x85\xe0\x07\xac\x80\x83\x9c\xa1\xbd\x82\xe5\x4c\xc2\x23\x78\x1f\xd9\x44\x34\x19\xaf\x13\x4f\x8a\xba\x6c\x84\x57\xe7\x86\xb9\x54\x4c\x5d\x6b\xd8\x6d\x59\x65\xea\xa9\xf1\x81\x99\x4c\x93\x60\x2e\x09\xf8\x76\xf6\x74\x38\x2c\xc8\x73\xe2\x0d\xfc\x4f\x99\xf4\x45\xea\xd8\x5c\x71\x79\x11\xe9\x46\x4a\x99\x5c\x9f\xcb\xa7\x5b\xd7\x3b\x2e\x33\x80\xed\xe8\x86\x05\x56\x81\x71\x78\xd6\xf2\x3a\xf2\x92\x61\x52\x73\x44\x31\x8a\x18\xd1\x56\xfc\xfc\x7f\x00\x00\x00\xff\xff\x03\x00\x16\xec\x5e\x2d\x45\x02\x00\x00")
 
 func templatesStruct_input_validatorTmplBytes() ([]byte, error) {
 	return bindataRead(
@@ -508,6 +553,66 @@ func templatesStruct_input_validatorTmpl() (*asset, error) {
 	return a, nil
 }
 
+var _templatesUser_facets_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x54\x8f\xcb\x0a\x83\x40\x0c\x45\xd7\xf5\x2b\x82\xab\x16\x8a\xfe\x85\xcb\xd2\x45\xf7\x9a\x3a\x19\x3b\x54\xa3\x64\x46\x41\x06\xff\xbd\xf3\x28\x94\xae\xf2\x3a\xb9\x37\xf1\x5e\x91\x36\x4c\x50\xae\x96\xa4\xd5\xd8\x93\xb3\xed\x30\x97\xc7\x51\x2c\xd8\xbf\x71\x20\xf0\xbe\xba\xe7\xf4\x86\x13\x85\x81\xf7\x82\x1c\x06\x55\x93\xf0\xd0\xa9\xeb\x48\x35\x2b\xf7\x19\x81\x0d\x47\xa3\xd0\x91\x05\x8c\xf9\x4a\x80\x03\x1a\xb6\x0e\xdc\x8b\xa0\x0b\x70\x06\x3b\x48\x96\xa0\xa8\x1f\x51\x48\xc1\x73\x8f\x4a\x8f\x7d\xf9\x9a\x55\x51\x7b\x41\xc1\x09\x8c\x4d\xcb\x59\x6e\xd6\xa9\x48\xdb\x55\xa1\x83\xf3\xff\x05\xe7\x0d\x0c\x3b\x92\x08\xf8\xe3\xfa\x95\xb0\x4e\x0c\x0f\x17\x20\x91\x59\xc0\x17\x27\x21\xb7\x0a\x03\x9b\xb1\x88\x7f\x11\xab\xe3\x17\x3f\x00\x00\x00\xff\xff\x03\x00\x43\xf4\xbe\xc8\x1d\x01\x00\x00")
+
+func templatesUser_facets_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUser_facets_goTmpl,
+		"templates/user_facets_go.tmpl",
+	)
+}
+
+func templatesUser_facets_goTmpl() (*asset, error) {
+	bytes, err := templatesUser_facets_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/user_facets_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesUser_facets_pythonTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x8f\xb1\x6e\xc3\x30\x0c\x44\x77\x7d\xc5\x21\x8b\x53\x20\xf1\x07\x74\x4f\xc7\x4e\x45\x57\x47\xb1\xa8\x58\xa8\x2d\x19\x94\xdc\xc2\x10\xfc\xef\x91\xa9\xa2\x0d\x17\x89\xc4\xdd\x3b\x32\x67\x43\xd6\x79\xc2\x61\x89\xc4\x9d\xd5\x3d\xa5\xd8\xcd\x6b\x1a\x82\x3f\x6c\x9b\xb2\x1c\x26\xfc\x24\x1b\x78\x8a\xed\xb7\x1e\x9d\xd1\x29\x70\x84\x9b\xe6\xc0\x09\x9f\x75\xe2\x82\xbf\x30\x07\x56\x39\x9f\xc1\xda\xdf\x09\xed\x9b\xa0\x0a\x42\xa9\x12\x81\x9c\xdb\x77\x3d\xd1\xb6\x1d\x0b\x65\xa1\x97\x57\x85\x52\x4d\xd3\xa0\x1f\xa8\xff\x42\x1a\x08\xd7\x3f\xd1\x15\xb2\x09\x0c\xf5\xa3\x66\x32\xb8\xad\x3b\xe1\x63\x9d\xa9\x0a\x4e\x10\x0c\x5c\x14\x67\x6d\x82\x95\x46\xac\x85\xac\x24\x62\x0f\xef\xfe\xd3\xf7\x4b\x4e\xb0\x8e\x46\xf3\xbb\xc3\x5e\xb3\x8e\xb1\xca\x99\xd2\xc2\xfe\xc9\x21\x37\x91\x37\xf2\xab\xef\x03\x00\x00\xff\xff\x03\x00\xa3\xd9\xc8\xd1\x35\x01\x00\x00")
+
+func templatesUser_facets_pythonTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUser_facets_pythonTmpl,
+		"templates/user_facets_python.tmpl",
+	)
+}
+
+func templatesUser_facets_pythonTmpl() (*asset, error) {
+	bytes, err := templatesUser_facets_pythonTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/user_facets_python.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _templatesUser_facets_registration_goTmpl = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x4c\x90\xc1\x6e\xc2\x30\x0c\x86\xcf\xe4\x29\xac\x9e\x40\xa2\x45\xda\x33\x4c\x1c\xa7\x49\x4c\xbb\xa2\xd0\xb8\xc1\xa2\x75\xaa\xd4\x2d\x43\x11\xef\x3e\x87\x20\xb6\x53\x1c\xfb\xf7\x9f\x3f\x5f\x4a\x0e\x3b\x62\x84\x6a\x9e\x30\x1e\x3b\xdb\xa2\x4c\xc7\x88\x9e\x26\x89\x56\x28\xf0\xd1\x87\xea\x7e\x37\xa3\x6d\x2f\xd6\x23\xa4\xd4\x7c\x96\xf2\xc3\x0e\xa8\x03\xb3\xdb\x7d\x9d\x69\x82\x8e\x7a\x04\x3d\xed\x2c\xa1\xf6\xc8\xa8\xeb\xe8\xe0\x74\x03\x1f\xea\x68\x87\x5e\x85\xef\x01\x38\x08\xa0\x23\x01\x79\x2d\xa9\xe4\x6c\xd9\xc1\x44\xdc\xaa\x85\xc0\x95\xfa\x1e\x4e\x08\x61\xc1\x78\x8d\x24\x82\x0c\x6e\x8e\xc4\x5e\xb7\x10\x18\x7f\x04\x9e\x2f\x68\x40\x63\x68\x18\x43\x14\x58\x9b\x55\xe5\xc3\x78\xf1\x0d\xf1\x6e\xb1\x3d\x39\x2b\x21\x36\xcb\x5b\x65\x36\x39\x26\x94\x6f\x61\x7c\xb8\x3c\x05\x6a\x00\xdd\xcc\x6d\x2e\x26\x08\xdd\x63\x96\x59\xd4\x05\x8c\x83\xc2\x64\x9b\x0d\x74\x76\x03\x1b\x35\xe4\x30\xf6\x38\x20\xe7\x1f\x12\xc3\x3f\x76\x8d\x0f\x26\xfb\x69\x9b\x64\xbd\x81\x64\x56\x29\xd5\x10\x2d\x2b\xbc\x66\xff\xd0\x28\xb5\xd5\x5f\xbe\x03\xca\xf7\x2b\xcb\x5e\x57\xd7\x95\x42\x2e\x74\xab\x6d\x06\x9e\x9b\xe5\xbe\x29\x6e\xc8\x4e\x3d\xee\x26\xa5\x52\xfd\x02\x00\x00\xff\xff\x03\x00\x5c\xbc\x38\x07\xc6\x01\x00\x00")
+
+func templatesUser_facets_registration_goTmplBytes() ([]byte, error) {
+	return bindataRead(
+		_templatesUser_facets_registration_goTmpl,
+		"templates/user_facets_registration_go.tmpl",
+	)
+}
+
+func templatesUser_facets_registration_goTmpl() (*asset, error) {
+	bytes, err := templatesUser_facets_registration_goTmplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "templates/user_facets_registration_go.tmpl", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -573,6 +678,8 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/input_validators_python.tmpl": templatesInput_validators_pythonTmpl,
 	"templates/oauth2_middleware.tmpl": templatesOauth2_middlewareTmpl,
 	"templates/oauth2_middleware_python.tmpl": templatesOauth2_middleware_pythonTmpl,
+	"templates/params_go.tmpl": templatesParams_goTmpl,
+	"templates/params_helper_go.tmpl": templatesParams_helper_goTmpl,
 	"templates/python_server_resource.tmpl": templatesPython_server_resourceTmpl,
 	"templates/requirements_python.tmpl": templatesRequirements_pythonTmpl,
 	"templates/server_main_go.tmpl": templatesServer_main_goTmpl,
@@ -581,6 +688,9 @@ var _bindata = map[string]func() (*asset, error){
 	"templates/server_resources_interface.tmpl": templatesServer_resources_interfaceTmpl,
 	"templates/struct.tmpl": templatesStructTmpl,
 	"templates/struct_input_validator.tmpl": templatesStruct_input_validatorTmpl,
+	"templates/user_facets_go.tmpl": templatesUser_facets_goTmpl,
+	"templates/user_facets_python.tmpl": templatesUser_facets_pythonTmpl,
+	"templates/user_facets_registration_go.tmpl": templatesUser_facets_registration_goTmpl,
 }
 
 // AssetDir returns the file names below a certain
@@ -637,6 +747,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"input_validators_python.tmpl": &bintree{templatesInput_validators_pythonTmpl, map[string]*bintree{}},
 		"oauth2_middleware.tmpl": &bintree{templatesOauth2_middlewareTmpl, map[string]*bintree{}},
 		"oauth2_middleware_python.tmpl": &bintree{templatesOauth2_middleware_pythonTmpl, map[string]*bintree{}},
+		"params_go.tmpl": &bintree{templatesParams_goTmpl, map[string]*bintree{}},
+		"params_helper_go.tmpl": &bintree{templatesParams_helper_goTmpl, map[string]*bintree{}},
 		"python_server_resource.tmpl": &bintree{templatesPython_server_resourceTmpl, map[string]*bintree{}},
 		"requirements_python.tmpl": &bintree{templatesRequirements_pythonTmpl, map[string]*bintree{}},
 		"server_main_go.tmpl": &bintree{templatesServer_main_goTmpl, map[string]*bintree{}},
@@ -645,6 +757,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"server_resources_interface.tmpl": &bintree{templatesServer_resources_interfaceTmpl, map[string]*bintree{}},
 		"struct.tmpl": &bintree{templatesStructTmpl, map[string]*bintree{}},
 		"struct_input_validator.tmpl": &bintree{templatesStruct_input_validatorTmpl, map[string]*bintree{}},
+		"user_facets_go.tmpl": &bintree{templatesUser_facets_goTmpl, map[string]*bintree{}},
+		"user_facets_python.tmpl": &bintree{templatesUser_facets_pythonTmpl, map[string]*bintree{}},
+		"user_facets_registration_go.tmpl": &bintree{templatesUser_facets_registration_goTmpl, map[string]*bintree{}},
 	}},
 }}
 
@@ -2,28 +2,50 @@ package codegen
 
 import (
 	"strings"
+
+	"github.com/Jumpscale/go-raml/raml"
 )
 
 var (
 	typeMap = map[string]string{
 		"string":  "string",
-		"file":    "string",
+		"file":    "*multipart.FileHeader",
 		"number":  "float64",
 		"integer": "int",
 		"boolean": "bool",
+		"any":     "interface{}",
+		"nil":     "interface{}",
 	}
 )
 
-func convertUnion(strType string) string {
-	if strings.Index(strType, "[]") > 0 {
-		return "[]interface{}"
+// convert from raml type expression to go type
+func convertToGoType(tip string) string {
+	// map, it is not part of RAML type expression
+	if strings.HasSuffix(tip, "{}") {
+		return "map[string]" + convertToGoType(tip[:len(tip)-2])
+	}
+
+	te, err := raml.ParseTypeExpr(tip)
+	if err != nil {
+		return normalizePkgName(tip)
 	}
-	return "interface{}"
+	return goTypeOfExpr(te)
 }
 
-// convert from raml type to go type
-func convertToGoType(tip string) string {
-	if v, ok := typeMap[tip]; ok {
+// convert from parsed raml type expression to go type
+func goTypeOfExpr(te *raml.TypeExpr) string {
+	switch te.Kind {
+	case raml.TypeExprArray:
+		return "[]" + goTypeOfExpr(te.Items)
+	case raml.TypeExprUnion:
+		// a nullable type is a pointer, the other unions are implemented as `interface{}`
+		if nonNil, ok := te.NonNil(); ok {
+			return nullableGoType(goTypeOfExpr(nonNil))
+		}
+		return "interface{}"
+	}
+
+	if v, ok := typeMap[te.Name]; ok {
 		return v
 	}
 	goramlPkgDir := func() string {
@@ -40,20 +62,101 @@ func convertToGoType(tip string) string {
 		"datetime":      goramlPkgDir + "DateTime",
 	}
 
-	if v, ok := dateMap[tip]; ok {
+	if v, ok := dateMap[te.Name]; ok {
 		return v
 	}
+	return normalizePkgName(libTypeName(te.Name))
+}
 
-	// other types that need some processing
+// convert from resolved raml type to go type
+func goType(rt *raml.ResolvedType) string {
+	if isDiscriminatorRoot(rt) { // holder of the polymorphic value
+		return goTypeName(rt) + discriminatorValueSuffix
+	}
+	return goTypeName(rt)
+}
+
+// convert from resolved raml type to go type,
+// without the polymorphism of the type which declares a discriminator
+func goTypeName(rt *raml.ResolvedType) string {
 	switch {
-	case strings.HasSuffix(tip, "[][]"): // bidimensional array
-		return "[][]" + convertToGoType(tip[:len(tip)-4])
-	case strings.HasSuffix(tip, "[]"): // array
-		return "[]" + convertToGoType(tip[:len(tip)-2])
-	case strings.HasSuffix(tip, "{}"): // map
-		return "map[string]" + convertToGoType(tip[:len(tip)-2])
-	case strings.Index(tip, "|") > 0:
-		return convertUnion(tip)
+	case !rt.IsAnonymous() && !rt.IsBuiltin(): // declared type
+		return normalizePkgName(libTypeName(rt.Name))
+	case rt.IsAnonymous() && len(rt.Parents) == 1: // inline declaration of a declared type
+		return goType(rt.Parents[0])
+	}
+
+	switch rt.Kind {
+	case raml.KindArray:
+		return "[]" + goType(rt.Items)
+	case raml.KindObject:
+		return "map[string]interface{}"
+	case raml.KindUnion:
+		if nonNil, ok := rt.NonNil(); ok {
+			return nullableGoType(goType(nonNil))
+		}
+		return "interface{}"
+	case raml.KindAny, raml.KindNil:
+		return "interface{}"
+	}
+	return convertToGoType(rt.Builtin)
+}
+
+// nullableGoType returns the Go type of a nullable type, e.g. `*string` for `string?`.
+// It is a pointer, unless nil is already a value of the Go type, e.g. a slice.
+func nullableGoType(typ string) string {
+	for _, prefix := range []string{"*", "[]", "map["} {
+		if strings.HasPrefix(typ, prefix) {
+			return typ
+		}
+	}
+	if typ == "interface{}" {
+		return typ
+	}
+	return "*" + typ
+}
+
+// isFileExpr returns true if a raml type expression is a file, e.g. `file` or `file?`
+func isFileExpr(expr string) bool {
+	te, err := raml.ParseTypeExpr(expr)
+	if err != nil {
+		return false
+	}
+	if nonNil, ok := te.NonNil(); ok {
+		te = nonNil
+	}
+	return te.IsName() && te.Name == "file"
+}
+
+// goImportPath returns the package to import for a Go type,
+// `mime/multipart` for a file or the package of the library which declares the type
+func goImportPath(rootImportPath, typ string) (string, error) {
+	typ = strings.TrimLeft(typ, "*[]")
+	if strings.HasPrefix(typ, "multipart.") {
+		return "mime/multipart", nil
+	}
+	return libImportPath(rootImportPath, typ)
+}
+
+// isNullableExpr returns true if null is a value of a raml type expression,
+// e.g. `string?`, `string | nil` or `any`
+func isNullableExpr(expr string) bool {
+	te, err := raml.ParseTypeExpr(expr)
+	if err != nil {
+		return false
+	}
+	if _, ok := te.NonNil(); ok {
+		return true
+	}
+	return te.IsName() && (te.Name == "nil" || te.Name == "any")
+}
+
+// libTypeName returns the name of a type or a security scheme relative to
+// the library which declares it, e.g. `a.b.City` is `b.City` in the package of library `b`
+func libTypeName(name string) string {
+	splitted := strings.Split(name, ".")
+	if len(splitted) <= 2 {
+		return name
 	}
-	return normalizePkgName(tip)
+	return strings.Join(splitted[len(splitted)-2:], ".")
 }
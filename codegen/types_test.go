@@ -13,7 +13,8 @@ func TestTypeConversion(t *testing.T) {
 			So(convertToGoType("number"), ShouldEqual, "float64")
 			So(convertToGoType("integer"), ShouldEqual, "int")
 			So(convertToGoType("boolean"), ShouldEqual, "bool")
-			So(convertToGoType("file"), ShouldEqual, "string")
+			So(convertToGoType("file"), ShouldEqual, "*multipart.FileHeader")
+			So(convertToGoType("file[]"), ShouldEqual, "[]*multipart.FileHeader")
 			So(convertToGoType("date-only"), ShouldEqual, "goraml.DateOnly")
 			So(convertToGoType("time-only"), ShouldEqual, "goraml.TimeOnly")
 			So(convertToGoType("Object"), ShouldEqual, "Object")
@@ -21,6 +22,17 @@ func TestTypeConversion(t *testing.T) {
 			So(convertToGoType("string[][]"), ShouldEqual, "[][]string")
 			So(convertToGoType("string | Person"), ShouldEqual, "interface{}")
 			So(convertToGoType("(string | Person)[]"), ShouldEqual, "[]interface{}")
+			So(convertToGoType("(Cat | Dog)[][]"), ShouldEqual, "[][]interface{}")
+			So(convertToGoType("string[][] | Person"), ShouldEqual, "interface{}")
+			So(convertToGoType("(Cat)[]"), ShouldEqual, "[]Cat")
+			So(convertToGoType("geo-lib.City[]"), ShouldEqual, "[]geo_lib.City")
+			So(convertToGoType("geo.City | nil"), ShouldEqual, "*geo.City")
+			So(convertToGoType("string?"), ShouldEqual, "*string")
+			So(convertToGoType("string[]?"), ShouldEqual, "[]string")
+			So(convertToGoType("string?[]"), ShouldEqual, "[]*string")
+			So(convertToGoType("Cat | Dog | nil"), ShouldEqual, "interface{}")
+			So(convertToGoType("any"), ShouldEqual, "interface{}")
+			So(convertToGoType("nil"), ShouldEqual, "interface{}")
 		})
 	})
 }
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//BundleCommand is executed to write a RAML specification as a single file
+type BundleCommand struct {
+	RamlFile string //raml file
+	Output   string //file where the bundled specification is written, default to stdout
+}
+
+//Execute writes a RAML specification as a single RAML 1.0 file.
+//The included files and the libraries are inlined in the written specification.
+func (command *BundleCommand) Execute() error {
+	log.Debug("Bundling RAML specification ", command.RamlFile)
+
+	apiDef := new(raml.APIDefinition)
+	if err := raml.ParseFile(command.RamlFile, apiDef); err != nil {
+		return err
+	}
+	contents, err := apiDef.Bundle()
+	if err != nil {
+		return err
+	}
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(contents)
+		return err
+	}
+	return ioutil.WriteFile(command.Output, contents, 0644)
+}
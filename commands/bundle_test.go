@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBundleCommand(t *testing.T) {
+	Convey("bundle command", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("libraries", func() {
+			output := filepath.Join(targetDir, "api.raml")
+			cmd := BundleCommand{
+				RamlFile: "../raml/samples/nested_libraries/api.raml",
+				Output:   output,
+			}
+			So(cmd.Execute(), ShouldBeNil)
+
+			contents, err := ioutil.ReadFile(output)
+			So(err, ShouldBeNil)
+			So(string(contents), ShouldStartWith, "#%RAML 1.0\n")
+			So(string(contents), ShouldNotContainSubstring, "uses:")
+
+			// the bundled specification is parsed without the library files
+			apiDef := new(raml.APIDefinition)
+			So(raml.ParseFile(output, apiDef), ShouldBeNil)
+			So(apiDef.Types, ShouldContainKey, "Author")
+			So(apiDef.Types["Books"].Type, ShouldEqual, "Book[]")
+			So(apiDef.Resources["/books"].Type.Name, ShouldEqual, "collection")
+		})
+
+		Convey("parse error", func() {
+			cmd := BundleCommand{
+				RamlFile: "../raml/samples/positions/type_error.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//UpgradeCommand is executed to convert a RAML 0.8 specification into RAML 1.0
+type UpgradeCommand struct {
+	RamlFile string //raml file
+	Output   string //file where the RAML 1.0 specification is written, default to stdout
+}
+
+//Execute converts a RAML 0.8 specification into RAML 1.0.
+//The included files are inlined in the written specification.
+func (command *UpgradeCommand) Execute() error {
+	log.Debug("Upgrading RAML specification ", command.RamlFile)
+
+	apiDef := new(raml.APIDefinition)
+	contents, err := raml.ParseReadFile(command.RamlFile, apiDef)
+	if err != nil {
+		return err
+	}
+	contents = append([]byte("#%RAML 1.0\n"), contents...)
+
+	if command.Output == "" {
+		_, err = os.Stdout.Write(contents)
+		return err
+	}
+	return ioutil.WriteFile(command.Output, contents, 0644)
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jumpscale/go-raml/raml"
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestUpgradeCommand(t *testing.T) {
+	Convey("upgrade command", t, func() {
+		targetDir, err := ioutil.TempDir("", "")
+		So(err, ShouldBeNil)
+
+		Convey("RAML 0.8", func() {
+			output := filepath.Join(targetDir, "api.raml")
+			cmd := UpgradeCommand{
+				RamlFile: "../raml/samples/raml08/api.raml",
+				Output:   output,
+			}
+			So(cmd.Execute(), ShouldBeNil)
+
+			contents, err := ioutil.ReadFile(output)
+			So(err, ShouldBeNil)
+			So(string(contents), ShouldStartWith, "#%RAML 1.0\n")
+			So(string(contents), ShouldNotContainSubstring, "schemas:")
+			So(string(contents), ShouldNotContainSubstring, "formParameters:")
+
+			// the written RAML 1.0 document is equivalent to the RAML 0.8 one
+			apiDef := new(raml.APIDefinition)
+			So(raml.ParseFile(output, apiDef), ShouldBeNil)
+			So(apiDef.Types, ShouldContainKey, "Book")
+			So(apiDef.Traits, ShouldContainKey, "paged")
+			So(apiDef.Resources["/books"].Get.QueryParameters["tag"].Type, ShouldEqual, "array")
+		})
+
+		Convey("parse error", func() {
+			cmd := UpgradeCommand{
+				RamlFile: "../raml/samples/positions/type_error.raml",
+				Output:   filepath.Join(targetDir, "api.raml"),
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+		})
+
+		Reset(func() {
+			os.RemoveAll(targetDir)
+		})
+	})
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/Jumpscale/go-raml/raml"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+//ValidateCommand is executed to validate a RAML specification
+type ValidateCommand struct {
+	RamlFile string    //raml file
+	Output   io.Writer //where the issues are written, default to stdout
+}
+
+//Execute validates a RAML specification.
+//It writes all issues found and returns an error if there is at least one error.
+func (command *ValidateCommand) Execute() error {
+	log.Debug("Validating RAML specification ", command.RamlFile)
+	out := command.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	var issues raml.ValidationIssues
+	apiDef := new(raml.APIDefinition)
+	if err := raml.ParseFile(command.RamlFile, apiDef); err != nil {
+		ramlErr, ok := err.(*raml.Error)
+		if !ok {
+			return err
+		}
+		for _, ed := range ramlErr.Errors {
+			issues = append(issues, raml.ValidationIssue{
+				Position: ed.Position,
+				Severity: raml.SeverityError,
+				Message:  ed.Message,
+			})
+		}
+	} else {
+		issues = raml.Validate(apiDef)
+	}
+
+	var numErrors int
+	for _, vi := range issues {
+		fmt.Fprintln(out, vi)
+		if vi.Severity == raml.SeverityError {
+			numErrors++
+		}
+	}
+	if numErrors > 0 {
+		return fmt.Errorf("%v is invalid: %v error(s), %v warning(s)",
+			command.RamlFile, numErrors, len(issues)-numErrors)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidateCommand(t *testing.T) {
+	Convey("validate command", t, func() {
+		var out bytes.Buffer
+
+		Convey("valid RAML", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/validation/valid.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldBeNil)
+			So(out.String(), ShouldBeEmpty)
+		})
+
+		Convey("invalid RAML", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/validation/api.raml",
+				Output:   &out,
+			}
+			err := cmd.Execute()
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "10 error(s), 1 warning(s)")
+			So(out.String(), ShouldContainSubstring,
+				"../raml/samples/validation/api.raml:8:5: error: types.User.address: unknown type Address\n")
+		})
+
+		Convey("parse error", func() {
+			cmd := ValidateCommand{
+				RamlFile: "../raml/samples/positions/type_error.raml",
+				Output:   &out,
+			}
+			So(cmd.Execute(), ShouldNotBeNil)
+			So(out.String(), ShouldContainSubstring, "positions/name.raml:3:12: error: ")
+		})
+	})
+}
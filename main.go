@@ -17,9 +17,12 @@ var Version = "0.1-Dev"
 var ApplicationName = "RAML code generation toolset"
 
 var (
-	serverCommand = &commands.ServerCommand{}
-	clientCommand = &commands.ClientCommand{}
-	specCommand   = &commands.SpecCommand{}
+	serverCommand   = &commands.ServerCommand{}
+	clientCommand   = &commands.ClientCommand{}
+	specCommand     = &commands.SpecCommand{}
+	validateCommand = &commands.ValidateCommand{}
+	upgradeCommand  = &commands.UpgradeCommand{}
+	bundleCommand   = &commands.BundleCommand{}
 )
 
 func main() {
@@ -145,6 +148,69 @@ func main() {
 				err := errors.New("Not implemented, check the roadmap")
 				log.Error(err)
 			},
+		}, {
+			Name:  "validate",
+			Usage: "Validate a RAML specification",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &validateCommand.RamlFile,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := validateCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		}, {
+			Name:  "upgrade",
+			Usage: "Convert a RAML 0.8 specification into RAML 1.0",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &upgradeCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "output, o",
+					Value:       "",
+					Usage:       "Destination raml file, default to stdout",
+					Destination: &upgradeCommand.Output,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := upgradeCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
+		}, {
+			Name:  "bundle",
+			Usage: "Write a RAML specification as a single file, the includes and the libraries are inlined",
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:        "ramlfile",
+					Value:       ".",
+					Usage:       "Source raml file",
+					Destination: &bundleCommand.RamlFile,
+				},
+				cli.StringFlag{
+					Name:        "output, o",
+					Value:       "",
+					Usage:       "Destination raml file, default to stdout",
+					Destination: &bundleCommand.Output,
+				},
+			},
+			Action: func(c *cli.Context) {
+				if err := bundleCommand.Execute(); err != nil {
+					log.Error(err)
+					os.Exit(1)
+				}
+			},
 		},
 	}
 
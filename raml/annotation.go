@@ -0,0 +1,459 @@
+package raml
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Annotation targets, as listed in
+// https://github.com/raml-org/raml-spec/blob/master/versions/raml-10/raml-10.md#annotation-target-location
+const (
+	TargetAPI                    = "API"
+	TargetDocumentationItem      = "DocumentationItem"
+	TargetResource               = "Resource"
+	TargetMethod                 = "Method"
+	TargetResponse               = "Response"
+	TargetRequestBody            = "RequestBody"
+	TargetResponseBody           = "ResponseBody"
+	TargetTypeDeclaration        = "TypeDeclaration"
+	TargetExample                = "Example"
+	TargetResourceType           = "ResourceType"
+	TargetTrait                  = "Trait"
+	TargetSecurityScheme         = "SecurityScheme"
+	TargetSecuritySchemeSettings = "SecuritySchemeSettings"
+	TargetAnnotationType         = "AnnotationType"
+	TargetLibrary                = "Library"
+	TargetOverlay                = "Overlay"
+	TargetExtension              = "Extension"
+)
+
+// AnnotationType declares an annotation.
+// An annotation type declaration is a type declaration
+// with an additional `allowedTargets` facet.
+type AnnotationType struct {
+	Name string `yaml:"-"`
+
+	// An alternate, human-friendly name for the annotation.
+	DisplayName string `yaml:"displayName"`
+
+	// A description of the annotation.
+	Description string `yaml:"description"`
+
+	// The type of the annotation value.
+	// The value of this node could be a type expression or
+	// an inline type declaration.
+	Type interface{} `yaml:"type"`
+
+	// The properties of an object annotation value.
+	Properties map[string]interface{} `yaml:"properties"`
+
+	// Enumeration of possible values.
+	Enum interface{} `yaml:"enum"`
+
+	// The locations to which annotations are restricted.
+	// Its value is a single target or an array of targets.
+	// If this facet is specified, annotations of this type
+	// can be applied only on a node corresponding to one of the locations.
+	AllowedTargets interface{} `yaml:"allowedTargets"`
+
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// the type declaration of the annotation value, with all its facets,
+	// nil if the annotation type wasn't unmarshalled
+	decl *Type
+}
+
+// UnmarshalYAML unmarshals an annotation type which might be
+// declared using a type expression or an inline type declaration.
+func (at *AnnotationType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var decl Type
+	if err := unmarshal(&decl); err != nil {
+		return err
+	}
+	delete(decl.FacetValues, "allowedTargets")
+
+	var expr string
+	if err := unmarshal(&expr); err == nil {
+		*at = AnnotationType{Type: expr, decl: &decl}
+		return nil
+	}
+
+	type annotationType AnnotationType
+	var a annotationType
+	if err := unmarshal(&a); err != nil {
+		return err
+	}
+	*at = AnnotationType(a)
+	at.decl = &decl
+	return nil
+}
+
+// typeDecl returns the type declaration of the annotation value
+func (at AnnotationType) typeDecl() Type {
+	if at.decl == nil {
+		return Type{Type: at.Type, Properties: at.Properties, Enum: at.Enum, Position: at.Position}
+	}
+	decl := *at.decl
+	decl.Position = at.Position
+	return decl
+}
+
+// Targets returns all locations where this annotation type
+// is allowed to be applied.
+// Empty result means that it could be applied anywhere.
+func (at AnnotationType) Targets() []string {
+	switch v := at.AllowedTargets.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		var targets []string
+		for _, t := range v {
+			targets = append(targets, fmt.Sprintf("%v", t))
+		}
+		return targets
+	}
+	return nil
+}
+
+// isAllowedIn returns true if this annotation type can be applied
+// to the given target
+func (at AnnotationType) isAllowedIn(target string) bool {
+	targets := at.Targets()
+	if len(targets) == 0 {
+		return true
+	}
+	for _, t := range targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Annotations holds all annotations applied to a node.
+// The key is the annotation name, including the enclosing parentheses,
+// the value is the annotation value.
+type Annotations map[string]interface{}
+
+// Has returns true if the annotation with the given name
+// (without parentheses) is applied.
+func (a Annotations) Has(name string) bool {
+	_, ok := a["("+name+")"]
+	return ok
+}
+
+// Value returns value of the annotation with the given name
+// (without parentheses) or nil if it is not applied.
+func (a Annotations) Value(name string) interface{} {
+	return a["("+name+")"]
+}
+
+// Names returns sorted names (without parentheses) of all applied annotations.
+func (a Annotations) Names() []string {
+	var names []string
+	for k := range a {
+		names = append(names, annotationName(k))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// strip parentheses from an annotation key
+func annotationName(key string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(key, "("), ")")
+}
+
+// isAnnotationKey returns true if a mapping key is an annotation
+func isAnnotationKey(key string) bool {
+	return strings.HasPrefix(key, "(") && strings.HasSuffix(key, ")")
+}
+
+// annotationValidator validates all annotations in a document
+// against their declarations.
+type annotationValidator struct {
+	annotationTypes map[string]AnnotationType
+	errs            *Error
+
+	// resolvers of the types of the annotation values by annotation name,
+	// the types of a library annotation are resolved in its library
+	resolvers map[string]*TypeResolver
+}
+
+// create annotation validator of a document.
+// Annotation types and types from the libraries are
+// available under `libname.name`.
+func newAnnotationValidator(annotationTypes map[string]AnnotationType, types map[string]Type,
+	libraries map[string]*Library) *annotationValidator {
+	av := &annotationValidator{
+		errs:            &Error{},
+		annotationTypes: map[string]AnnotationType{},
+		resolvers:       map[string]*TypeResolver{},
+	}
+	resolver := NewTypeResolver(types, libraries)
+	for name, at := range annotationTypes {
+		at.Name = name
+		av.annotationTypes[name] = at
+		av.resolvers[name] = resolver
+	}
+	for libName, lib := range libraries {
+		libResolver := lib.TypeResolver()
+		for name, at := range lib.AnnotationTypes {
+			at.Name = libName + "." + name
+			av.annotationTypes[at.Name] = at
+			av.resolvers[at.Name] = libResolver
+		}
+	}
+	return av
+}
+
+// validate all annotations applied to a node
+func (av *annotationValidator) validate(annotations Annotations, target, location string, pos Position) {
+	for key, val := range annotations {
+		name := annotationName(key)
+		at, ok := av.annotationTypes[name]
+		if !ok {
+			av.errs.add(pos, "%v: unknown annotation %v", location, key)
+			continue
+		}
+		if !at.isAllowedIn(target) {
+			av.errs.add(pos, "%v: annotation %v is not allowed in %v, allowed targets:%v",
+				location, key, target, at.Targets())
+			continue
+		}
+		rt, err := av.resolvers[name].ResolveDecl(at.typeDecl())
+		if err != nil {
+			av.errs.add(pos, "%v: invalid type of annotation %v: %v", location, key, err)
+			continue
+		}
+		for _, m := range checkInstance(val, rt, "") {
+			av.errs.add(pos, "%v: invalid value of annotation %v%v: %v", location, key, m.Path, m.Message)
+		}
+	}
+}
+
+// validate all annotations in named parameters
+func (av *annotationValidator) validateNamedParams(params map[string]NamedParameter, location string) {
+	for name, np := range params {
+		av.validateNamedParam(np, location+"."+name)
+	}
+}
+
+// validate all annotations in headers
+func (av *annotationValidator) validateHeaders(headers map[HTTPHeader]Header, location string) {
+	for name, h := range headers {
+		av.validateNamedParam(NamedParameter(h), location+"."+string(name))
+	}
+}
+
+// validate all annotations in a named parameter, its items and its example
+func (av *annotationValidator) validateNamedParam(np NamedParameter, location string) {
+	av.validate(np.Annotations, TargetTypeDeclaration, location, np.Position)
+	av.validateItems(np.Items, location, np.Position)
+	av.validateExamples(np.Example, nil, location, np.Position)
+}
+
+// validate all annotations in a type, its properties, its items and its examples
+func (av *annotationValidator) validateType(t Type, location string) {
+	av.validate(t.Annotations, TargetTypeDeclaration, location, t.Position)
+	av.validateProperties(t.Properties, location, t.Position)
+	av.validateItems(t.Items, location, t.Position)
+	av.validateExamples(t.Example, t.Examples, location, t.Position)
+}
+
+// validate all annotations in properties, including the nested properties
+// of the inline type declarations
+func (av *annotationValidator) validateProperties(props map[string]interface{}, location string, pos Position) {
+	for name, p := range props {
+		prop := ToProperty(name, p)
+		av.validate(prop.Annotations, TargetTypeDeclaration, location+"."+prop.Name, pos)
+		av.validateInlineDecl(p, location+"."+prop.Name, pos)
+	}
+}
+
+// validate the items of an array declared with an inline type declaration
+func (av *annotationValidator) validateItems(items interface{}, location string, pos Position) {
+	if decl, ok := items.(map[interface{}]interface{}); ok {
+		location += ".items"
+		av.validate(ToProperty("items", decl).Annotations, TargetTypeDeclaration, location, pos)
+		av.validateInlineDecl(decl, location, pos)
+	}
+}
+
+// validate all annotations in the properties, the items and the examples
+// of an inline type declaration
+func (av *annotationValidator) validateInlineDecl(decl interface{}, location string, pos Position) {
+	m, ok := decl.(map[interface{}]interface{})
+	if !ok {
+		return
+	}
+	if props, ok := m["properties"].(map[interface{}]interface{}); ok {
+		nested := map[string]interface{}{}
+		for name, p := range props {
+			nested[fmt.Sprintf("%v", name)] = p
+		}
+		av.validateProperties(nested, location, pos)
+	}
+	av.validateItems(m["items"], location, pos)
+
+	examples := map[string]interface{}{}
+	if named, ok := m["examples"].(map[interface{}]interface{}); ok {
+		for name, ex := range named {
+			examples[fmt.Sprintf("%v", name)] = ex
+		}
+	}
+	av.validateExamples(m["example"], examples, location, pos)
+}
+
+// validate the annotations of the examples declared in the expanded form,
+// e.g. `{value: 5, (reviewed): true}`
+func (av *annotationValidator) validateExamples(example interface{}, examples map[string]interface{}, location string, pos Position) {
+	validate := func(example interface{}, location string) {
+		m, ok := expandedExample(example)
+		if !ok {
+			return
+		}
+		annotations := Annotations{}
+		for k, v := range m {
+			if key := fmt.Sprintf("%v", k); isAnnotationKey(key) {
+				annotations[key] = v
+			}
+		}
+		av.validate(annotations, TargetExample, location, pos)
+	}
+	validate(example, location+".example")
+	for name, ex := range examples {
+		validate(ex, location+".examples."+name)
+	}
+}
+
+// validate all annotations in a body
+func (av *annotationValidator) validateBodies(b Bodies, target, location string) {
+	av.validate(b.Annotations, target, location, b.Position)
+	av.validateProperties(b.Properties, location, b.Position)
+	av.validateExamples(b.Example, b.Examples, location, b.Position)
+	for mt, body := range b.ForMIMEType {
+		av.validate(body.Annotations, target, location+"."+mt, body.Position)
+		av.validateProperties(body.Properties, location+"."+mt, body.Position)
+		av.validateExamples(body.Example, body.Examples, location+"."+mt, body.Position)
+	}
+}
+
+func (av *annotationValidator) validateResponses(responses map[HTTPCode]Response, location string) {
+	for code, resp := range responses {
+		loc := fmt.Sprintf("%v.responses.%v", location, code)
+		av.validate(resp.Annotations, TargetResponse, loc, resp.Position)
+		av.validateHeaders(resp.Headers, loc+".headers")
+		av.validateBodies(resp.Bodies, TargetResponseBody, loc+".body")
+	}
+}
+
+func (av *annotationValidator) validateMethod(m *Method, location string) {
+	if m == nil {
+		return
+	}
+	location = location + "." + strings.ToLower(m.Name)
+	av.validate(m.Annotations, TargetMethod, location, m.Position)
+	av.validateNamedParams(m.QueryParameters, location+".queryParameters")
+	av.validateHeaders(m.Headers, location+".headers")
+	av.validateBodies(m.Bodies, TargetRequestBody, location+".body")
+	av.validateResponses(m.Responses, location)
+}
+
+func (av *annotationValidator) validateResource(r *Resource, location string) {
+	location = location + r.URI
+	av.validate(r.Annotations, TargetResource, location, r.Position)
+	av.validateNamedParams(r.URIParameters, location+".uriParameters")
+	for _, m := range r.Methods {
+		av.validateMethod(m, location)
+	}
+	for _, n := range r.Nested {
+		av.validateResource(n, location)
+	}
+}
+
+func (av *annotationValidator) validateTrait(t Trait, location string) {
+	av.validate(t.Annotations, TargetTrait, location, t.Position)
+	av.validateNamedParams(t.QueryParameters, location+".queryParameters")
+	av.validateHeaders(t.Headers, location+".headers")
+	av.validateBodies(t.Bodies, TargetRequestBody, location+".body")
+	av.validateResponses(t.Responses, location)
+}
+
+func (av *annotationValidator) validateResourceType(rt ResourceType, location string) {
+	av.validate(rt.Annotations, TargetResourceType, location, rt.Position)
+	av.validateNamedParams(rt.URIParameters, location+".uriParameters")
+	for _, m := range rt.methods {
+		av.validateMethod(m, location)
+	}
+}
+
+func (av *annotationValidator) validateSecurityScheme(ss SecurityScheme, location string) {
+	av.validate(ss.Annotations, TargetSecurityScheme, location, ss.Position)
+	av.validate(ss.DescribedBy.Annotations, TargetSecurityScheme, location+".describedBy", ss.DescribedBy.Position)
+	av.validateNamedParams(ss.DescribedBy.QueryParameters, location+".describedBy.queryParameters")
+	av.validateHeaders(ss.DescribedBy.Headers, location+".describedBy.headers")
+	av.validateResponses(ss.DescribedBy.Responses, location+".describedBy")
+}
+
+// validate declarations shared by API definition and library
+func (av *annotationValidator) validateDeclarations(types map[string]Type, traits map[string]Trait,
+	resourceTypes map[string]ResourceType, schemes map[string]SecurityScheme,
+	annotationTypes map[string]AnnotationType) {
+
+	for name, t := range types {
+		av.validateType(t, "types."+name)
+	}
+	for name, t := range traits {
+		av.validateTrait(t, "traits."+name)
+	}
+	for name, rt := range resourceTypes {
+		av.validateResourceType(rt, "resourceTypes."+name)
+	}
+	for name, ss := range schemes {
+		av.validateSecurityScheme(ss, "securitySchemes."+name)
+	}
+	for name, at := range annotationTypes {
+		av.validate(at.Annotations, TargetAnnotationType, "annotationTypes."+name, at.Position)
+	}
+}
+
+// returns validation errors as an error, or nil if there is no error
+func (av *annotationValidator) err() error {
+	av.errs.sort()
+	return av.errs.orNil()
+}
+
+// validateAnnotations validates all annotations applied in an API definition
+// against the declared annotation types.
+// Annotations in the libraries are validated when the library is parsed.
+func (apiDef *APIDefinition) validateAnnotations() error {
+	av := newAnnotationValidator(apiDef.AnnotationTypes, apiDef.Types, apiDef.Libraries)
+
+	av.validate(apiDef.Annotations, TargetAPI, "API", apiDef.Position)
+	av.validateNamedParams(apiDef.BaseURIParameters, "baseUriParameters")
+	for i, doc := range apiDef.Documentation {
+		av.validate(doc.Annotations, TargetDocumentationItem, fmt.Sprintf("documentation[%v]", i), doc.Position)
+	}
+	av.validateDeclarations(apiDef.Types, apiDef.Traits, apiDef.ResourceTypes,
+		apiDef.SecuritySchemes, apiDef.AnnotationTypes)
+
+	for _, r := range apiDef.Resources {
+		av.validateResource(&r, "")
+	}
+	return av.err()
+}
+
+// validateAnnotations validates all annotations applied in a library
+// against the declared annotation types.
+func (l *Library) validateAnnotations() error {
+	av := newAnnotationValidator(l.AnnotationTypes, l.Types, l.Libraries)
+
+	av.validate(l.Annotations, TargetLibrary, "Library", l.Position)
+	av.validateDeclarations(l.Types, l.Traits, l.ResourceTypes, l.SecuritySchemes, l.AnnotationTypes)
+
+	return av.err()
+}
@@ -0,0 +1,72 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestAnnotations(t *testing.T) {
+	Convey("Annotations", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("valid annotations", func() {
+			err := ParseFile("./samples/annotations.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// annotation types
+			So(apiDef.AnnotationTypes, ShouldContainKey, "deprecated")
+			So(apiDef.AnnotationTypes["deprecated"].Type, ShouldEqual, "nil")
+			So(apiDef.AnnotationTypes["experimental"].Targets(), ShouldResemble, []string{"Resource", "Method"})
+			So(apiDef.AnnotationTypes["clearanceLevel"].Targets(), ShouldResemble, []string{"Method"})
+			So(apiDef.AnnotationTypes["clearanceLevel"].Properties, ShouldContainKey, "signature")
+			So(apiDef.Libraries["meta"].AnnotationTypes, ShouldContainKey, "owner")
+
+			// API & documentation
+			So(apiDef.Annotations.Has("deprecated"), ShouldBeTrue)
+			So(apiDef.Documentation[0].Annotations.Value("badge"), ShouldEqual, "intro")
+
+			// types & properties
+			user := apiDef.Types["User"]
+			So(user.Annotations.Value("level"), ShouldEqual, "high")
+			So(ToProperty("name", user.Properties["name"]).Annotations.Value("rank"), ShouldEqual, 1)
+			example := user.Example.(map[interface{}]interface{})
+			So(example["(reviewed)"], ShouldBeTrue)
+
+			// resource
+			users := apiDef.Resources["/users"]
+			So(users.Annotations.Names(), ShouldResemble, []string{"experimental", "meta.owner"})
+			So(users.Annotations.Value("meta.owner"), ShouldEqual, "users-team")
+
+			// method
+			cl := users.Get.Annotations.Value("clearanceLevel").(map[interface{}]interface{})
+			So(cl["level"], ShouldEqual, "medium")
+			So(users.Get.Annotations.Value("tags"), ShouldResemble, []interface{}{"read", "users"})
+
+			// response & body
+			resp := users.Get.Responses[200]
+			So(resp.Annotations.Value("badge"), ShouldEqual, "ok")
+			So(resp.Bodies.ForMIMEType["application/json"].Annotations.Value("badge"), ShouldEqual, "list")
+			So(resp.Bodies.ForMIMEType, ShouldNotContainKey, "(badge)")
+		})
+
+		Convey("invalid annotations", func() {
+			err := ParseFile("./samples/bad_annotations.raml", apiDef)
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 8)
+			So(err.Error(), ShouldContainSubstring, "annotation (experimental) is not allowed in API")
+			So(err.Error(), ShouldContainSubstring, "/users: unknown annotation (unknown)")
+			So(err.Error(), ShouldContainSubstring, "/users.get: invalid value of annotation (level)")
+			So(err.Error(), ShouldContainSubstring, "/users.get: invalid value of annotation (rank)")
+			So(err.Error(), ShouldContainSubstring, "/users.post: invalid value of annotation (code): \"waytoolong\" is longer than 3 characters")
+			So(err.Error(), ShouldContainSubstring, "/users.post: invalid value of annotation (stars): 99 is greater than maximum 5")
+
+			// nested properties & examples
+			So(err.Error(), ShouldContainSubstring, "types.User.address.city: invalid value of annotation (stars): 0 is less than minimum 1")
+			So(err.Error(), ShouldContainSubstring, "types.User.examples.paris: invalid value of annotation (reviewed)")
+		})
+	})
+}
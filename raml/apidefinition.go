@@ -1,11 +1,5 @@
 package raml
 
-import (
-	"fmt"
-	"path/filepath"
-	"strings"
-)
-
 // APIDefinition describes the basic information of an API, such as its
 // title and base URI, and describes how to define common schema references.
 type APIDefinition struct {
@@ -14,6 +8,10 @@ type APIDefinition struct {
 	// A short, plain-text label for the API.
 	Title string `yaml:"title" validate:"nonzero"`
 
+	// A substantial, human-friendly description of the API.
+	// Its value is a string and MAY be formatted using markdown.
+	Description string `yaml:"description"`
+
 	// The version of the API, for example "v1"
 	Version string `yaml:"version"`
 
@@ -47,7 +45,12 @@ type APIDefinition struct {
 	// The media type applies to requests having a body,
 	// the expected responses, and examples using the same sequence of media type strings.
 	// Each value needs to conform to the media type specification in RFC6838.
-	MediaType string `yaml:"mediaType"`
+	MediaTypes MediaTypes `yaml:"mediaType"`
+
+	// The first default media type of the API.
+	//
+	// Deprecated: use MediaTypes, the API can declare several media types.
+	MediaType string `yaml:"-"`
 
 	// Additional overall documentation for the API.
 	// The API definition can include a variety of documents that serve as a
@@ -71,7 +74,13 @@ type APIDefinition struct {
 	// Declarations of resource types for use within the API.
 	ResourceTypes map[string]ResourceType `yaml:"resourceTypes"`
 
-	// TODO : annontation types
+	// Declarations of annotation types for use by annotations.
+	AnnotationTypes map[string]AnnotationType `yaml:"annotationTypes"`
+
+	// Annotations to be applied to this API.
+	// An annotation is a map having a key that begins with "(" and ends with ")"
+	// where the text enclosed in parentheses is the annotation name.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
 
 	// Declarations of security schemes for use within the API.
 	SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes"`
@@ -90,6 +99,28 @@ type APIDefinition struct {
 	Libraries map[string]*Library `yaml:"-"`
 
 	Filename string
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+}
+
+// MediaTypes are the default media types of the bodies of an API,
+// declared as a single media type or a sequence of media types.
+type MediaTypes []string
+
+// UnmarshalYAML unmarshals a media type or a sequence of media types
+func (mt *MediaTypes) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var single string
+	if err := unmarshal(&single); err == nil {
+		*mt = MediaTypes{single}
+		return nil
+	}
+	var sequence []string
+	if err := unmarshal(&sequence); err != nil {
+		return err
+	}
+	*mt = MediaTypes(sequence)
+	return nil
 }
 
 // PostProcess doing additional processing
@@ -99,16 +130,17 @@ type APIDefinition struct {
 // - allocate map fields
 func (apiDef *APIDefinition) PostProcess(filename string) error {
 	apiDef.Filename = filename
-	// libraries
-	apiDef.Libraries = map[string]*Library{}
-
-	for name, path := range apiDef.Uses {
-		lib := &Library{Filename: path}
-		if err := ParseFile(filepath.Join(ramlFileDir, path), lib); err != nil {
-			return fmt.Errorf("apiDef.PostProcess() failed to parse library	name=%v, path=%v\n\terr=%v",
-				name, path, err)
-		}
-		apiDef.Libraries[name] = lib
+
+	// libraries are loaded by the parser
+	if apiDef.Libraries == nil {
+		apiDef.Libraries = map[string]*Library{}
+	}
+	setOptionalParams(apiDef.BaseURIParameters)
+
+	// library qualified names
+	if err := checkReferences(apiDef.Traits, apiDef.ResourceTypes, apiDef.SecuritySchemes,
+		apiDef.Libraries, apiDef.Resources, apiDef.SecuredBy, apiDef.Position); err != nil {
+		return err
 	}
 
 	// traits
@@ -116,40 +148,47 @@ func (apiDef *APIDefinition) PostProcess(filename string) error {
 		t.postProcess(name)
 		apiDef.Traits[name] = t
 	}
+	traits := allTraits(apiDef.Traits, apiDef.Libraries)
 
 	// resource types
 	for name, rt := range apiDef.ResourceTypes {
-		rt.postProcess(name, apiDef.Traits)
+		if err := rt.postProcess(name, traits); err != nil {
+			return err
+		}
 		apiDef.ResourceTypes[name] = rt
 	}
+	resourceTypes := apiDef.allResourceTypes()
+	if err := checkBaseResourceTypes(apiDef.ResourceTypes, resourceTypes); err != nil {
+		return err
+	}
 
 	// resources
 	for k := range apiDef.Resources {
 		r := apiDef.Resources[k]
-		if err := r.postProcess(k, nil, apiDef.allResourceTypes(), apiDef.Traits); err != nil {
+		if err := r.postProcess(k, nil, resourceTypes, traits); err != nil {
 			return err
 		}
 		apiDef.Resources[k] = r
 	}
-	return nil
+
+	// default media types of the bodies
+	if len(apiDef.MediaTypes) > 0 {
+		apiDef.MediaType = apiDef.MediaTypes[0]
+	}
+	for _, r := range apiDef.Resources {
+		r.applyMediaTypes(apiDef.MediaTypes)
+	}
+
+	// annotations
+	return apiDef.validateAnnotations()
 }
 
 // AllResourceTypes gets all resource type that defined in this api definition.
 // resource types could be from:
 // - this document itself
-// - library
+// - library, including the libraries used by a library
 func (apiDef *APIDefinition) allResourceTypes() map[string]ResourceType {
-	rts := apiDef.ResourceTypes
-	if len(rts) == 0 {
-		rts = map[string]ResourceType{}
-	}
-
-	for libName, l := range apiDef.Libraries {
-		for rtName, rt := range l.ResourceTypes {
-			rts[fmt.Sprintf("%v.%v", libName, rtName)] = rt
-		}
-	}
-	return rts
+	return allResourceTypes(apiDef.ResourceTypes, apiDef.Libraries)
 }
 
 // FindLibFile find lbrary file by it's name
@@ -160,8 +199,12 @@ func (apiDef *APIDefinition) FindLibFile(name string) string {
 		return filename
 	}
 
-	// search in included libraries
+	// search in included libraries,
+	// their file is relative to the directory of the API definition
 	for _, lib := range apiDef.Libraries {
+		if used, ok := lib.Libraries[name]; ok && used.Filename != "" {
+			return used.Filename
+		}
 		if filename, ok := lib.Uses[name]; ok {
 			return filename
 		}
@@ -170,25 +213,7 @@ func (apiDef *APIDefinition) FindLibFile(name string) string {
 }
 
 // GetSecurityScheme gets security scheme by it's name
-// it also search in included library
+// it also search in included library, including the libraries used by a library
 func (apiDef *APIDefinition) GetSecurityScheme(name string) (SecurityScheme, bool) {
-	var ss SecurityScheme
-	var ok bool
-
-	// split library name by '.'
-	// if there is '.', it means we need to look from the library
-	splitted := strings.Split(strings.TrimSpace(name), ".")
-
-	switch len(splitted) {
-	case 1:
-		ss, ok = apiDef.SecuritySchemes[name]
-	case 2:
-		var l *Library
-		l, ok = apiDef.Libraries[splitted[0]]
-		if !ok {
-			return ss, false
-		}
-		ss, ok = l.SecuritySchemes[splitted[1]]
-	}
-	return ss, ok
+	return findSecurityScheme(name, apiDef.SecuritySchemes, apiDef.Libraries)
 }
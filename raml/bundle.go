@@ -0,0 +1,424 @@
+package raml
+
+// This file contains the bundler, which writes an API definition
+// and the libraries it uses as a single RAML 1.0 document.
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var (
+	// kinds of the declarations of a library, in the order they are written,
+	// they are the keys of the declarations in a document
+	bundleKinds = []string{
+		locationTypes,
+		locationTraits,
+		locationResourceTypes,
+		locationAnnotationTypes,
+		locationSecuritySchemes,
+	}
+)
+
+// Bundle serializes an API definition into a single RAML 1.0 document,
+// in which the included files and the libraries are inlined.
+//
+// The declarations of the libraries are declared by the API definition.
+// They keep their name unless it is already declared, they are then
+// prefixed by the name of their library, e.g. `lib_Book` for `lib.Book`.
+// The references to the declarations of the libraries are renamed:
+// the type expressions, the applied traits, resource types and security
+// schemes, the annotations and the type expressions given as the
+// parameters which the traits and resource types use as types.
+func (apiDef *APIDefinition) Bundle() ([]byte, error) {
+	s := &serializer{
+		dir:   filepath.Dir(apiDef.Filename),
+		files: map[string][]byte{},
+	}
+	doc := s.in(apiDef.Filename).apiDefinition(apiDef)
+
+	b := &bundler{
+		serializer: s,
+		taken:      map[string]map[string]bool{},
+		byPath:     map[string]*bundleScope{},
+	}
+	for _, kind := range bundleKinds {
+		b.taken[kind] = map[string]bool{}
+		if decls, ok := doc.value(kind).(mapping); ok {
+			for _, item := range decls {
+				b.taken[kind][fmt.Sprint(item.Key)] = true
+			}
+		}
+	}
+	for name := range builtinTypes {
+		b.taken[locationTypes][name] = true
+	}
+
+	root := &bundleScope{
+		doc:       doc,
+		libraries: b.libraryScopes("", apiDef.Uses, apiDef.Libraries, ""),
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	var bundled mapping
+	for _, item := range root.rename(doc, locationRoot).(mapping) {
+		if item.Key != "uses" {
+			bundled = append(bundled, item)
+		}
+	}
+	for _, kind := range bundleKinds {
+		decls := map[string]interface{}{}
+		if existing, ok := bundled.value(kind).(mapping); ok {
+			for _, item := range existing {
+				decls[fmt.Sprint(item.Key)] = item.Value
+			}
+		}
+		for _, sc := range b.scopes {
+			for _, item := range sc.declarations(kind) {
+				decls[fmt.Sprint(item.Key)] = item.Value
+			}
+		}
+		if len(decls) > 0 {
+			bundled = setDeclarations(bundled, kind, sortedMapping(decls))
+		}
+	}
+	return marshalDocument(ramlVersionHeader, bundled)
+}
+
+// bundler inlines the libraries of an API definition
+type bundler struct {
+	*serializer
+	taken  map[string]map[string]bool // declared names by kind
+	byPath map[string]*bundleScope    // scopes of the libraries by their path
+	scopes []*bundleScope             // scopes of the libraries, in the order they are declared
+}
+
+// bundleScope resolves the references of the API definition or of a library
+type bundleScope struct {
+	doc       mapping                      // root mapping of the library
+	names     map[string]map[string]string // new names of the declarations of the library by kind
+	libraries map[string]*bundleScope      // scopes of the libraries used by the document
+}
+
+// libraryScopes returns the scopes of the libraries used by a document,
+// dir is the directory of the document relative to the directory of the API
+// definition and qualifier is the prefix of the names of its libraries, e.g. `lib.`.
+// The names of the declarations are given in the order of the qualified
+// names of the libraries, a library used twice is only declared once.
+func (b *bundler) libraryScopes(dir string, uses map[string]string, libraries map[string]*Library, qualifier string) map[string]*bundleScope {
+	var names []string
+	for name := range uses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	scopes := map[string]*bundleScope{}
+	for _, name := range names {
+		lib, ok := libraries[name]
+		if !ok {
+			continue
+		}
+		path := filepath.ToSlash(filepath.Join(dir, uses[name]))
+		if sc, ok := b.byPath[path]; ok {
+			scopes[name] = sc
+			continue
+		}
+
+		sc := &bundleScope{
+			doc:   b.in(filepath.Join(b.dir, path)).library(lib),
+			names: map[string]map[string]string{},
+		}
+		b.byPath[path] = sc
+		b.scopes = append(b.scopes, sc)
+		for _, kind := range bundleKinds {
+			sc.names[kind] = map[string]string{}
+			if decls, ok := sc.doc.value(kind).(mapping); ok {
+				for _, item := range decls {
+					declName := fmt.Sprint(item.Key)
+					sc.names[kind][declName] = b.declare(kind, qualifier+name, declName)
+				}
+			}
+		}
+		sc.libraries = b.libraryScopes(filepath.Dir(path), lib.Uses, lib.Libraries, qualifier+name+".")
+		scopes[name] = sc
+	}
+	return scopes
+}
+
+// declare returns the name of a declaration of a library in the bundled document,
+// library is the qualified name of the library, e.g. `lib.sub`
+func (b *bundler) declare(kind, library, name string) string {
+	taken := b.taken[kind]
+	bundled := name
+	if taken[bundled] {
+		bundled = strings.Replace(library, ".", "_", -1) + "_" + name
+	}
+	for i := 2; taken[bundled]; i++ {
+		bundled = fmt.Sprintf("%v_%v%d", strings.Replace(library, ".", "_", -1), name, i)
+	}
+	taken[bundled] = true
+	return bundled
+}
+
+// declarations returns the declarations of a kind of the library,
+// with their new name and their references renamed
+func (sc *bundleScope) declarations(kind string) mapping {
+	decls, _ := sc.doc.value(kind).(mapping)
+	location := childLocation(locationRoot, kind)
+
+	var m mapping
+	for _, item := range decls {
+		name := fmt.Sprint(item.Key)
+		m.set(sc.names[kind][name], sc.rename(item.Value, childLocation(location, name)))
+	}
+	return m
+}
+
+// renameRef returns the new name of a reference to a declaration,
+// e.g. `lib.sub.Book`
+func (sc *bundleScope) renameRef(kind, ref string) string {
+	if i := strings.Index(ref, "."); i > 0 {
+		if lib, ok := sc.libraries[ref[:i]]; ok {
+			return lib.renameRef(kind, ref[i+1:])
+		}
+		return ref
+	}
+	if name, ok := sc.names[kind][ref]; ok {
+		return name
+	}
+	return ref
+}
+
+// renameTypeExpr renames the types of a type expression,
+// the inline schemas and the invalid expressions are not changed
+func (sc *bundleScope) renameTypeExpr(expr string) string {
+	return renameTypeNames(expr, func(name string) string {
+		return sc.renameRef(locationTypes, name)
+	})
+}
+
+// renameChoices renames the applied traits, resource types or security schemes
+// and the parameters which they use as types
+func (sc *bundleScope) renameChoices(v interface{}, kind string) interface{} {
+	switch val := v.(type) {
+	case string:
+		return sc.renameRef(kind, val)
+	case []interface{}:
+		choices := make([]interface{}, 0, len(val))
+		for _, choice := range val {
+			choices = append(choices, sc.renameChoices(choice, kind))
+		}
+		return choices
+	case mapping:
+		m := make(mapping, 0, len(val))
+		for _, item := range val {
+			ref := fmt.Sprint(item.Key)
+			params, ok := item.Value.(mapping)
+			if ok {
+				typeParams := sc.typeParams(kind, ref)
+				params = append(mapping(nil), params...)
+				for i := range params {
+					param, ok := params[i].Value.(string)
+					if ok && typeParams[fmt.Sprint(params[i].Key)] {
+						params[i].Value = sc.renameTypeExpr(param)
+					}
+				}
+				item.Value = params
+			}
+			m.set(sc.renameRef(kind, ref), item.Value)
+		}
+		return m
+	}
+	return v
+}
+
+// declaration returns the declaration of a reference, e.g. `lib.paged`,
+// and the scope in which it is declared
+func (sc *bundleScope) declaration(kind, ref string) (interface{}, *bundleScope) {
+	if i := strings.Index(ref, "."); i > 0 {
+		if lib, ok := sc.libraries[ref[:i]]; ok {
+			return lib.declaration(kind, ref[i+1:])
+		}
+	}
+	decls, _ := sc.doc.value(kind).(mapping)
+	return decls.value(ref), sc
+}
+
+// typeParams returns the parameters of an applied trait or resource type
+// which it uses in its type expressions, e.g. `item` for `type: <<item>>[]`.
+// The other parameters are not renamed, even if their value is the name of a type.
+func (sc *bundleScope) typeParams(kind, ref string) map[string]bool {
+	params := map[string]bool{}
+	decl, declScope := sc.declaration(kind, ref)
+	location := childLocation(childLocation(locationRoot, kind), ref)
+	declScope.collectTypeParams(decl, location, params)
+	return params
+}
+
+// collectTypeParams adds the parameters used in the type expressions
+// of a value at a location of a declaration, including the parameters
+// given as type parameters of the traits and resource types it applies
+func (sc *bundleScope) collectTypeParams(v interface{}, location string, params map[string]bool) {
+	switch val := v.(type) {
+	case string:
+		if location == fragmentDataType || location == fragmentAnnotationTypeDeclaration {
+			addParamNames(val, params)
+		}
+	case []interface{}:
+		for _, item := range val {
+			sc.collectTypeParams(item, location, params)
+		}
+	case mapping:
+		for _, item := range val {
+			key, _ := item.Key.(string)
+			if isAnnotationKey(key) {
+				continue
+			}
+			child, kind := childRef(location, key)
+			switch {
+			case kind != "":
+				sc.collectChoicesTypeParams(item.Value, kind, params)
+			case child != "":
+				sc.collectTypeParams(item.Value, child, params)
+			}
+		}
+	}
+}
+
+// collectChoicesTypeParams adds the parameters given as
+// type parameters of the applied traits or resource types
+func (sc *bundleScope) collectChoicesTypeParams(v interface{}, kind string, params map[string]bool) {
+	switch val := v.(type) {
+	case []interface{}:
+		for _, choice := range val {
+			sc.collectChoicesTypeParams(choice, kind, params)
+		}
+	case mapping:
+		for _, item := range val {
+			choiceParams, ok := item.Value.(mapping)
+			if !ok {
+				continue
+			}
+			typeParams := sc.typeParams(kind, fmt.Sprint(item.Key))
+			for _, param := range choiceParams {
+				if value, ok := param.Value.(string); ok && typeParams[fmt.Sprint(param.Key)] {
+					addParamNames(value, params)
+				}
+			}
+		}
+	}
+}
+
+// addParamNames adds the names of the parameters of a value,
+// e.g. `item` for `<<item | !pluralize>>`
+func addParamNames(value string, params map[string]bool) {
+	for _, p := range dcRe.FindAllStringSubmatch(value, -1) {
+		params[strings.TrimSpace(strings.SplitN(p[1], "|", 2)[0])] = true
+	}
+}
+
+// rename returns a copy of a value at a location of a document,
+// in which the references to the declarations are renamed.
+// The values at an unknown location, e.g. the examples, are not changed.
+func (sc *bundleScope) rename(v interface{}, location string) interface{} {
+	switch val := v.(type) {
+	case string:
+		if location == fragmentDataType || location == fragmentAnnotationTypeDeclaration {
+			return sc.renameTypeExpr(val)
+		}
+	case []interface{}: // multiple inheritance
+		items := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			items = append(items, sc.rename(item, location))
+		}
+		return items
+	case mapping:
+		m := make(mapping, 0, len(val))
+		for _, item := range val {
+			key, _ := item.Key.(string)
+			if isAnnotationKey(key) {
+				m.set("("+sc.renameRef(locationAnnotationTypes, annotationName(key))+")", item.Value)
+				continue
+			}
+			m.set(item.Key, sc.renameChild(item.Value, location, key))
+		}
+		return m
+	}
+	return v
+}
+
+// renameChild renames the references of the value of a mapping key,
+// given the location of the mapping
+func (sc *bundleScope) renameChild(v interface{}, location, key string) interface{} {
+	child, kind := childRef(location, key)
+	switch {
+	case kind != "":
+		return sc.renameChoices(v, kind)
+	case child != "":
+		return sc.rename(v, child)
+	}
+	return v
+}
+
+// childRef returns the location of the value of a mapping key given the
+// location of the mapping, or the kind of the declarations the value
+// applies, e.g. the traits for `is`
+func childRef(location, key string) (child, kind string) {
+	switch location {
+	case locationRoot, locationResource, fragmentResourceType, locationMethod, fragmentTrait:
+		switch key {
+		case "is":
+			return "", locationTraits
+		case "securedBy":
+			return "", locationSecuritySchemes
+		case "type":
+			if location == locationResource || location == fragmentResourceType {
+				return "", locationResourceTypes
+			}
+		}
+	case locationBody, fragmentDataType:
+		if key == "formParameters" {
+			return locationTypes, ""
+		}
+	}
+	return childLocation(location, strings.TrimSuffix(key, "?")), ""
+}
+
+// value returns the value of a key of the mapping, nil if it has no such key
+func (m mapping) value(key string) interface{} {
+	for _, item := range m {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+// setDeclarations sets the declarations of a kind in the root mapping of
+// an API definition. A new key is added before the keys which are written
+// after it: the next kinds, the security schemes, the annotations and the resources.
+func setDeclarations(m mapping, kind string, decls mapping) mapping {
+	next := map[string]bool{"securedBy": true}
+	found := false
+	for _, k := range bundleKinds {
+		next[k] = found
+		found = found || k == kind
+	}
+
+	for i, item := range m {
+		key, _ := item.Key.(string)
+		if key == kind {
+			m[i].Value = decls
+			return m
+		}
+		if next[key] || isAnnotationKey(key) || strings.HasPrefix(key, "/") {
+			m = append(m[:i], append(mapping{{Key: kind, Value: decls}}, m[i:]...)...)
+			return m
+		}
+	}
+	return append(m, mapping{{Key: kind, Value: decls}}...)
+}
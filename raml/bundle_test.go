@@ -0,0 +1,121 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBundle(t *testing.T) {
+	Convey("RAML bundler", t, func() {
+		apiDef := new(APIDefinition)
+
+		// parse the bundled document
+		parseBundle := func(bundle []byte) *APIDefinition {
+			parsed := new(APIDefinition)
+			loader := MemFileLoader(map[string][]byte{"api.raml": bundle})
+			err := NewParser(ParserOptions{FileLoader: loader}).ParseFile("api.raml", parsed)
+			So(err, ShouldBeNil)
+			So(Validate(parsed), ShouldBeEmpty)
+			So(parsed.Uses, ShouldBeEmpty)
+			So(parsed.Libraries, ShouldBeEmpty)
+			return parsed
+		}
+
+		Convey("nested libraries", func() {
+			err := ParseFile("./samples/nested_libraries/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			bundle, err := apiDef.Bundle()
+			So(err, ShouldBeNil)
+			content := string(bundle)
+			So(content, ShouldStartWith, "#%RAML 1.0\ntitle: Nested libraries\n")
+			So(content, ShouldNotContainSubstring, "uses:")
+			So(content, ShouldNotContainSubstring, "lib.")
+
+			// the declarations of the libraries keep their name
+			parsed := parseBundle(bundle)
+			So(parsed.Types, ShouldContainKey, "Author")
+			So(parsed.Types["Books"].Type, ShouldEqual, "Book[]")
+			So(parsed.Types["Book"].Properties["author"], ShouldEqual, "Author")
+			So(parsed.Traits, ShouldContainKey, "paged")
+			So(parsed.ResourceTypes["collection"].Type.Name, ShouldEqual, "base")
+
+			books := parsed.Resources["/books"]
+			So(books.Type.Name, ShouldEqual, "collection")
+			So(books.SecuredBy[0].Name, ShouldEqual, "oauth")
+			So(books.Get.Is[0].Name, ShouldEqual, "sorted")
+			So(books.Get.QueryParameters, ShouldContainKey, "page")
+			So(parsed.SecuritySchemes, ShouldContainKey, "oauth")
+		})
+
+		Convey("name collisions", func() {
+			err := ParseFile("./samples/bundle/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			bundle, err := apiDef.Bundle()
+			So(err, ShouldBeNil)
+			parsed := parseBundle(bundle)
+
+			// the declarations whose name is declared are prefixed by their library
+			So(parsed.Types["Book"].Properties, ShouldContainKey, "isbn")
+			So(parsed.Types["books_Book"].Properties, ShouldContainKey, "title")
+			So(parsed.Types["books_Book"].Properties["price"], ShouldEqual, "Price")
+			So(parsed.Types["Item"].Type, ShouldEqual, "string")
+			So(parsed.Types["shop_Item"].Properties, ShouldContainKey, "quantity")
+			So(parsed.Types["Order"].Properties["book"], ShouldEqual, "books_Book")
+			So(parsed.Types["Order"].Properties["items"], ShouldEqual, "shop_Item[]")
+
+			// a library used by two libraries is declared once
+			So(parsed.Types, ShouldContainKey, "Price")
+			So(parsed.Types, ShouldNotContainKey, "common_Price")
+
+			orders := parsed.Resources["/orders"]
+			So(orders.Annotations, ShouldContainKey, "(audited)")
+			So(orders.Post.Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Order | books_Book")
+			So(orders.Get.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Order[]")
+
+			// only the parameters used as types are renamed
+			labeled := parsed.ResourceTypes["collection"].Is[1]
+			So(labeled.Parameters["labelType"], ShouldEqual, "shop_Label")
+			So(labeled.Parameters["label"], ShouldEqual, "Item")
+			So(orders.Get.QueryParameters["label"].Description, ShouldEqual, "Item")
+		})
+
+		Convey("library samples", func() {
+			files := []string{
+				"./samples/simple_with_lib.raml",
+				"./samples/nested_libraries/api.raml",
+				"./samples/bundle/api.raml",
+				"../codegen/fixtures/libraries/api.raml",
+			}
+			for _, file := range files {
+				apiDef := new(APIDefinition)
+				So(ParseFile(file, apiDef), ShouldBeNil)
+				bundle, err := apiDef.Bundle()
+				So(err, ShouldBeNil)
+				parseBundle(bundle)
+			}
+
+			// the names applied by the library resource types and traits are renamed
+			So(ParseFile("./samples/simple_with_lib.raml", apiDef), ShouldBeNil)
+			bundle, err := apiDef.Bundle()
+			So(err, ShouldBeNil)
+			parsed := parseBundle(bundle)
+			file := parsed.Resources["/files/{name}"]
+			So(file.Get.Responses[201].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "File")
+			So(file.Get.Headers, ShouldContainKey, HTTPHeader("drm-key"))
+		})
+
+		Convey("API definition without libraries", func() {
+			err := ParseFile("./samples/resource_type_params.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			bundle, err := apiDef.Bundle()
+			So(err, ShouldBeNil)
+			So(string(bundle), ShouldEqual, string(docs.Files[docs.Root]))
+		})
+	})
+}
@@ -31,95 +31,188 @@ package raml
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
-	"github.com/gigforks/yaml"
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
 )
 
+// Position is a position in a RAML source file.
+// It is embedded in the RAML nodes to record where they are defined.
+// Line and Column are 1-based, zero means unknown.
+type Position struct {
+	File   string `yaml:"-"`
+	Line   int    `yaml:"-"`
+	Column int    `yaml:"-"`
+}
+
+// SetPosition implements yaml.Positioner interface
+func (p *Position) SetPosition(file string, line, column int) {
+	p.File = file
+	p.Line = line
+	p.Column = column
+}
+
+// String returns the position in the `file:line:col` format
+func (p Position) String() string {
+	var parts []string
+	if p.File != "" {
+		parts = append(parts, p.File)
+	}
+	if p.Line > 0 {
+		parts = append(parts, fmt.Sprint(p.Line))
+		if p.Column > 0 {
+			parts = append(parts, fmt.Sprint(p.Column))
+		}
+	}
+	return strings.Join(parts, ":")
+}
+
+// ErrorDetail is a single problem found in a RAML document.
+type ErrorDetail struct {
+	Position
+
+	Message string
+}
+
+func (ed ErrorDetail) String() string {
+	if pos := ed.Position.String(); pos != "" {
+		return pos + ": " + ed.Message
+	}
+	return ed.Message
+}
+
 // An Error is returned by the ParseFile function when RAML or YAML problems
 // are encountered when parsing the RAML document.
 type Error struct {
-	Errors []string
+	Errors []ErrorDetail
 }
 
 func (e *Error) Error() string {
+	var errs []string
+	for _, ed := range e.Errors {
+		errs = append(errs, ed.String())
+	}
 	return fmt.Sprintf("Error parsing RAML:\n  %s\n",
-		strings.Join(e.Errors, "\n  "))
+		strings.Join(errs, "\n  "))
+}
+
+// add a problem to the error
+func (e *Error) add(pos Position, format string, args ...interface{}) {
+	e.Errors = append(e.Errors, ErrorDetail{
+		Position: pos,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// sort the problems by their position
+func (e *Error) sort() {
+	sort.Stable(byPosition(e.Errors))
+}
+
+// byPosition sorts error details by their position
+type byPosition []ErrorDetail
+
+func (b byPosition) Len() int      { return len(b) }
+func (b byPosition) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b byPosition) Less(i, j int) bool {
+	if b[i].File != b[j].File {
+		return b[i].File < b[j].File
+	}
+	if b[i].Line != b[j].Line {
+		return b[i].Line < b[j].Line
+	}
+	if b[i].Column != b[j].Column {
+		return b[i].Column < b[j].Column
+	}
+	return b[i].Message < b[j].Message
+}
+
+// returns the error if it contains any problem, nil otherwise
+func (e *Error) orNil() error {
+	if len(e.Errors) == 0 {
+		return nil
+	}
+	return e
+}
+
+// newError creates a RAML error of a single problem
+func newError(pos Position, format string, args ...interface{}) *Error {
+	e := &Error{}
+	e.add(pos, format, args...)
+	return e
 }
 
-// Populate the RAML error value with converted YAML error strings (with
+// Populate the RAML error value with converted YAML errors (with
 // additional context)
 func populateRAMLError(ramlError *Error,
 	yamlErrors *yaml.TypeError) {
 
 	// Go over the errors
-	for _, currErr := range yamlErrors.Errors {
+	for i, currErr := range yamlErrors.Errors {
+		var detail *yaml.UnmarshalError
+		if i < len(yamlErrors.Details) {
+			detail = yamlErrors.Details[i]
+		}
+		if detail == nil {
+			ramlError.add(Position{}, "YAML error, %s", currErr)
+			continue
+		}
 
 		// Create the RAML errors
 		ramlError.Errors =
-			append(ramlError.Errors, convertYAMLError(currErr))
+			append(ramlError.Errors, convertYAMLError(detail))
 	}
 }
 
-// Convert a YAML error string into RAML error string, with more context
-func convertYAMLError(yamlError string) string {
-
-	if strings.Contains(yamlError, "cannot unmarshal") {
-
-		yamlErrorParts := strings.Split(yamlError, " ")
-
-		if len(yamlErrorParts) >= 7 {
-
-			fmt.Println(yamlError)
-
-			var ok bool
-			var source string
-			var target string
-			var targetName string
-			line := yamlErrorParts[1]
-			line = line[:len(line)-1]
-
-			// TODO: support more complex types:
-			// map[string]raml.NamedParameter -->
-			// detect map, format to:
-			//   "mapping of %s to %s", ramlTypeNames["string"], ramlTypeNames["raml.NamedParameter"]
-			// if "string" is not found, use the key, i.e. "string" in this case.
-			// so the output would be:
-			//   mapping of string to named parameter
-
-			// TODO: instead of having string in the key of some mappings,
-			// perhaps use a type alias:
-			//   type Name string
-			//   map[Name]NamedParameter
-			// would output:
-			//   mapping of name string to named parameter
-
-			if source, ok = yamlTypeToName[yamlErrorParts[4]]; !ok {
-				source = yamlErrorParts[4]
-			}
-			fmt.Println("source: ", source)
+// Convert a YAML error into RAML error, with more context
+func convertYAMLError(yamlError *yaml.UnmarshalError) ErrorDetail {
+	pos := Position{
+		File:   yamlError.File,
+		Line:   yamlError.Line,
+		Column: yamlError.Column,
+	}
 
-			if source == "string" {
-				source = fmt.Sprintf("string (got %s)", yamlErrorParts[5])
-				target = yamlErrorParts[7]
-			} else {
-				target = yamlErrorParts[6]
+	if yamlError.Type == nil {
+		return ErrorDetail{Position: pos, Message: fmt.Sprintf("YAML error, %s", yamlError.Msg)}
+	}
 
-			}
-			if targetName, ok = ramlTypeNames[target]; !ok {
-				targetName = target
-			}
+	// TODO: support more complex types:
+	// map[string]raml.NamedParameter -->
+	// detect map, format to:
+	//   "mapping of %s to %s", ramlTypeNames["string"], ramlTypeNames["raml.NamedParameter"]
+	// if "string" is not found, use the key, i.e. "string" in this case.
+	// so the output would be:
+	//   mapping of string to named parameter
 
-			target, _ = ramlTypes[target]
+	// TODO: instead of having string in the key of some mappings,
+	// perhaps use a type alias:
+	//   type Name string
+	//   map[Name]NamedParameter
+	// would output:
+	//   mapping of name string to named parameter
 
-			return fmt.Sprintf("line %s: %s cannot be of "+
-				"type %s, must be %s", line, targetName, source, target)
+	source, ok := yamlTypeToName[yamlError.Tag]
+	if !ok {
+		source = yamlError.Tag
+	}
+	if source == "string" {
+		source = fmt.Sprintf("string (got `%s`)", yamlError.Value)
+	}
 
-		}
+	target := yamlError.Type.String()
+	targetName, ok := ramlTypeNames[target]
+	if !ok {
+		targetName = target
+	}
+	if t, ok := ramlTypes[target]; ok {
+		target = t
 	}
 
-	// Otherwise
-	return fmt.Sprintf("YAML error, %s", yamlError)
+	return ErrorDetail{
+		Position: pos,
+		Message:  fmt.Sprintf("%s cannot be of type %s, must be %s", targetName, source, target),
+	}
 }
 
 var yamlTypeToName = map[string]string{
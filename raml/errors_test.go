@@ -0,0 +1,54 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestErrorPositions(t *testing.T) {
+	Convey("Error positions", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("type error in included file", func() {
+			err := ParseFile("./samples/positions/type_error.raml", apiDef)
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 1)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "samples/positions/name.raml", Line: 3, Column: 12})
+			So(ramlErr.Errors[0].String(), ShouldEqual,
+				"samples/positions/name.raml:3:12: numeric value cannot be of type string (got `abc`), must be integer")
+		})
+
+		Convey("syntax error", func() {
+			err := ParseFile("./samples/positions/syntax_error.raml", apiDef)
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 1)
+			So(ramlErr.Errors[0].File, ShouldEqual, "./samples/positions/syntax_error.raml")
+			So(ramlErr.Errors[0].Line, ShouldBeGreaterThan, 0)
+			So(ramlErr.Errors[0].Message, ShouldStartWith, "YAML syntax error")
+		})
+
+		Convey("validation error", func() {
+			err := ParseFile("./samples/positions/unknown_resource_type.raml", apiDef)
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/positions/unknown_resource_type.raml", Line: 4, Column: 3})
+			So(ramlErr.Errors[0].Message, ShouldContainSubstring, "can't find resource type named :collection")
+		})
+
+		Convey("parsed nodes position", func() {
+			err := ParseFile("./samples/include/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Types["User"].Position, ShouldResemble, Position{File: "samples/include/types/user.raml", Line: 2, Column: 1})
+			So(apiDef.Resources["/users"].Get.Line, ShouldEqual, 9)
+		})
+	})
+}
@@ -0,0 +1,420 @@
+package raml
+
+// This file contains the checker of the examples,
+// an example must be a valid instance of its resolved type.
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+var (
+	// keys of the expanded form of an example, e.g. `{value: 5, strict: false}`
+	exampleWrapperKeys = map[string]bool{
+		"value":       true,
+		"displayName": true,
+		"description": true,
+		"strict":      true,
+	}
+
+	// layouts of the date types
+	dateLayouts = map[string][]string{
+		"date-only":     {"2006-01-02"},
+		"time-only":     {"15:04:05", "15:04:05.999999999"},
+		"datetime-only": {"2006-01-02T15:04:05", "2006-01-02T15:04:05.999999999"},
+		"datetime":      {time.RFC3339, time.RFC3339Nano},
+	}
+)
+
+// exampleMismatch is a value of an example which is not valid against its type
+type exampleMismatch struct {
+	// Path of the value in the example, e.g. `.address.city` or `.tags[1]`,
+	// empty for the example itself
+	Path string
+
+	Message string
+}
+
+// examplePositions holds the positions of the example and of the named examples
+// of a declaration, the mismatches of an example are reported at its position.
+type examplePositions struct {
+	Example  examplePosition            `yaml:"example"`
+	Examples map[string]examplePosition `yaml:"examples"`
+}
+
+// examplePosition is the position of the node of an example
+type examplePosition struct {
+	Position
+}
+
+// UnmarshalYAML keeps the position of the example node, which is set by the decoder,
+// the value of the example is unmarshaled by its declaration.
+func (p *examplePosition) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	return nil
+}
+
+// unmarshalExamplePositions returns the positions of the examples of a declaration
+func unmarshalExamplePositions(unmarshal func(interface{}) error) examplePositions {
+	var ep examplePositions
+	if err := unmarshal(&ep); err != nil {
+		return examplePositions{}
+	}
+	return ep
+}
+
+// position returns the position of the named example, or of the example
+// if name is empty, pos if its position is unknown
+func (ep examplePositions) position(name string, pos Position) Position {
+	if name == "" {
+		return orPosition(ep.Example.Position, pos)
+	}
+	return orPosition(ep.Examples[name].Position, pos)
+}
+
+// unwrapExample returns the value of an example declared in the expanded form,
+// e.g. `{value: 5, strict: false}`, and whether it must be checked against its type.
+func unwrapExample(example interface{}) (interface{}, bool) {
+	m, ok := expandedExample(example)
+	if !ok {
+		return example, true
+	}
+	strict, ok := m["strict"].(bool)
+	return m["value"], !ok || strict
+}
+
+// expandedExample returns the map of an example declared in the expanded form,
+// it has a value and only the keys of the expanded form or annotations.
+func expandedExample(example interface{}) (map[interface{}]interface{}, bool) {
+	m, ok := example.(map[interface{}]interface{})
+	if !ok {
+		return nil, false
+	}
+	if _, ok := m["value"]; !ok {
+		return nil, false
+	}
+	for k := range m {
+		key := fmt.Sprintf("%v", k)
+		if !exampleWrapperKeys[key] && !isAnnotationKey(key) {
+			return nil, false
+		}
+	}
+	return m, true
+}
+
+// decodeExample decodes an example of an object or an array
+// which is given as a YAML or JSON document, e.g. an included JSON file.
+func decodeExample(val interface{}, rt *ResolvedType) (interface{}, error) {
+	s, ok := val.(string)
+	if !ok || (rt.Kind != KindObject && rt.Kind != KindArray) {
+		return val, nil
+	}
+	var decoded interface{}
+	if err := yaml.Unmarshal([]byte(s), &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// checkInstance checks that a value is a valid instance of a type
+// and returns all values which are not valid.
+func checkInstance(val interface{}, rt *ResolvedType, path string) []exampleMismatch {
+	mismatch := func(format string, args ...interface{}) []exampleMismatch {
+		return []exampleMismatch{{Path: path, Message: fmt.Sprintf(format, args...)}}
+	}
+
+	if len(rt.Enum) > 0 && (val != nil || !rt.IsNullable()) {
+		if err := checkEnum(val, rt.Enum); err != nil {
+			return mismatch("%v", err)
+		}
+	}
+
+	switch rt.Kind {
+	case KindAny:
+		return nil
+	case KindNil:
+		if val != nil {
+			return mismatch("%v is not nil", val)
+		}
+		return nil
+	case KindUnion:
+		if nonNil, ok := rt.NonNil(); ok {
+			// a value of a nullable type is either null or a value
+			// of its non nil type, e.g. `string` for `string?`
+			if val == nil {
+				return nil
+			}
+			if mismatches := checkInstance(val, nonNil, path); len(mismatches) > 0 {
+				return mismatches
+			}
+		} else if !matchesMember(val, rt, path) {
+			return mismatch("%v doesn't match any member of %v", val, rt)
+		}
+		// the facets of the union apply to the value of its member
+		if err := checkScalarFacets(val, rt); err != nil {
+			return mismatch("%v", err)
+		}
+		return nil
+	case KindArray:
+		return checkArrayInstance(val, rt, path)
+	case KindObject:
+		return checkObjectInstance(val, rt, path)
+	}
+
+	if err := checkScalar(val, rt.Builtin); err != nil {
+		return mismatch("%v", err)
+	}
+	if err := checkScalarFacets(val, rt); err != nil {
+		return mismatch("%v", err)
+	}
+	return nil
+}
+
+// matchesMember returns true if a value is a valid instance of a member of an union
+func matchesMember(val interface{}, rt *ResolvedType, path string) bool {
+	for _, member := range rt.Members {
+		if len(checkInstance(val, member, path)) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// checkArrayInstance checks the items and the facets of an array value
+func checkArrayInstance(val interface{}, rt *ResolvedType, path string) []exampleMismatch {
+	arr, ok := val.([]interface{})
+	if !ok {
+		return []exampleMismatch{{Path: path, Message: fmt.Sprintf("%v must be an array", val)}}
+	}
+
+	var mismatches []exampleMismatch
+	f := rt.Facets
+	if f.MinItems != nil && len(arr) < *f.MinItems {
+		mismatches = append(mismatches, exampleMismatch{Path: path, Message: fmt.Sprintf("must have at least %v items", *f.MinItems)})
+	}
+	if f.MaxItems != nil && len(arr) > *f.MaxItems {
+		mismatches = append(mismatches, exampleMismatch{Path: path, Message: fmt.Sprintf("must have at most %v items", *f.MaxItems)})
+	}
+	if f.UniqueItems != nil && *f.UniqueItems {
+		seen := map[string]bool{}
+		for _, item := range arr {
+			key := fmt.Sprintf("%v", item)
+			if seen[key] {
+				mismatches = append(mismatches, exampleMismatch{Path: path, Message: fmt.Sprintf("duplicate item %v", item)})
+				break
+			}
+			seen[key] = true
+		}
+	}
+
+	if rt.Items == nil {
+		return mismatches
+	}
+	for i, item := range arr {
+		mismatches = append(mismatches, checkInstance(item, rt.Items, fmt.Sprintf("%v[%v]", path, i))...)
+	}
+	return mismatches
+}
+
+// checkObjectInstance checks the properties and the facets of an object value
+func checkObjectInstance(val interface{}, rt *ResolvedType, path string) []exampleMismatch {
+	obj, ok := val.(map[interface{}]interface{})
+	if !ok {
+		return []exampleMismatch{{Path: path, Message: fmt.Sprintf("%v must be an object", val)}}
+	}
+
+	var mismatches []exampleMismatch
+	f := rt.Facets
+	if f.MinProperties != nil && len(obj) < *f.MinProperties {
+		mismatches = append(mismatches, exampleMismatch{Path: path, Message: fmt.Sprintf("must have at least %v properties", *f.MinProperties)})
+	}
+	if f.MaxProperties != nil && len(obj) > *f.MaxProperties {
+		mismatches = append(mismatches, exampleMismatch{Path: path, Message: fmt.Sprintf("must have at most %v properties", *f.MaxProperties)})
+	}
+
+	props := rt.AllProperties()
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prop := props[name]
+		if patternPropertyRegexp(name) != nil {
+			continue
+		}
+		v, ok := obj[name]
+		if !ok {
+			if prop.Required {
+				mismatches = append(mismatches, exampleMismatch{Path: path, Message: "missing required property " + name})
+			}
+			continue
+		}
+		mismatches = append(mismatches, checkInstance(v, prop.Type, path+"."+name)...)
+	}
+
+	// the other properties of the value must match a pattern property,
+	// unless the type allows additional properties
+	var keys []string
+	values := map[string]interface{}{}
+	for k, v := range obj {
+		key := fmt.Sprintf("%v", k)
+		if _, ok := props[key]; !ok {
+			keys = append(keys, key)
+			values[key] = v
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		matched := false
+		for _, name := range names {
+			if re := patternPropertyRegexp(name); re != nil && re.MatchString(key) {
+				mismatches = append(mismatches, checkInstance(values[key], props[name].Type, path+"."+key)...)
+				matched = true
+				break
+			}
+		}
+		if !matched && f.AdditionalProperties != nil && !*f.AdditionalProperties {
+			mismatches = append(mismatches, exampleMismatch{Path: path, Message: "unknown property " + key})
+		}
+	}
+	return mismatches
+}
+
+// patternPropertyRegexp returns the regular expression of a pattern property,
+// e.g. `/^note\d+$/`, nil if the property isn't a pattern property
+func patternPropertyRegexp(name string) *regexp.Regexp {
+	if len(name) < 2 || !strings.HasPrefix(name, "/") || !strings.HasSuffix(name, "/") {
+		return nil
+	}
+	pattern := name[1 : len(name)-1]
+	if pattern == "" { // `//` matches any property
+		pattern = ".*"
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil
+	}
+	return re
+}
+
+// checkScalarFacets checks a scalar value against the facets of its type
+func checkScalarFacets(val interface{}, rt *ResolvedType) error {
+	f := rt.Facets
+	switch v := val.(type) {
+	case string:
+		length := utf8.RuneCountInString(v)
+		if f.MinLength != nil && length < *f.MinLength {
+			return fmt.Errorf("%q is shorter than %v characters", v, *f.MinLength)
+		}
+		if f.MaxLength != nil && length > *f.MaxLength {
+			return fmt.Errorf("%q is longer than %v characters", v, *f.MaxLength)
+		}
+		if f.Pattern != nil {
+			// a pattern which is not a valid Go regular expression can't be checked
+			if re, err := regexp.Compile(*f.Pattern); err == nil && !re.MatchString(v) {
+				return fmt.Errorf("%q doesn't match pattern %v", v, *f.Pattern)
+			}
+		}
+		return checkDate(v, rt)
+	case int, int64, uint64, float64:
+		num, _ := toFloat64(v)
+		if f.Minimum != nil && num < *f.Minimum {
+			return fmt.Errorf("%v is less than minimum %v", v, *f.Minimum)
+		}
+		if f.Maximum != nil && num > *f.Maximum {
+			return fmt.Errorf("%v is greater than maximum %v", v, *f.Maximum)
+		}
+		if f.MultipleOf != nil && *f.MultipleOf != 0 {
+			if q := num / *f.MultipleOf; math.Abs(q-math.Floor(q+0.5)) > 1e-9 {
+				return fmt.Errorf("%v is not a multiple of %v", v, *f.MultipleOf)
+			}
+		}
+	}
+	return nil
+}
+
+// check a value against RAML scalar type
+func checkScalar(val interface{}, typ string) error {
+	ok := true
+	switch typ {
+	case "string", "date-only", "time-only", "datetime-only", "datetime", "date", "file":
+		_, ok = val.(string)
+	case "number":
+		switch val.(type) {
+		case int, int64, uint64, float64:
+		default:
+			ok = false
+		}
+	case "integer":
+		switch val.(type) {
+		case int, int64, uint64:
+		default:
+			ok = false
+		}
+	case "boolean":
+		_, ok = val.(bool)
+	case "nil":
+		ok = val == nil
+	case "any":
+	default:
+		return fmt.Errorf("unknown type:%v", typ)
+	}
+	if !ok {
+		return fmt.Errorf("%v is not a valid %v", val, typ)
+	}
+	return nil
+}
+
+// check that a value is one of enum values
+func checkEnum(val, enum interface{}) error {
+	values, ok := enum.([]interface{})
+	if !ok {
+		values = []interface{}{enum}
+	}
+	for _, e := range values {
+		if fmt.Sprintf("%v", e) == fmt.Sprintf("%v", val) {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v is not one of %v", val, values)
+}
+
+// checkDate checks the format of a value of a date type
+func checkDate(val string, rt *ResolvedType) error {
+	layouts, ok := dateLayouts[rt.Builtin]
+	if !ok {
+		return nil
+	}
+	if rt.Builtin == "datetime" && rt.Facets.Format != nil && strings.ToLower(*rt.Facets.Format) == "rfc2616" {
+		layouts = []string{time.RFC1123}
+	}
+	for _, layout := range layouts {
+		if _, err := time.Parse(layout, val); err == nil {
+			return nil
+		}
+	}
+	return fmt.Errorf("%v is not a valid %v", val, rt.Builtin)
+}
+
+// toFloat64 converts a numeric value into float64
+func toFloat64(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
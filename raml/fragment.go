@@ -0,0 +1,454 @@
+package raml
+
+// This file contains the RAML fragments: documents which contain
+// a single declaration and start with a typed header, e.g. `#%RAML 1.0 Trait`.
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+const (
+	fragmentLibrary                   = "Library"
+	fragmentDataType                  = "DataType"
+	fragmentTrait                     = "Trait"
+	fragmentResourceType              = "ResourceType"
+	fragmentNamedExample              = "NamedExample"
+	fragmentDocumentationItem         = "DocumentationItem"
+	fragmentSecurityScheme            = "SecurityScheme"
+	fragmentAnnotationTypeDeclaration = "AnnotationTypeDeclaration"
+)
+
+var (
+	// kinds of the RAML documents which have a typed header
+	fragmentKinds = map[string]bool{
+		fragmentOverlay:                   true,
+		fragmentExtension:                 true,
+		fragmentLibrary:                   true,
+		fragmentDataType:                  true,
+		fragmentTrait:                     true,
+		fragmentResourceType:              true,
+		fragmentNamedExample:              true,
+		fragmentDocumentationItem:         true,
+		fragmentSecurityScheme:            true,
+		fragmentAnnotationTypeDeclaration: true,
+	}
+
+	// HTTP methods of a resource, resource type or security scheme
+	methodKeys = map[string]bool{
+		"get":     true,
+		"post":    true,
+		"put":     true,
+		"patch":   true,
+		"head":    true,
+		"delete":  true,
+		"options": true,
+	}
+)
+
+// Fragment is a RAML fragment: a document which contains a single
+// declaration, e.g. a file which starts with `#%RAML 1.0 DataType`.
+// The declaration is parsed into the field of its kind,
+// the other fields are nil.
+type Fragment struct {
+	// Kind of the fragment, it is the fragment header without `#%RAML 1.0`.
+	// e.g. `DataType`, `Trait`, `ResourceType`, `Library`, `NamedExample`,
+	// `DocumentationItem`, `SecurityScheme` or `AnnotationTypeDeclaration`.
+	Kind string
+
+	// Libraries used by the fragment, by their name
+	Uses      map[string]string
+	Libraries map[string]*Library
+
+	DataType          *Type
+	Trait             *Trait
+	ResourceType      *ResourceType
+	Library           *Library
+	NamedExample      map[string]interface{}
+	DocumentationItem *Documentation
+	SecurityScheme    *SecurityScheme
+	AnnotationType    *AnnotationType
+
+	Filename string
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+}
+
+// UnmarshalYAML unmarshals the declaration of a fragment
+// according to the fragment kind, which is set by the parser.
+func (f *Fragment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var uses struct {
+		Uses map[string]string `yaml:"uses"`
+	}
+	if err := unmarshal(&uses); err != nil {
+		return err
+	}
+	f.Uses = uses.Uses
+
+	var decl interface{}
+	switch f.Kind {
+	case fragmentDataType:
+		f.DataType = &Type{}
+		decl = f.DataType
+	case fragmentTrait:
+		f.Trait = &Trait{}
+		decl = f.Trait
+	case fragmentResourceType:
+		f.ResourceType = &ResourceType{}
+		decl = f.ResourceType
+	case fragmentLibrary:
+		f.Library = &Library{}
+		decl = f.Library
+	case fragmentNamedExample:
+		decl = &f.NamedExample
+	case fragmentDocumentationItem:
+		f.DocumentationItem = &Documentation{}
+		decl = f.DocumentationItem
+	case fragmentSecurityScheme:
+		f.SecurityScheme = &SecurityScheme{}
+		decl = f.SecurityScheme
+	case fragmentAnnotationTypeDeclaration:
+		f.AnnotationType = &AnnotationType{}
+		decl = f.AnnotationType
+	default:
+		return fmt.Errorf("unsupported RAML fragment %v", f.Kind)
+	}
+	if err := unmarshal(decl); err != nil {
+		return err
+	}
+
+	// `uses` is not an example
+	delete(f.NamedExample, "uses")
+	return nil
+}
+
+// PostProcess doing additional processing
+// that couldn't be done by yaml parser such as :
+// - setting some additional values not exist in the .raml
+// - allocate map fields
+func (f *Fragment) PostProcess(fileName string) error {
+	f.Filename = fileName
+
+	// libraries are loaded by the parser
+	if f.Libraries == nil {
+		f.Libraries = map[string]*Library{}
+	}
+
+	switch {
+	case f.Trait != nil:
+		f.Trait.postProcess("")
+	case f.ResourceType != nil:
+		return f.ResourceType.postProcess("", nil)
+	case f.Library != nil:
+		f.Library.Uses = f.Uses
+		f.Library.Libraries = f.Libraries
+		return f.Library.PostProcess(fileName)
+	}
+	return nil
+}
+
+// checkRootKind checks that a document of the given kind
+// can be parsed into the given root
+func checkRootKind(root Root, kind, filePath string) error {
+	switch r := root.(type) {
+	case *APIDefinition:
+		if kind != "" && kind != fragmentOverlay && kind != fragmentExtension {
+			return fmt.Errorf("%v is a RAML %v, not an API definition", filePath, kind)
+		}
+	case *Library:
+		if kind != fragmentLibrary {
+			return fmt.Errorf("%v is not a RAML Library, it must start with #%%RAML 1.0 Library", filePath)
+		}
+	case *Fragment:
+		if kind == "" || kind == fragmentOverlay || kind == fragmentExtension {
+			return fmt.Errorf("%v is not a RAML fragment", filePath)
+		}
+		r.Kind = kind
+	}
+	return nil
+}
+
+// includeLocation returns the location of the root node of a document
+// of the given kind, see childLocation
+func includeLocation(kind string) string {
+	switch kind {
+	case "", fragmentOverlay, fragmentExtension, fragmentLibrary:
+		return locationRoot
+	}
+	return kind
+}
+
+// locations of the nodes of a RAML document which don't hold a fragment
+// but contain nodes which hold a fragment
+const (
+	locationRoot            = "root"            // API definition, library, overlay or extension
+	locationTypes           = "types"           // type declarations, properties, parameters and headers
+	locationTraits          = "traits"          // trait declarations
+	locationResourceTypes   = "resourceTypes"   // resource type declarations
+	locationSecuritySchemes = "securitySchemes" // security scheme declarations
+	locationAnnotationTypes = "annotationTypes" // annotation type declarations
+	locationDocumentation   = "documentation"   // documentation items
+	locationResource        = "resource"
+	locationMethod          = "method"
+	locationResponses       = "responses"
+	locationResponse        = "response"
+	locationBody            = "body"
+)
+
+// childLocation returns the location of the value of a mapping key,
+// given the location of the mapping.
+// The location of a node which holds a fragment is the fragment kind.
+// It returns empty string for an unknown location.
+func childLocation(location, key string) string {
+	switch location {
+	case locationRoot:
+		switch key {
+		case "types", "schemas":
+			return locationTypes
+		case "traits":
+			return locationTraits
+		case "resourceTypes":
+			return locationResourceTypes
+		case "securitySchemes":
+			return locationSecuritySchemes
+		case "annotationTypes":
+			return locationAnnotationTypes
+		case "documentation":
+			return locationDocumentation
+		}
+		if strings.HasPrefix(key, "/") {
+			return locationResource
+		}
+	case locationTypes:
+		return fragmentDataType
+	case locationTraits:
+		return fragmentTrait
+	case locationResourceTypes:
+		return fragmentResourceType
+	case locationSecuritySchemes:
+		return fragmentSecurityScheme
+	case locationAnnotationTypes:
+		return fragmentAnnotationTypeDeclaration
+	case fragmentDataType, fragmentAnnotationTypeDeclaration:
+		switch key {
+		case "type", "schema", "items":
+			return fragmentDataType
+		case "properties", "facets":
+			return locationTypes
+		case "examples":
+			return fragmentNamedExample
+		}
+	case locationResource, fragmentResourceType:
+		switch {
+		case strings.HasPrefix(key, "/"):
+			return locationResource
+		case methodKeys[strings.TrimSuffix(key, "?")]:
+			return locationMethod
+		case key == "uriParameters":
+			return locationTypes
+		}
+	case locationMethod, fragmentTrait:
+		switch key {
+		case "body":
+			return locationBody
+		case "responses":
+			return locationResponses
+		case "queryParameters", "headers":
+			return locationTypes
+		case "queryString":
+			return fragmentDataType
+		}
+	case fragmentSecurityScheme:
+		if key == "describedBy" {
+			return locationMethod
+		}
+	case locationResponses:
+		return locationResponse
+	case locationResponse:
+		switch key {
+		case "body":
+			return locationBody
+		case "headers":
+			return locationTypes
+		}
+	case locationBody:
+		if strings.Contains(key, "/") { // media type
+			return fragmentDataType
+		}
+		return childLocation(fragmentDataType, key)
+	}
+	return ""
+}
+
+// itemLocation returns the location of the items of a sequence,
+// given the location of the sequence.
+func itemLocation(location string) string {
+	if location == locationDocumentation {
+		return fragmentDocumentationItem
+	}
+	return ""
+}
+
+// checkIncludedFragment checks that a fragment of the given kind
+// can be included in the given location
+func checkIncludedFragment(kind, location string) error {
+	switch {
+	case kind == "":
+		return nil
+	case kind == fragmentLibrary, kind == fragmentOverlay, kind == fragmentExtension:
+		return fmt.Errorf("a RAML %v can't be included", kind)
+	case location == "" || location == kind:
+		return nil
+	case fragmentKinds[location]:
+		return fmt.Errorf("a %v fragment can't be included where a %v is expected", kind, location)
+	}
+	return fmt.Errorf("a %v fragment can't be included in %v", kind, location)
+}
+
+// fragmentUses are the libraries used by the fragments included in a document.
+// They are added to the libraries of the document.
+// The library names of a fragment are scoped to the fragment: a library whose
+// name is used for another library is added with a new name, e.g. `common_2`,
+// and the references of the fragment are renamed.
+type fragmentUses struct {
+	baseDir string // directory to which the libraries path are relative
+
+	paths     map[string]string // path of the libraries by their name
+	names     map[string]string // name of the libraries by their cleaned path
+	added     []string          // names of the libraries added to the document
+	fragments map[string]bool   // files of the included fragments
+}
+
+// newFragmentUses creates the libraries used by the fragments included in a document,
+// uses is the `uses` node of the document, if any
+func newFragmentUses(baseDir string, uses *yaml.Node) *fragmentUses {
+	fu := &fragmentUses{
+		baseDir:   baseDir,
+		paths:     map[string]string{},
+		names:     map[string]string{},
+		fragments: map[string]bool{},
+	}
+	if uses != nil && uses.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(uses.Children); i += 2 {
+			name, path := uses.Children[i].Value, strings.TrimSpace(uses.Children[i+1].Value)
+			fu.paths[name] = path
+			if _, ok := fu.names[filepath.Clean(path)]; !ok {
+				fu.names[filepath.Clean(path)] = name
+			}
+		}
+	}
+	return fu
+}
+
+// take removes the `uses` node of an included fragment
+// and adds its libraries to the libraries of the document.
+// It returns the new names of the libraries which are renamed, by their name.
+func (fu *fragmentUses) take(fragment *yaml.Node, filePath string) (map[string]string, error) {
+	fu.fragments[filePath] = true
+	if fragment.Kind != yaml.MappingNode {
+		return nil, nil
+	}
+	for i := 0; i+1 < len(fragment.Children); i += 2 {
+		if fragment.Children[i].Value != "uses" {
+			continue
+		}
+		uses := fragment.Children[i+1]
+		fragment.Children = append(fragment.Children[:i:i], fragment.Children[i+2:]...)
+
+		if uses.Kind != yaml.MappingNode {
+			return nil, newError(nodePosition(uses), "uses must be a mapping of library names to paths")
+		}
+		renamed := map[string]string{}
+		for j := 0; j+1 < len(uses.Children); j += 2 {
+			name, path := uses.Children[j].Value, strings.TrimSpace(uses.Children[j+1].Value)
+
+			// the path is relative to the fragment
+			if !filepath.IsAbs(path) {
+				rel, err := filepath.Rel(fu.baseDir, filepath.Join(filepath.Dir(filePath), path))
+				if err != nil {
+					return nil, newError(nodePosition(uses.Children[j+1]), "library %v: %v", name, err)
+				}
+				path = rel
+			}
+			if added := fu.add(name, path); added != name {
+				renamed[name] = added
+			}
+		}
+		return renamed, nil
+	}
+	return nil, nil
+}
+
+// add adds a library used by a fragment and returns its name in the document:
+// the name of the library if the document doesn't use it for another library
+func (fu *fragmentUses) add(name, path string) string {
+	if added, ok := fu.names[filepath.Clean(path)]; ok {
+		return added
+	}
+	added := name
+	for i := 2; fu.paths[added] != ""; i++ {
+		added = fmt.Sprintf("%v_%d", name, i)
+	}
+	fu.paths[added] = path
+	fu.names[filepath.Clean(path)] = added
+	fu.added = append(fu.added, added)
+	return added
+}
+
+// rename renames the references to the renamed libraries of an included fragment,
+// n is a node of the fragment at a location of the document.
+// The fragments it includes have their own libraries, they aren't renamed.
+func (fu *fragmentUses) rename(n *yaml.Node, filePath, location string, renamed map[string]string) {
+	if len(renamed) == 0 {
+		return
+	}
+	renameNodeRefs(n, location, func(kind, ref string) string {
+		if i := strings.Index(ref, "."); i > 0 {
+			if name, ok := renamed[ref[:i]]; ok {
+				return name + ref[i:]
+			}
+		}
+		return ref
+	}, func(n *yaml.Node) bool {
+		return n.File != filePath && fu.fragments[n.File]
+	})
+}
+
+// addTo adds the libraries of the included fragments to the `uses` node of a document.
+func (fu *fragmentUses) addTo(doc *yaml.Node, filePath string) {
+	if len(fu.added) == 0 {
+		return
+	}
+	if doc == nil || len(doc.Children) == 0 || doc.Children[0].Kind != yaml.MappingNode {
+		return
+	}
+	root := doc.Children[0]
+
+	uses := mappingValue(root, "uses")
+	if uses == nil {
+		uses = &yaml.Node{Kind: yaml.MappingNode, File: filePath, Line: root.Line, Column: root.Column}
+		root.Children = append(root.Children, &yaml.Node{
+			Kind:   yaml.ScalarNode,
+			File:   filePath,
+			Line:   root.Line,
+			Column: root.Column,
+			Value:  "uses",
+		}, uses)
+	}
+	if uses.Kind != yaml.MappingNode {
+		return
+	}
+
+	names := append([]string(nil), fu.added...)
+	sort.Strings(names)
+	for _, name := range names {
+		uses.Children = append(uses.Children,
+			&yaml.Node{Kind: yaml.ScalarNode, File: filePath, Line: uses.Line, Column: uses.Column, Value: name},
+			&yaml.Node{Kind: yaml.ScalarNode, File: filePath, Line: uses.Line, Column: uses.Column, Value: fu.paths[name]})
+	}
+}
@@ -0,0 +1,118 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestFragment(t *testing.T) {
+	Convey("Fragments", t, func() {
+		Convey("included fragments", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/fragments/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Documentation[0].Title, ShouldEqual, "Introduction")
+			So(apiDef.Traits["paged"].QueryParameters, ShouldContainKey, "page")
+			So(apiDef.ResourceTypes["collection"].Description, ShouldEqual, "a collection")
+			So(apiDef.SecuritySchemes["token"].Type, ShouldEqual, "Pass Through")
+			So(apiDef.AnnotationTypes["audited"].AllowedTargets, ShouldEqual, "Method")
+
+			body := apiDef.Resources["/persons"].Get.Responses[200].Bodies.ForMIMEType["application/json"]
+			So(body.Examples, ShouldContainKey, "alice")
+		})
+
+		Convey("libraries used by an included fragment", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/fragments/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Uses, ShouldResemble, map[string]string{"common": "libs/common.raml"})
+			So(apiDef.Libraries, ShouldContainKey, "common")
+
+			person, err := apiDef.TypeResolver().Resolve("Person")
+			So(err, ShouldBeNil)
+			So(person.Properties["address"].Type.Name, ShouldEqual, "common.Address")
+			So(person.Properties["tags"].Type.Items.Name, ShouldEqual, "string")
+		})
+
+		Convey("fragment parsed into its type", func() {
+			for kind, file := range map[string]string{
+				"DataType":                  "types/person.raml",
+				"Trait":                     "traits/paged.raml",
+				"ResourceType":              "resource-types/collection.raml",
+				"Library":                   "libs/common.raml",
+				"NamedExample":              "examples/persons.raml",
+				"DocumentationItem":         "docs/intro.raml",
+				"SecurityScheme":            "security/token.raml",
+				"AnnotationTypeDeclaration": "annotations/audited.raml",
+			} {
+				fragment := new(Fragment)
+				err := ParseFile("./samples/fragments/"+file, fragment)
+				So(err, ShouldBeNil)
+				So(fragment.Kind, ShouldEqual, kind)
+			}
+
+			fragment := new(Fragment)
+			So(ParseFile("./samples/fragments/types/person.raml", fragment), ShouldBeNil)
+			So(fragment.DataType.Type, ShouldEqual, "object")
+			So(fragment.DataType.Properties, ShouldContainKey, "address")
+			So(fragment.Uses, ShouldResemble, map[string]string{"common": "../libs/common.raml"})
+			So(fragment.Libraries["common"].Types, ShouldContainKey, "Address")
+
+			fragment = new(Fragment)
+			So(ParseFile("./samples/fragments/traits/paged.raml", fragment), ShouldBeNil)
+			So(fragment.Trait.Usage, ShouldEqual, "apply it to a collection")
+
+			fragment = new(Fragment)
+			So(ParseFile("./samples/fragments/examples/persons.raml", fragment), ShouldBeNil)
+			So(fragment.NamedExample, ShouldContainKey, "alice")
+
+			fragment = new(Fragment)
+			So(ParseFile("./samples/fragments/libs/common.raml", fragment), ShouldBeNil)
+			So(fragment.Library.Types, ShouldContainKey, "Address")
+		})
+
+		Convey("fragment kind must match the parsed document", func() {
+			err := ParseFile("./samples/fragments/traits/paged.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "is a RAML Trait, not an API definition")
+
+			err = ParseFile("./samples/fragments/traits/paged.raml", new(Library))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "is not a RAML Library")
+
+			err = ParseFile("./samples/fragments/api.raml", new(Fragment))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "is not a RAML fragment")
+
+			err = ParseFile("./samples/fragments/unknown.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "unknown RAML fragment Snippet")
+		})
+
+		Convey("fragment kind must match the include location", func() {
+			err := ParseFile("./samples/fragments/misplaced.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "misplaced.raml:4")
+			So(err.Error(), ShouldContainSubstring, "a Trait fragment can't be included where a DataType is expected")
+
+			err = ParseFile("./samples/fragments/included-library.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "a RAML Library can't be included")
+		})
+
+		Convey("library names are scoped to their fragment", func() {
+			apiDef := new(APIDefinition)
+			So(ParseFile("./samples/fragments/conflict.raml", apiDef), ShouldBeNil)
+			So(apiDef.Uses, ShouldResemble, map[string]string{
+				"common":   "libs/other.raml",
+				"common_2": "libs/common.raml",
+			})
+			So(apiDef.Types["Nickname"].Type, ShouldEqual, "common.Other")
+			So(apiDef.Types["Person"].Properties["address"], ShouldEqual, "common_2.Address")
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+	})
+}
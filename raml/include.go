@@ -0,0 +1,185 @@
+package raml
+
+// This file contains the `!include` resolver.
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+const (
+	includeTag = "!include"
+)
+
+var (
+	// extensions of files which are included as YAML nodes.
+	// Content of other files are included as string.
+	yamlFileExts = map[string]bool{
+		".raml": true,
+		".yaml": true,
+		".yml":  true,
+	}
+)
+
+// resolveIncludes replaces all `!include` nodes of a YAML node tree
+// with the content of the included files:
+// - RAML & YAML files are included as YAML nodes
+// - other text files (json, xsd, md, txt, ...) are included as string
+// - binary files are rejected
+// Included file path is resolved by the include resolver of the parser.
+// includedBy is the chain of files which include the current tree,
+// the last one is the file which contains the tree.
+// location is the location of the tree in the document, see childLocation,
+// a fragment can only be included where its kind is expected.
+// The libraries used by the included fragments are added to uses.
+func (p *Parser) resolveIncludes(n *yaml.Node, includedBy []string, location string, uses *fragmentUses) error {
+	if n == nil {
+		return nil
+	}
+
+	if n.Tag != includeTag {
+		for i, c := range n.Children {
+			childLoc := ""
+			switch n.Kind {
+			case yaml.MappingNode:
+				if i%2 == 1 {
+					childLoc = childLocation(location, n.Children[i-1].Value)
+				}
+			case yaml.SequenceNode:
+				childLoc = itemLocation(location)
+			case yaml.DocumentNode:
+				childLoc = location
+			}
+			if err := p.resolveIncludes(c, includedBy, childLoc, uses); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if n.Kind != yaml.ScalarNode {
+		return newError(nodePosition(n), "%v value must be a file path", includeTag)
+	}
+
+	includedFile := strings.TrimSpace(n.Value)
+	included, err := p.includeFile(includedFile, includedBy, location, uses)
+	if err != nil {
+		if ramlErr, ok := err.(*Error); ok { // error inside the included file
+			return ramlErr
+		}
+		return newError(nodePosition(n), "Error including file %s: %s", includedFile, err.Error())
+	}
+	*n = *included
+	return nil
+}
+
+// includeFile reads an included file and returns it as a YAML node
+func (p *Parser) includeFile(includedFile string, includedBy []string, location string, uses *fragmentUses) (*yaml.Node, error) {
+	filePath, err := p.includeResolver(includedBy[len(includedBy)-1], includedFile)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, f := range includedBy {
+		if f == filePath {
+			return nil, fmt.Errorf("cyclic include of %v", filePath)
+		}
+	}
+
+	contents, err := p.readFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if !yamlFileExts[strings.ToLower(filepath.Ext(includedFile))] {
+		if isBinary(contents) {
+			return nil, fmt.Errorf("can't include binary file %v", filePath)
+		}
+		if strings.ToLower(filepath.Ext(includedFile)) == ".json" {
+			if contents, err = p.bundleJSONSchema(filePath, contents); err != nil {
+				return nil, err
+			}
+		}
+		return &yaml.Node{
+			Kind:   yaml.ScalarNode,
+			File:   filePath,
+			Line:   1,
+			Column: 1,
+			Value:  string(contents),
+		}, nil
+	}
+
+	// a RAML fragment must be included where its kind is expected
+	kind := includedFragmentKind(contents)
+	if err := checkIncludedFragment(kind, location); err != nil {
+		return nil, err
+	}
+	if kind != "" {
+		location = kind
+	}
+
+	doc, err := yaml.Parse(contents)
+	if err != nil {
+		return nil, newParseError(filePath, err)
+	}
+	setNodeFile(doc, filePath)
+	if doc == nil || len(doc.Children) == 0 { // empty file
+		return &yaml.Node{Kind: yaml.ScalarNode, File: filePath, Line: 1, Column: 1, Implicit: true}, nil
+	}
+
+	root := doc.Children[0]
+	var renamed map[string]string
+	if kind != "" {
+		if renamed, err = uses.take(root, filePath); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.resolveIncludes(root, append(includedBy, filePath), location, uses); err != nil {
+		return nil, err
+	}
+	uses.rename(root, filePath, location, renamed)
+	return root, nil
+}
+
+// includedFragmentKind returns the kind of an included RAML fragment,
+// empty if the included file doesn't have a RAML 1.0 fragment header.
+func includedFragmentKind(contents []byte) string {
+	firstLine := string(contents)
+	if i := strings.IndexByte(firstLine, '\n'); i >= 0 {
+		firstLine = firstLine[:i]
+	}
+	if !strings.HasPrefix(firstLine, ramlVersionHeader) {
+		return ""
+	}
+	return fragmentKind(firstLine)
+}
+
+// setNodeFile sets the file name of all nodes in a tree
+func setNodeFile(n *yaml.Node, fileName string) {
+	if n == nil {
+		return
+	}
+	n.File = fileName
+	for _, c := range n.Children {
+		setNodeFile(c, fileName)
+	}
+}
+
+// nodePosition returns position of a YAML node
+func nodePosition(n *yaml.Node) Position {
+	return Position{
+		File:   n.File,
+		Line:   n.Line,
+		Column: n.Column,
+	}
+}
+
+// isBinary returns true if the given content is not a text
+func isBinary(contents []byte) bool {
+	return !utf8.Valid(contents) || bytes.IndexByte(contents, 0) >= 0
+}
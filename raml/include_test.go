@@ -0,0 +1,54 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestInclude(t *testing.T) {
+	Convey("Include", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("include fragments & text files", func() {
+			err := ParseFile("./samples/include/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// !include inside string is not an include
+			So(apiDef.Description, ShouldEqual, "Fragments are inserted using !include directive")
+
+			// includes in flow sequence & mapping
+			So(len(apiDef.Documentation), ShouldEqual, 2)
+			So(apiDef.Documentation[0].Title, ShouldEqual, "Introduction")
+			So(apiDef.Documentation[1].Content, ShouldEqual, "# Usage\n\nJust call it.\n")
+
+			// RAML fragment, with nested include relative to the fragment
+			user := apiDef.Types["User"]
+			So(user.Type, ShouldEqual, "object")
+			So(user.Properties, ShouldContainKey, "address")
+			address := user.Properties["address"].(map[interface{}]interface{})
+			So(address["properties"], ShouldResemble, map[interface{}]interface{}{"city": "string"})
+
+			// json file included as string
+			So(user.Example, ShouldStartWith, "{\n  \"name\": \"john\"")
+		})
+
+		Convey("binary file", func() {
+			err := ParseFile("./samples/include/binary.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "can't include binary file")
+		})
+
+		Convey("invalid JSON file", func() {
+			err := ParseFile("./samples/include/invalid_json.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "invalid JSON file")
+		})
+
+		Convey("cyclic include", func() {
+			err := ParseFile("./samples/include/cyclic.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "cyclic include")
+		})
+	})
+}
@@ -20,6 +20,7 @@ const (
 
 type node struct {
 	kind         int
+	file         string
 	line, column int
 	tag          string
 	value        string
@@ -77,15 +78,13 @@ func (p *parser) skip() {
 }
 
 func (p *parser) fail() {
-	var where string
-	var line int
+	var line, column int
 	if p.parser.problem_mark.line != 0 {
 		line = p.parser.problem_mark.line
+		column = p.parser.problem_mark.column + 1
 	} else if p.parser.context_mark.line != 0 {
 		line = p.parser.context_mark.line
-	}
-	if line != 0 {
-		where = "line " + strconv.Itoa(line) + ": "
+		column = p.parser.context_mark.column + 1
 	}
 	var msg string
 	if len(p.parser.problem) > 0 {
@@ -93,7 +92,7 @@ func (p *parser) fail() {
 	} else {
 		msg = "unknown problem parsing YAML content"
 	}
-	failf("%s%s", where, msg)
+	fail(&SyntaxError{Line: line, Column: column, Problem: msg})
 }
 
 func (p *parser) anchor(n *node, anchor []byte) {
@@ -120,7 +119,6 @@ func (p *parser) parse() *node {
 	default:
 		panic("attempted to parse unknown event: " + strconv.Itoa(int(p.event.typ)))
 	}
-	panic("unreachable")
 }
 
 func (p *parser) node(kind int) *node {
@@ -190,7 +188,7 @@ type decoder struct {
 	doc     *node
 	aliases map[string]bool
 	mapType reflect.Type
-	terrors []string
+	terrors []*UnmarshalError
 }
 
 var (
@@ -218,7 +216,15 @@ func (d *decoder) terror(n *node, tag string, out reflect.Value) {
 			value = " `" + value + "`"
 		}
 	}
-	d.terrors = append(d.terrors, fmt.Sprintf("line %d: cannot unmarshal %s%s into %s", n.line+1, shortTag(tag), value, out.Type()))
+	d.terrors = append(d.terrors, &UnmarshalError{
+		File:   n.file,
+		Line:   n.line + 1,
+		Column: n.column + 1,
+		Tag:    shortTag(tag),
+		Value:  n.value,
+		Type:   out.Type(),
+		Msg:    fmt.Sprintf("line %d: cannot unmarshal %s%s into %s", n.line+1, shortTag(tag), value, out.Type()),
+	})
 }
 
 func (d *decoder) callUnmarshaler(n *node, u Unmarshaler) (good bool) {
@@ -229,12 +235,23 @@ func (d *decoder) callUnmarshaler(n *node, u Unmarshaler) (good bool) {
 		if len(d.terrors) > terrlen {
 			issues := d.terrors[terrlen:]
 			d.terrors = d.terrors[:terrlen]
-			return &TypeError{issues}
+			return newTypeError(issues)
 		}
 		return nil
 	})
 	if e, ok := err.(*TypeError); ok {
-		d.terrors = append(d.terrors, e.Errors...)
+		for i, msg := range e.Errors {
+			if i < len(e.Details) && e.Details[i] != nil {
+				d.terrors = append(d.terrors, e.Details[i])
+				continue
+			}
+			d.terrors = append(d.terrors, &UnmarshalError{
+				File:   n.file,
+				Line:   n.line + 1,
+				Column: n.column + 1,
+				Msg:    msg,
+			})
+		}
 		return false
 	}
 	if err != nil {
@@ -282,6 +299,7 @@ func (d *decoder) unmarshal(n *node, out reflect.Value) (good bool) {
 		return d.alias(n, out)
 	}
 	out, unmarshaled, good := d.prepare(n, out)
+	d.setPosition(n, out)
 	if unmarshaled {
 		return good
 	}
@@ -298,6 +316,16 @@ func (d *decoder) unmarshal(n *node, out reflect.Value) (good bool) {
 	return good
 }
 
+// setPosition informs the out value about it's position in the document
+func (d *decoder) setPosition(n *node, out reflect.Value) {
+	if out.Kind() != reflect.Struct || !out.CanAddr() {
+		return
+	}
+	if p, ok := out.Addr().Interface().(Positioner); ok {
+		p.SetPosition(n.file, n.line+1, n.column+1)
+	}
+}
+
 func (d *decoder) document(n *node, out reflect.Value) (good bool) {
 	if len(n.children) == 1 {
 		d.doc = n
@@ -0,0 +1,126 @@
+package yaml
+
+import (
+	"reflect"
+)
+
+// NodeKind is the kind of a YAML node
+type NodeKind int
+
+// Kinds of YAML nodes
+const (
+	DocumentNode NodeKind = documentNode
+	MappingNode  NodeKind = mappingNode
+	SequenceNode NodeKind = sequenceNode
+	ScalarNode   NodeKind = scalarNode
+)
+
+// Node is a node of a parsed YAML document.
+// It can be inspected and modified before being decoded
+// into a Go value using UnmarshalNode.
+type Node struct {
+	Kind NodeKind
+
+	// Position of the node in the document, 1-based.
+	// File is the name of the file where the node is defined,
+	// it is not set by Parse.
+	File         string
+	Line, Column int
+
+	// The explicit tag of the node, e.g. "!include".
+	Tag string
+
+	// The value of a scalar node.
+	Value string
+
+	// Implicit is false for quoted scalars, which are always decoded as string.
+	Implicit bool
+
+	// The child nodes.
+	// Children of a mapping node are its keys and values in order: k1, v1, k2, v2, ...
+	Children []*Node
+}
+
+// Parse parses the first document found within the in byte slice
+// and returns it as a node tree.
+// Aliases are expanded and shares the anchored node.
+func Parse(in []byte) (n *Node, err error) {
+	defer handleErr(&err)
+	p := newParser(in)
+	defer p.destroy()
+	doc := p.parse()
+	if doc == nil {
+		return nil, nil
+	}
+	return exportNode(doc, doc.anchors, map[*node]*Node{}, map[string]bool{}), nil
+}
+
+// UnmarshalNode decodes a node tree and assigns decoded values into the out value,
+// the same way as Unmarshal does.
+func UnmarshalNode(n *Node, out interface{}) (err error) {
+	defer handleErr(&err)
+	d := newDecoder()
+	if n != nil {
+		v := reflect.ValueOf(out)
+		if v.Kind() == reflect.Ptr && !v.IsNil() {
+			v = v.Elem()
+		}
+		d.unmarshal(importNode(n), v)
+	}
+	if len(d.terrors) > 0 {
+		return newTypeError(d.terrors)
+	}
+	return nil
+}
+
+// convert internal node to the exported one
+func exportNode(n *node, anchors map[string]*node, exported map[*node]*Node, aliases map[string]bool) *Node {
+	if n.kind == aliasNode {
+		an, ok := anchors[n.value]
+		if !ok {
+			failf("unknown anchor '%s' referenced", n.value)
+		}
+		if aliases[n.value] {
+			failf("anchor '%s' value contains itself", n.value)
+		}
+		aliases[n.value] = true
+		defer delete(aliases, n.value)
+		return exportNode(an, anchors, exported, aliases)
+	}
+	if en, ok := exported[n]; ok {
+		return en
+	}
+	en := &Node{
+		Kind:     NodeKind(n.kind),
+		Line:     n.line + 1,
+		Column:   n.column + 1,
+		Tag:      n.tag,
+		Value:    n.value,
+		Implicit: n.implicit,
+	}
+	exported[n] = en
+	for _, c := range n.children {
+		en.Children = append(en.Children, exportNode(c, anchors, exported, aliases))
+	}
+	return en
+}
+
+// convert exported node to the internal one
+func importNode(en *Node) *node {
+	n := &node{
+		kind:     int(en.Kind),
+		file:     en.File,
+		line:     en.Line - 1,
+		column:   en.Column - 1,
+		tag:      en.Tag,
+		value:    en.Value,
+		implicit: en.Implicit,
+	}
+	if en.Kind == DocumentNode {
+		n.anchors = map[string]*node{}
+	}
+	for _, c := range en.Children {
+		n.children = append(n.children, importNode(c))
+	}
+	return n
+}
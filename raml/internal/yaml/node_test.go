@@ -0,0 +1,75 @@
+package yaml
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	n, err := Parse([]byte("title: api\nfiles: !include files.raml\nlist: [a, &b b, *b]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Kind != DocumentNode || len(n.Children) != 1 {
+		t.Fatalf("expected a document node, got %+v", n)
+	}
+
+	root := n.Children[0]
+	if root.Kind != MappingNode || len(root.Children) != 6 {
+		t.Fatalf("expected a mapping of 3 keys, got %+v", root)
+	}
+	if title := root.Children[1]; title.Value != "api" || title.Line != 1 || title.Column != 8 {
+		t.Errorf("expected title at 1:8, got %+v", title)
+	}
+	if include := root.Children[3]; include.Tag != "!include" || include.Value != "files.raml" {
+		t.Errorf("expected an !include, got %+v", include)
+	}
+
+	// the aliases are expanded
+	if list := root.Children[5]; list.Kind != SequenceNode || list.Children[2].Value != "b" {
+		t.Errorf("expected the alias to be expanded, got %+v", list)
+	}
+}
+
+func TestUnmarshalNode(t *testing.T) {
+	n, err := Parse([]byte("name: api\nsize: 3\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Children[0].Children[3].Value = "4"
+
+	var v struct {
+		Name string
+		Size int
+	}
+	if err := UnmarshalNode(n, &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Name != "api" || v.Size != 4 {
+		t.Errorf("unexpected value %+v", v)
+	}
+}
+
+func TestUnmarshalNodeError(t *testing.T) {
+	n, err := Parse([]byte("name: api\nsize: big\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Children[0].Children[3].File = "sizes.raml"
+
+	// the error is at the position of the node in its file
+	var v struct{ Size int }
+	typeErr, ok := UnmarshalNode(n, &v).(*TypeError)
+	if !ok || len(typeErr.Details) != 1 {
+		t.Fatalf("expected a type error, got %v", typeErr)
+	}
+	if d := typeErr.Details[0]; d.File != "sizes.raml" || d.Line != 2 || d.Value != "big" {
+		t.Errorf("unexpected error %+v", d)
+	}
+}
+
+func TestParseSyntaxError(t *testing.T) {
+	_, err := Parse([]byte("title: api\n  bad: [\n"))
+	if syntaxErr, ok := err.(*SyntaxError); !ok || syntaxErr.Line == 0 {
+		t.Errorf("expected a syntax error with its line, got %v", err)
+	}
+}
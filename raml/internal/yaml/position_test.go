@@ -0,0 +1,61 @@
+package yaml
+
+import (
+	"testing"
+)
+
+// positioned records the position given by the decoder
+type positioned struct {
+	Name         string
+	file         string
+	line, column int
+}
+
+func (p *positioned) SetPosition(file string, line, column int) {
+	p.file, p.line, p.column = file, line, column
+}
+
+func TestPositioner(t *testing.T) {
+	n, err := Parse([]byte("items:\n  - name: a\n  - name: b\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.Children[0].Children[1].Children[1].File = "items.raml"
+
+	var v struct{ Items []positioned }
+	if err := UnmarshalNode(n, &v); err != nil {
+		t.Fatal(err)
+	}
+	if p := v.Items[0]; p.Name != "a" || p.line != 2 || p.column != 5 {
+		t.Errorf("expected a at 2:5, got %+v", p)
+	}
+	if p := v.Items[1]; p.file != "items.raml" || p.line != 3 || p.column != 5 {
+		t.Errorf("expected b at items.raml:3:5, got %+v", p)
+	}
+}
+
+func TestUnmarshalErrorPosition(t *testing.T) {
+	var v struct{ Sizes []int }
+	typeErr, ok := Unmarshal([]byte("sizes: [1, big]\n"), &v).(*TypeError)
+	if !ok || len(typeErr.Details) != 1 || len(typeErr.Errors) != 1 {
+		t.Fatalf("expected a type error, got %v", typeErr)
+	}
+	if d := typeErr.Details[0]; d.Line != 1 || d.Column != 12 || d.Tag != "!!str" {
+		t.Errorf("expected the error of big at 1:12, got %+v", d)
+	}
+	if typeErr.Errors[0] != typeErr.Details[0].Error() {
+		t.Errorf("expected the message of the detail, got %v", typeErr.Errors[0])
+	}
+}
+
+func TestSyntaxErrorPosition(t *testing.T) {
+	var v interface{}
+	err := Unmarshal([]byte("a: b\nc: [d\n"), &v)
+	syntaxErr, ok := err.(*SyntaxError)
+	if !ok {
+		t.Fatalf("expected a syntax error, got %v", err)
+	}
+	if syntaxErr.Line == 0 || syntaxErr.Column == 0 {
+		t.Errorf("expected the position of the problem, got %+v", syntaxErr)
+	}
+}
@@ -4,6 +4,8 @@
 //
 //   https://github.com/go-yaml/yaml
 //
+// This is the copy of github.com/gigforks/yaml used by the RAML parser,
+// README.md lists its changes.
 package yaml
 
 import (
@@ -11,6 +13,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 )
@@ -91,7 +94,7 @@ func Unmarshal(in []byte, out interface{}) (err error) {
 		d.unmarshal(node, v)
 	}
 	if len(d.terrors) > 0 {
-		return &TypeError{d.terrors}
+		return newTypeError(d.terrors)
 	}
 	return nil
 }
@@ -174,6 +177,58 @@ func failf(format string, args ...interface{}) {
 // unmarshaled partially.
 type TypeError struct {
 	Errors []string
+
+	// Details of the errors, in the same order as Errors.
+	Details []*UnmarshalError
+}
+
+// UnmarshalError describes a YAML value which couldn't be decoded
+// into the requested type.
+type UnmarshalError struct {
+	// Position of the value, line and column are 1-based.
+	// File is only known when decoding a node tree created by Parse.
+	File         string
+	Line, Column int
+
+	Tag   string       // short tag of the YAML value, e.g. "!!str"
+	Value string       // the YAML value, truncated for long values
+	Type  reflect.Type // the type the value couldn't be decoded into
+
+	Msg string
+}
+
+func (e *UnmarshalError) Error() string {
+	return e.Msg
+}
+
+// SyntaxError is returned by Unmarshal and Parse when the input
+// is not a valid YAML document.
+type SyntaxError struct {
+	Line, Column int
+	Problem      string
+}
+
+func (e *SyntaxError) Error() string {
+	var where string
+	if e.Line != 0 {
+		where = "line " + strconv.Itoa(e.Line) + ": "
+	}
+	return "yaml: " + where + e.Problem
+}
+
+// Positioner is implemented by types which want to know
+// their position in the YAML document when being unmarshaled.
+type Positioner interface {
+	SetPosition(file string, line, column int)
+}
+
+// newTypeError creates TypeError from the unmarshal errors
+func newTypeError(details []*UnmarshalError) *TypeError {
+	e := &TypeError{Details: details}
+	for _, d := range details {
+		e.Errors = append(e.Errors, d.Msg)
+	}
+	return e
 }
 
 func (e *TypeError) Error() string {
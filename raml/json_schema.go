@@ -0,0 +1,555 @@
+package raml
+
+// This file contains the translation of JSON schemas (draft-04) into RAML types
+// and the bundling of the JSON schemas referenced by an included JSON schema.
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// RAML types of the JSON schema formats of a string
+	jsonSchemaFormats = map[string]string{
+		"date-time": "datetime",
+		"date":      "date-only",
+		"time":      "time-only",
+	}
+
+	// JSON schema validation keywords which are RAML facets
+	jsonSchemaFacets = []string{
+		"description", "enum",
+		"pattern", "minLength", "maxLength",
+		"minimum", "maximum", "multipleOf",
+		"minItems", "maxItems", "uniqueItems",
+		"minProperties", "maxProperties",
+	}
+)
+
+// jsonSchemaConverter translates a JSON schema into RAML types
+type jsonSchemaConverter struct {
+	root  map[string]interface{}
+	namer *schemaTypeNamer
+
+	// names of the types of the referenced schemas by their `$ref`
+	refs map[string]string
+
+	// translated types by their name
+	types map[string]Type
+}
+
+// jsonSchemaToTypes translates a JSON schema into the RAML type `name`
+// and the types of the schemas it defines.
+func jsonSchemaToTypes(name, schema string, namer *schemaTypeNamer) (map[string]Type, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal([]byte(schema), &root); err != nil {
+		return nil, fmt.Errorf("invalid JSON schema: %v", err)
+	}
+	c := &jsonSchemaConverter{
+		root:  root,
+		namer: namer,
+		refs:  map[string]string{"#": name},
+		types: map[string]Type{},
+	}
+	t, err := c.toType(root, "")
+	if err != nil {
+		return nil, err
+	}
+	c.types[name] = t
+	return c.types, nil
+}
+
+// toType translates a schema into a type declaration,
+// hint is the name of the schema relative to the root schema.
+func (c *jsonSchemaConverter) toType(node map[string]interface{}, hint string) (Type, error) {
+	if !isJSONObjectSchema(node) {
+		decl, err := c.decl(node, hint)
+		if err != nil {
+			return Type{}, err
+		}
+		t := toType(decl)
+		if d, ok := node["description"].(string); ok {
+			t.Description = d
+		}
+		return t, nil
+	}
+
+	facets := c.facets(node)
+	t := toType(facets)
+	t.Type = "object"
+	t.Properties = map[string]interface{}{}
+	if ap, ok := node["additionalProperties"].(bool); ok {
+		t.AdditionalProperties = &ap
+	}
+	if err := c.addProperties(&t, node, hint); err != nil {
+		return Type{}, err
+	}
+
+	// allOf: the referenced schemas are the parents,
+	// the properties of the inline schemas are merged
+	allOf, _ := node["allOf"].([]interface{})
+	var parents []interface{}
+	for _, m := range allOf {
+		member, ok := m.(map[string]interface{})
+		if !ok {
+			return Type{}, fmt.Errorf("invalid allOf member: %v", m)
+		}
+		if ref, ok := member["$ref"].(string); ok {
+			parent, err := c.ref(ref)
+			if err != nil {
+				return Type{}, err
+			}
+			parents = append(parents, parent)
+			continue
+		}
+		if err := c.addProperties(&t, member, hint); err != nil {
+			return Type{}, err
+		}
+	}
+	switch len(parents) {
+	case 0:
+	case 1:
+		t.Type = parents[0]
+	default:
+		t.Type = parents
+	}
+	return t, nil
+}
+
+// addProperties adds the properties of an object schema to a type
+func (c *jsonSchemaConverter) addProperties(t *Type, node map[string]interface{}, hint string) error {
+	required := map[string]bool{}
+	if req, ok := node["required"].([]interface{}); ok {
+		for _, name := range req {
+			required[fmt.Sprintf("%v", name)] = true
+		}
+	}
+
+	props, _ := node["properties"].(map[string]interface{})
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		prop, ok := props[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid schema of property %v", name)
+		}
+		decl, err := c.decl(prop, hint+" "+name)
+		if err != nil {
+			return fmt.Errorf("property %v: %v", name, err)
+		}
+
+		// draft-03 declares a required property in the property schema
+		if req, ok := prop["required"].(bool); ok && req {
+			required[name] = true
+		}
+		key := name
+		if !required[name] {
+			key += "?"
+		}
+		t.Properties[key] = decl
+	}
+	return nil
+}
+
+// decl translates a schema into a type expression or an inline type declaration.
+// An object schema is translated into a named type.
+func (c *jsonSchemaConverter) decl(node map[string]interface{}, hint string) (interface{}, error) {
+	if ref, ok := node["$ref"].(string); ok {
+		return c.ref(ref)
+	}
+	if isJSONObjectSchema(node) {
+		name := c.namer.name(hint)
+		t, err := c.toType(node, hint)
+		if err != nil {
+			return nil, err
+		}
+		c.types[name] = t
+		return c.objectUnionExpr(name, node, hint)
+	}
+
+	typ, err := c.typeExpr(node, hint)
+	if err != nil {
+		return nil, err
+	}
+	facets := c.facets(node)
+	if len(facets) == 0 {
+		return typ, nil
+	}
+	facets["type"] = typ
+	return facets, nil
+}
+
+// typeExpr returns the type expression of a non object schema
+func (c *jsonSchemaConverter) typeExpr(node map[string]interface{}, hint string) (string, error) {
+	var members []interface{}
+	switch typ := node["type"].(type) {
+	case []interface{}: // e.g. ["string", "null"]
+		for _, t := range typ {
+			members = append(members, map[string]interface{}{"type": t})
+		}
+	case string:
+		return c.builtinExpr(typ, node, hint)
+	}
+	if members == nil {
+		members, _ = node["oneOf"].([]interface{})
+	}
+	if members == nil {
+		members, _ = node["anyOf"].([]interface{})
+	}
+	if members != nil {
+		return c.unionExpr(members, hint)
+	}
+
+	// an enum without type
+	if enum, ok := node["enum"].([]interface{}); ok {
+		for _, v := range enum {
+			if _, ok := v.(string); !ok {
+				return "any", nil
+			}
+		}
+		return "string", nil
+	}
+	return "any", nil
+}
+
+// builtinExpr returns the type expression of a JSON schema type
+func (c *jsonSchemaConverter) builtinExpr(typ string, node map[string]interface{}, hint string) (string, error) {
+	switch typ {
+	case "string":
+		format, _ := node["format"].(string)
+		if t, ok := jsonSchemaFormats[format]; ok {
+			return t, nil
+		}
+		return "string", nil
+	case "integer", "number", "boolean":
+		return typ, nil
+	case "null":
+		return "nil", nil
+	case "object":
+		return "object", nil
+	case "array":
+		items, ok := node["items"].(map[string]interface{})
+		if !ok { // no items or tuple validation
+			return "array", nil
+		}
+		decl, err := c.decl(items, hint+" item")
+		if err != nil {
+			return "", err
+		}
+		expr, ok := decl.(string)
+		if !ok { // items with facets are only supported by an inline declaration
+			name := c.namer.name(hint + " item")
+			c.types[name] = toType(decl)
+			expr = name
+		}
+		if strings.Contains(expr, "|") {
+			expr = "(" + expr + ")"
+		}
+		return expr + "[]", nil
+	}
+	return "", fmt.Errorf("unknown type %v", typ)
+}
+
+// objectUnionExpr returns the type expression of an object schema which has
+// other types than `object`, e.g. `Person | nil` for `["object", "null"]`,
+// name is the name of the object type
+func (c *jsonSchemaConverter) objectUnionExpr(name string, node map[string]interface{}, hint string) (string, error) {
+	types, _ := node["type"].([]interface{})
+	members := []string{name}
+	for _, t := range types {
+		typ, ok := t.(string)
+		if !ok {
+			return "", fmt.Errorf("invalid type %v", t)
+		}
+		if typ == "object" {
+			continue
+		}
+		expr, err := c.builtinExpr(typ, node, hint)
+		if err != nil {
+			return "", err
+		}
+		members = append(members, expr)
+	}
+	return strings.Join(members, " | "), nil
+}
+
+// unionExpr returns the type expression of the union of schemas
+func (c *jsonSchemaConverter) unionExpr(members []interface{}, hint string) (string, error) {
+	var exprs []string
+	for i, m := range members {
+		member, ok := m.(map[string]interface{})
+		if !ok {
+			return "", fmt.Errorf("invalid union member: %v", m)
+		}
+		memberHint := fmt.Sprintf("%v %v", hint, i+1)
+		decl, err := c.decl(member, memberHint)
+		if err != nil {
+			return "", err
+		}
+		expr, ok := decl.(string)
+		if !ok { // a member with facets is a named type
+			expr = c.namer.name(memberHint)
+			c.types[expr] = toType(decl)
+		}
+		exprs = append(exprs, expr)
+	}
+	return strings.Join(exprs, " | "), nil
+}
+
+// ref returns the type name of a referenced schema
+func (c *jsonSchemaConverter) ref(ref string) (string, error) {
+	if name, ok := c.refs[ref]; ok {
+		return name, nil
+	}
+	if !strings.HasPrefix(ref, "#") {
+		return "", fmt.Errorf("unsupported $ref %v", ref)
+	}
+	node, ok := resolveJSONPointer(c.root, ref).(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("can't resolve $ref %v", ref)
+	}
+
+	hint := ref[strings.LastIndex(ref, "/")+1:]
+	name := c.namer.name(hint)
+	c.refs[ref] = name // registered before the translation, the schema can reference itself
+	t, err := c.toType(node, hint)
+	if err != nil {
+		return "", err
+	}
+	c.types[name] = t
+	return name, nil
+}
+
+// facets returns the validation keywords of a schema as RAML facets
+func (c *jsonSchemaConverter) facets(node map[string]interface{}) map[interface{}]interface{} {
+	facets := map[interface{}]interface{}{}
+	for _, name := range jsonSchemaFacets {
+		if val, ok := node[name]; ok {
+			facets[name] = jsonValue(val)
+		}
+	}
+	return facets
+}
+
+// isJSONObjectSchema returns true if a schema is an object schema,
+// including a schema whose types contain `object`
+func isJSONObjectSchema(node map[string]interface{}) bool {
+	if _, ok := node["$ref"]; ok {
+		return false
+	}
+	switch typ := node["type"].(type) {
+	case string:
+		return typ == "object"
+	case []interface{}: // e.g. ["object", "null"]
+		for _, t := range typ {
+			if t == "object" {
+				return true
+			}
+		}
+		return false
+	}
+	_, hasProps := node["properties"]
+	_, hasAllOf := node["allOf"]
+	return hasProps || hasAllOf
+}
+
+// jsonValue converts a decoded JSON value into the value of a decoded YAML node,
+// i.e. integral numbers are int and objects are map[interface{}]interface{}
+func jsonValue(val interface{}) interface{} {
+	switch v := val.(type) {
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < math.MaxInt32 {
+			return int(v)
+		}
+	case []interface{}:
+		values := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			values = append(values, jsonValue(item))
+		}
+		return values
+	case map[string]interface{}:
+		m := map[interface{}]interface{}{}
+		for k, item := range v {
+			m[k] = jsonValue(item)
+		}
+		return m
+	}
+	return val
+}
+
+// resolveJSONPointer returns the node of a JSON document
+// referenced by a JSON pointer, e.g. `#/definitions/address`.
+// It returns nil if there is no such node.
+func resolveJSONPointer(root interface{}, pointer string) interface{} {
+	pointer = strings.TrimPrefix(strings.TrimPrefix(pointer, "#"), "/")
+	if pointer == "" {
+		return root
+	}
+	node := root
+	for _, token := range strings.Split(pointer, "/") {
+		token = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+		switch n := node.(type) {
+		case map[string]interface{}:
+			node = n[token]
+		case []interface{}:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(n) {
+				return nil
+			}
+			node = n[i]
+		default:
+			return nil
+		}
+	}
+	return node
+}
+
+// jsonSchemaBundler inlines the JSON schemas of other files
+// into the definitions of a JSON schema
+type jsonSchemaBundler struct {
+	parser *Parser
+	root   map[string]interface{}
+
+	// JSON pointers of the bundled schemas by their file path
+	pointers map[string]string
+}
+
+// bundleJSONSchema returns an included JSON schema in which the `$ref` to
+// the other files are replaced by references to its definitions.
+// The content of a file which has no such reference is returned as is,
+// an error is returned if the file isn't valid JSON.
+func (p *Parser) bundleJSONSchema(filePath string, contents []byte) ([]byte, error) {
+	var doc interface{}
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON file %v: %v", filePath, err)
+	}
+	root, ok := doc.(map[string]interface{})
+	if !ok || !hasExternalRef(root) {
+		return contents, nil
+	}
+	b := &jsonSchemaBundler{
+		parser:   p,
+		root:     root,
+		pointers: map[string]string{filePath: "#"},
+	}
+	if err := b.rewriteRefs(root, filePath, "#"); err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(root, "", "  ")
+}
+
+// rewriteRefs rewrites the `$ref` of a schema of the given file,
+// pointer is the JSON pointer of the file schema in the bundle.
+func (b *jsonSchemaBundler) rewriteRefs(node interface{}, filePath, pointer string) error {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(n))
+		for k := range n {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if ref, ok := n[k].(string); ok && k == "$ref" {
+				newRef, err := b.rewriteRef(ref, filePath, pointer)
+				if err != nil {
+					return err
+				}
+				n[k] = newRef
+				continue
+			}
+			if err := b.rewriteRefs(n[k], filePath, pointer); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, item := range n {
+			if err := b.rewriteRefs(item, filePath, pointer); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// rewriteRef returns the reference in the bundle of a `$ref` of the given file
+func (b *jsonSchemaBundler) rewriteRef(ref, filePath, pointer string) (string, error) {
+	file, fragment := ref, ""
+	if i := strings.Index(ref, "#"); i >= 0 {
+		file, fragment = ref[:i], ref[i+1:]
+	}
+	if strings.Contains(file, "://") { // remote schema is not bundled
+		return ref, nil
+	}
+	if file != "" {
+		refPath, err := b.parser.includeResolver(filePath, file)
+		if err != nil {
+			return "", err
+		}
+		if pointer, err = b.bundle(refPath); err != nil {
+			return "", err
+		}
+	}
+	return pointer + strings.TrimSuffix(fragment, "/"), nil
+}
+
+// bundle adds the schema of a file to the definitions of the root schema,
+// it returns the JSON pointer of the schema.
+func (b *jsonSchemaBundler) bundle(filePath string) (string, error) {
+	if pointer, ok := b.pointers[filePath]; ok {
+		return pointer, nil
+	}
+	contents, err := b.parser.readFile(filePath)
+	if err != nil {
+		return "", err
+	}
+	var schema map[string]interface{}
+	if err := json.Unmarshal(contents, &schema); err != nil {
+		return "", fmt.Errorf("invalid JSON schema %v: %v", filePath, err)
+	}
+
+	defs, ok := b.root["definitions"].(map[string]interface{})
+	if !ok {
+		defs = map[string]interface{}{}
+		b.root["definitions"] = defs
+	}
+	base := strings.TrimSuffix(filepath.Base(filePath), filepath.Ext(filePath))
+	key := base
+	for i := 2; defs[key] != nil; i++ {
+		key = fmt.Sprintf("%v%v", base, i)
+	}
+	defs[key] = schema
+
+	pointer := "#/definitions/" + key
+	b.pointers[filePath] = pointer
+	return pointer, b.rewriteRefs(schema, filePath, pointer)
+}
+
+// hasExternalRef returns true if a JSON document has a `$ref` to another file
+func hasExternalRef(node interface{}) bool {
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for k, v := range n {
+			if ref, ok := v.(string); ok && k == "$ref" && !strings.HasPrefix(ref, "#") {
+				return true
+			}
+			if hasExternalRef(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, item := range n {
+			if hasExternalRef(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -1,10 +1,5 @@
 package raml
 
-import (
-	"fmt"
-	"path/filepath"
-)
-
 // Library is used to combine any collection of data type declarations,
 // resource type declarations, trait declarations, and security scheme declarations
 // into modular, externalized, reusable groups.
@@ -16,13 +11,20 @@ type Library struct {
 	Traits          map[string]Trait          `yaml:"traits"`
 	SecuritySchemes map[string]SecurityScheme `yaml:"securitySchemes"`
 	Uses            map[string]string         `yaml:"uses"`
+	AnnotationTypes map[string]AnnotationType `yaml:"annotationTypes"`
+	Annotations     Annotations               `yaml:",regexp:^[(].*[)]$"`
 
 	// Describes the content or purpose of a specific library.
 	// The value is a string and MAY be formatted using markdown.
 	Usage string `yaml:"usage"`
 
 	Libraries map[string]*Library `yaml:"-"`
-	Filename  string              `yaml:"-"`
+
+	// Path of the library file, relative to the base directory of the parser
+	Filename string `yaml:"-"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 // PostProcess doing additional processing
@@ -31,16 +33,15 @@ type Library struct {
 // - setting some additional values not exist in the .raml
 // - allocate map fields
 func (l *Library) PostProcess(fileName string) error {
-	// libraries
-	l.Libraries = map[string]*Library{}
-	for name, path := range l.Uses {
-		lib := &Library{Filename: path}
-		if err := ParseFile(filepath.Join(ramlFileDir, path), lib); err != nil {
-			return fmt.Errorf("l.PostProcess() failed to parse library	name=%v, path=%v, err=%v",
-				name, path, err)
-		}
-		l.Libraries[name] = lib
+	// libraries are loaded by the parser
+	if l.Libraries == nil {
+		l.Libraries = map[string]*Library{}
+	}
 
+	// library qualified names
+	if err := checkReferences(l.Traits, l.ResourceTypes, l.SecuritySchemes,
+		l.Libraries, nil, nil, l.Position); err != nil {
+		return err
 	}
 
 	// traits
@@ -48,11 +49,19 @@ func (l *Library) PostProcess(fileName string) error {
 		t.postProcess(name)
 		l.Traits[name] = t
 	}
+	traits := allTraits(l.Traits, l.Libraries)
 
 	// resource types
 	for name, rt := range l.ResourceTypes {
-		rt.postProcess(name, l.Traits)
+		if err := rt.postProcess(name, traits); err != nil {
+			return err
+		}
 		l.ResourceTypes[name] = rt
 	}
-	return nil
+	if err := checkBaseResourceTypes(l.ResourceTypes, allResourceTypes(l.ResourceTypes, l.Libraries)); err != nil {
+		return err
+	}
+
+	// annotations
+	return l.validateAnnotations()
 }
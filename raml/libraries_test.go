@@ -33,6 +33,12 @@ func TestLibraries(t *testing.T) {
 			So(file.Get, ShouldNotBeNil)
 			So(file.Get.Headers, ShouldContainKey, HTTPHeader("drm-key"))
 
+			// resource type inherited from a library resource type
+			r := apiDef.Resources["/files/{name}"]
+			So(r.Get.Description, ShouldEqual, "get the file with its metadata")
+			So(r.Get.Headers, ShouldContainKey, HTTPHeader("drm-key"))
+			So(r.Put, ShouldNotBeNil)
+
 			// second level
 			So(files.Libraries, ShouldContainKey, "file-type")
 			fileType := files.Libraries["file-type"]
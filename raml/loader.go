@@ -0,0 +1,112 @@
+package raml
+
+// This file contains the file loaders used by the parser
+// to read RAML files, included files and libraries.
+
+import (
+	"archive/zip"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileLoader loads content of the files needed by the parser:
+// the root RAML file, the `!include`d files and the libraries.
+type FileLoader interface {
+	// ReadFile returns content of the file in the given path
+	ReadFile(path string) ([]byte, error)
+}
+
+// OSFileLoader loads the files from the operating system filesystem.
+// It is the default file loader.
+type OSFileLoader struct{}
+
+// ReadFile implements FileLoader.ReadFile
+func (OSFileLoader) ReadFile(path string) ([]byte, error) {
+	return ioutil.ReadFile(path)
+}
+
+// MemFileLoader loads the files from memory.
+// The key is the file path, the value is the file content.
+type MemFileLoader map[string][]byte
+
+// ReadFile implements FileLoader.ReadFile
+func (m MemFileLoader) ReadFile(path string) ([]byte, error) {
+	path = loaderPath(path)
+	for name, content := range m {
+		if loaderPath(name) == path {
+			return content, nil
+		}
+	}
+	return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+}
+
+// ZipFileLoader loads the files from a zip archive.
+type ZipFileLoader struct {
+	files map[string]*zip.File
+}
+
+// NewZipFileLoader creates a file loader of a zip archive
+func NewZipFileLoader(r *zip.Reader) *ZipFileLoader {
+	zl := &ZipFileLoader{
+		files: map[string]*zip.File{},
+	}
+	for _, f := range r.File {
+		zl.files[loaderPath(f.Name)] = f
+	}
+	return zl
+}
+
+// ReadFile implements FileLoader.ReadFile
+func (zl *ZipFileLoader) ReadFile(path string) ([]byte, error) {
+	f, ok := zl.files[loaderPath(path)]
+	if !ok {
+		return nil, &os.PathError{Op: "open", Path: path, Err: os.ErrNotExist}
+	}
+
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return ioutil.ReadAll(rc)
+}
+
+// readerFileLoader serves a file from an already read content,
+// other files are loaded by the underlying loader
+type readerFileLoader struct {
+	path    string
+	content []byte
+	loader  FileLoader
+}
+
+// ReadFile implements FileLoader.ReadFile
+func (rl readerFileLoader) ReadFile(path string) ([]byte, error) {
+	if loaderPath(path) == loaderPath(rl.path) {
+		return rl.content, nil
+	}
+	return rl.loader.ReadFile(path)
+}
+
+// loaderPath normalizes a file path of in-memory and zip file loader
+func loaderPath(path string) string {
+	return strings.TrimPrefix(filepath.ToSlash(filepath.Clean(path)), "/")
+}
+
+// readFile reads a file using the parser file loader
+func (p *Parser) readFile(filePath string) ([]byte, error) {
+	if _, fileName := filepath.Split(filePath); fileName == "" {
+		return nil, fmt.Errorf("File name cannot be nil: %s", filePath)
+	}
+
+	content, err := p.fileLoader.ReadFile(filePath)
+	if err != nil {
+		return nil,
+			fmt.Errorf("Could not read file %s (Error: %s)",
+				filePath, err.Error())
+	}
+	return content, nil
+}
@@ -0,0 +1,102 @@
+package raml
+
+import (
+	"archive/zip"
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+var loaderFiles = map[string]string{
+	"api.raml": `#%RAML 1.0
+title: Loader API
+uses:
+  lib: libs/lib.raml
+types:
+  User: !include types/user.raml
+/users:
+  get:
+    responses:
+      200:
+        body:
+          application/json:
+            type: lib.Users
+`,
+	"types/user.raml": `type: object
+properties:
+  name: string
+  address: !include address.raml
+`,
+	"types/address.raml": `type: object
+properties:
+  street: string
+`,
+	"libs/lib.raml": `#%RAML 1.0 Library
+types:
+  Users:
+    type: array
+    items: string
+`,
+}
+
+func TestFileLoader(t *testing.T) {
+	Convey("File loader", t, func() {
+		Convey("in-memory loader", func() {
+			files := MemFileLoader{}
+			for name, content := range loaderFiles {
+				files[name] = []byte(content)
+			}
+			apiDef := new(APIDefinition)
+			err := NewParser(ParserOptions{FileLoader: files}).ParseFile("api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Title, ShouldEqual, "Loader API")
+			So(apiDef.Types["User"].Properties, ShouldContainKey, "address")
+			So(apiDef.Libraries, ShouldContainKey, "lib")
+			So(apiDef.Libraries["lib"].Types, ShouldContainKey, "Users")
+		})
+
+		Convey("zip loader", func() {
+			buf := new(bytes.Buffer)
+			zw := zip.NewWriter(buf)
+			for name, content := range loaderFiles {
+				w, err := zw.Create("api/" + name)
+				So(err, ShouldBeNil)
+				_, err = w.Write([]byte(content))
+				So(err, ShouldBeNil)
+			}
+			So(zw.Close(), ShouldBeNil)
+
+			zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+			So(err, ShouldBeNil)
+
+			apiDef := new(APIDefinition)
+			err = NewParser(ParserOptions{FileLoader: NewZipFileLoader(zr)}).ParseFile("api/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Types["User"].Properties, ShouldContainKey, "address")
+			So(apiDef.Libraries["lib"].Types, ShouldContainKey, "Users")
+		})
+
+		Convey("missing file", func() {
+			apiDef := new(APIDefinition)
+			files := MemFileLoader{"api.raml": []byte(loaderFiles["api.raml"])}
+			err := NewParser(ParserOptions{FileLoader: files}).ParseFile("api.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "types/user.raml")
+		})
+
+		Convey("parse from reader", func() {
+			apiDef := new(APIDefinition)
+			r := strings.NewReader(`#%RAML 1.0
+title: Reader API
+types:
+  User: !include types/user.raml
+`)
+			err := NewParser(ParserOptions{}).Parse(r, "./samples/include/api_reader.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Title, ShouldEqual, "Reader API")
+			So(apiDef.Types, ShouldContainKey, "User")
+		})
+	})
+}
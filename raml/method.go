@@ -2,6 +2,7 @@ package raml
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -19,7 +20,8 @@ type Method struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description"`
 
-	// TODO : annotation
+	// Annotations to be applied to this method.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
 
 	// Detailed information about any query parameters needed by this method.
 	// Mutually exclusive with queryString.
@@ -31,9 +33,10 @@ type Method struct {
 	// Detailed information about any request headers needed by this method.
 	Headers map[HTTPHeader]Header `yaml:"headers"`
 
-	// The query string needed by this method.
+	// The query string needed by this method, it is a type declaration
+	// whose properties are the query parameters, e.g. `queryString: PageQuery`.
 	// Mutually exclusive with queryParameters.
-	QueryString map[string]NamedParameter `yaml:"queryString"`
+	QueryString *Type `yaml:"queryString"`
 
 	// Information about the expected responses to a request.
 	// Responses MUST be a map of one or more HTTP status codes, where each
@@ -53,6 +56,9 @@ type Method struct {
 
 	// The security schemes that apply to this method.
 	SecuredBy []DefinitionChoice `yaml:"securedBy"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 func newMethod(name string) *Method {
@@ -65,29 +71,41 @@ func newMethod(name string) *Method {
 // fields need to be inherited:
 // - description
 // - response
-func (m *Method) inheritFromResourceType(r *Resource, rtm *Method, rt *ResourceType) {
+func (m *Method) inheritFromResourceType(rtm *Method, dicts map[string]interface{}) error {
 	if rtm == nil {
-		return
+		return nil
 	}
-	dicts := initResourceTypeDicts(r, r.Type.Parameters)
 
 	// inherit description
-	m.Description = substituteParams(m.Description, rtm.Description, dicts)
+	var err error
+	if m.Description, err = substituteParams(m.Description, rtm.Description, dicts); err != nil {
+		return err
+	}
 
 	// inherit bodies
-	m.Bodies.inherit(rtm.Bodies, dicts)
+	if err := m.Bodies.inherit(rtm.Bodies, dicts); err != nil {
+		return err
+	}
 
 	// inherit headers
-	m.inheritHeaders(rtm.Headers, dicts)
+	if err := m.inheritHeaders(rtm.Headers, dicts); err != nil {
+		return err
+	}
 
 	// inherit query params
-	m.inheritQueryParams(rtm.QueryParameters, dicts)
+	if err := m.inheritQueryParams(rtm.QueryParameters, dicts); err != nil {
+		return err
+	}
+	m.inheritQueryString(rtm.QueryString)
 
 	// inherit response
-	m.inheritResponses(rtm.Responses, dicts)
+	if err := m.inheritResponses(rtm.Responses, dicts); err != nil {
+		return err
+	}
 
 	// inherit protocols
 	m.inheritProtocols(rtm.Protocols)
+	return nil
 }
 
 // inherit from all traits, inherited traits are:
@@ -101,8 +119,12 @@ func (m *Method) inheritFromTraits(r *Resource, is []DefinitionChoice, traitsMap
 			return fmt.Errorf("invalid traits name:%v", tDef.Name)
 		}
 
-		if err := m.inheritFromATrait(r, &t, tDef.Parameters); err != nil {
-			return err
+		applied, err := t.apply(r, m, tDef.Parameters)
+		if err != nil {
+			return fmt.Errorf("trait %v: %v", tDef.Name, err)
+		}
+		if err := m.inheritFromATrait(r, applied, tDef.Parameters); err != nil {
+			return fmt.Errorf("trait %v: %v", tDef.Name, err)
 		}
 	}
 	return nil
@@ -113,15 +135,27 @@ func (m *Method) inheritFromTraits(r *Resource, is []DefinitionChoice, traitsMap
 func (m *Method) inheritFromATrait(r *Resource, t *Trait, dicts map[string]interface{}) error {
 	dicts = initTraitDicts(r, m, dicts)
 
-	m.Description = substituteParams(m.Description, t.Description, dicts)
+	var err error
+	if m.Description, err = substituteParams(m.Description, t.Description, dicts); err != nil {
+		return err
+	}
 
-	m.Bodies.inherit(t.Bodies, dicts)
+	if err := m.Bodies.inherit(t.Bodies, dicts); err != nil {
+		return err
+	}
 
-	m.inheritHeaders(t.Headers, dicts)
+	if err := m.inheritHeaders(t.Headers, dicts); err != nil {
+		return err
+	}
 
-	m.inheritResponses(t.Responses, dicts)
+	if err := m.inheritResponses(t.Responses, dicts); err != nil {
+		return err
+	}
 
-	m.inheritQueryParams(t.QueryParameters, dicts)
+	if err := m.inheritQueryParams(t.QueryParameters, dicts); err != nil {
+		return err
+	}
+	m.inheritQueryString(t.QueryString)
 
 	m.inheritProtocols(t.Protocols)
 
@@ -132,14 +166,31 @@ func (m *Method) inheritFromATrait(r *Resource, t *Trait, dicts map[string]inter
 	return nil
 }
 
+// setOptionalParams removes the `?` suffix of the names of the optional
+// parameters and headers of the method, of its bodies and of its responses
+func (m *Method) setOptionalParams() {
+	setOptionalParams(m.QueryParameters)
+	setOptionalHeaders(m.Headers)
+	m.Bodies.setOptionalParams()
+	for _, resp := range m.Responses {
+		setOptionalHeaders(resp.Headers)
+		resp.Bodies.setOptionalParams()
+	}
+}
+
 // inheritHeaders inherit method's headers from parent headers.
 // parent headers could be from resource type or a trait
-func (m *Method) inheritHeaders(parents map[HTTPHeader]Header, dicts map[string]interface{}) {
-	m.Headers = inheritHeaders(m.Headers, parents, dicts)
+func (m *Method) inheritHeaders(parents map[HTTPHeader]Header, dicts map[string]interface{}) error {
+	headers, err := inheritHeaders(m.Headers, parents, dicts)
+	if err != nil {
+		return err
+	}
+	m.Headers = headers
+	return nil
 }
 
 // inheritHeaders inherits headers from parents to childs
-func inheritHeaders(childs, parents map[HTTPHeader]Header, dicts map[string]interface{}) map[HTTPHeader]Header {
+func inheritHeaders(childs, parents map[HTTPHeader]Header, dicts map[string]interface{}) (map[HTTPHeader]Header, error) {
 	if len(childs) == 0 {
 		childs = map[HTTPHeader]Header{}
 	}
@@ -154,15 +205,17 @@ func inheritHeaders(childs, parents map[HTTPHeader]Header, dicts map[string]inte
 		}
 		parent.Name = string(name)
 		np := NamedParameter(h)
-		np.inherit(NamedParameter(parent), dicts)
+		if err := np.inherit(NamedParameter(parent), dicts); err != nil {
+			return nil, fmt.Errorf("header %v: %v", name, err)
+		}
 		childs[name] = Header(np)
 	}
-	return childs
+	return childs, nil
 }
 
 // inheritQueryParams inherit method's query params from parent query params.
 // parent query params could be from resource type or a trait
-func (m *Method) inheritQueryParams(parents map[string]NamedParameter, dicts map[string]interface{}) {
+func (m *Method) inheritQueryParams(parents map[string]NamedParameter, dicts map[string]interface{}) error {
 	if len(m.QueryParameters) == 0 {
 		m.QueryParameters = map[string]NamedParameter{}
 	}
@@ -175,10 +228,20 @@ func (m *Method) inheritQueryParams(parents map[string]NamedParameter, dicts map
 			qp = NamedParameter{Name: name}
 		}
 		parent.Name = name // parent name is not initialized by the parser
-		qp.inherit(parent, dicts)
+		if err := qp.inherit(parent, dicts); err != nil {
+			return fmt.Errorf("query parameter %v: %v", name, err)
+		}
 		m.QueryParameters[qp.Name] = qp
 	}
+	return nil
+}
 
+// inheritQueryString inherit method's query string from parent query string,
+// parent query string could be from resource type or a trait
+func (m *Method) inheritQueryString(parent *Type) {
+	if m.QueryString == nil {
+		m.QueryString = parent
+	}
 }
 
 // inheritProtocols inherit method's protocols from parent protocols
@@ -191,7 +254,7 @@ func (m *Method) inheritProtocols(parent []string) {
 
 // inheritResponses inherit method's responses from parent responses
 // parent responses could be from resource type or a trait
-func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string]interface{}) {
+func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string]interface{}) error {
 	if len(m.Responses) == 0 { // allocate if needed
 		m.Responses = map[HTTPCode]Response{}
 	}
@@ -203,10 +266,12 @@ func (m *Method) inheritResponses(parent map[HTTPCode]Response, dicts map[string
 			}
 			resp = Response{HTTPCode: code}
 		}
-		resp.inherit(rParent, dicts)
+		if err := resp.inherit(rParent, dicts); err != nil {
+			return fmt.Errorf("response %v: %v", code, err)
+		}
 		m.Responses[code] = resp
 	}
-
+	return nil
 }
 
 // Response property of a method on a resource describes
@@ -225,7 +290,8 @@ type Response struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string
 
-	// TODO : annotation
+	// Annotations to be applied to this response.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
 
 	// An API's methods may support custom header values in responses
 	// Detailed information about any response headers returned by this method
@@ -233,13 +299,32 @@ type Response struct {
 
 	// The body of the response
 	Bodies Bodies `yaml:"body"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 // inherit from parent response
-func (resp *Response) inherit(parent Response, dicts map[string]interface{}) {
-	resp.Description = substituteParams(resp.Description, parent.Description, dicts)
-	resp.Bodies.inherit(parent.Bodies, dicts)
-	resp.Headers = inheritHeaders(resp.Headers, parent.Headers, dicts)
+func (resp *Response) inherit(parent Response, dicts map[string]interface{}) error {
+	var err error
+	if resp.Description, err = substituteParams(resp.Description, parent.Description, dicts); err != nil {
+		return err
+	}
+	if err = resp.Bodies.inherit(parent.Bodies, dicts); err != nil {
+		return err
+	}
+	resp.Headers, err = inheritHeaders(resp.Headers, parent.Headers, dicts)
+	return err
+}
+
+// applyMediaTypes applies the default media types to the request body
+// and the response bodies of a method
+func (m *Method) applyMediaTypes(mediaTypes []string) {
+	m.Bodies.applyMediaTypes(mediaTypes)
+	for code, resp := range m.Responses {
+		resp.Bodies.applyMediaTypes(mediaTypes)
+		m.Responses[code] = resp
+	}
 }
 
 // Body is the request/response body
@@ -262,11 +347,21 @@ type Body struct {
 	// specified in the root-level schemas property
 	Schema string `yaml:"schema"`
 
+	// Type of the body
+	Type string `yaml:"type"`
+
+	// Properties of the body, when the body is an object type declared inline.
+	// we use `interface{}` as property type to support syntactic sugar & shortcut
+	Properties map[string]interface{} `yaml:"properties"`
+
 	// Brief description
 	Description string `yaml:"description"`
 
-	// Example attribute to generate example invocations
-	Example string `yaml:"example"`
+	// An example of the body
+	Example interface{} `yaml:"example"`
+
+	// Named examples of the body
+	Examples map[string]interface{} `yaml:"examples"`
 
 	// Web forms REQUIRE special encoding and custom declaration.
 	// If the API's media type is either application/x-www-form-urlencoded or
@@ -280,6 +375,91 @@ type Body struct {
 	// request and response body?
 
 	Headers map[HTTPHeader]Header `yaml:"headers"`
+
+	// Annotations to be applied to this body.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// positions of the examples in the RAML source
+	examplePositions examplePositions
+}
+
+// UnmarshalYAML unmarshals a body and keeps the positions of its examples
+func (b *Body) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// the body without UnmarshalYAML method
+	type body Body
+	var decl body
+	if err := unmarshal(&decl); err != nil {
+		return err
+	}
+	*b = Body(decl)
+	b.examplePositions = unmarshalExamplePositions(unmarshal)
+	return nil
+}
+
+// HasType returns true if the body declares its type
+func (b Body) HasType() bool {
+	return b.Type != "" || len(b.Properties) > 0
+}
+
+// inherit inherits the body of the same media type from a parent body
+func (b *Body) inherit(parent Body, dicts map[string]interface{}) error {
+	var err error
+	if b.Schema, err = substituteParams(b.Schema, parent.Schema, dicts); err != nil {
+		return err
+	}
+	if b.Description, err = substituteParams(b.Description, parent.Description, dicts); err != nil {
+		return err
+	}
+	if b.Type, err = substituteParams(b.Type, parent.Type, dicts); err != nil {
+		return err
+	}
+	if b.Example == nil && len(b.Examples) == 0 {
+		b.Example, b.Examples = parent.Example, parent.Examples
+		b.examplePositions = parent.examplePositions
+	}
+	b.Properties = inheritProperties(b.Properties, parent.Properties)
+
+	if len(parent.FormParameters) > 0 && b.FormParameters == nil {
+		b.FormParameters = map[string]NamedParameter{}
+	}
+	for name, parentParam := range parent.FormParameters {
+		p := b.FormParameters[name]
+		if err := p.inherit(parentParam, dicts); err != nil {
+			return fmt.Errorf("form parameter %v: %v", name, err)
+		}
+		b.FormParameters[name] = p
+	}
+	return nil
+}
+
+// inheritProperties adds the properties of a parent body which are not
+// declared by a body
+func inheritProperties(props, parent map[string]interface{}) map[string]interface{} {
+	if len(parent) == 0 {
+		return props
+	}
+	if props == nil {
+		props = map[string]interface{}{}
+	}
+	for k, p := range parent {
+		if _, ok := props[k]; ok {
+			continue
+		}
+
+		// handle optional properties as described in
+		// https://github.com/raml-org/raml-spec/blob/raml-10/versions/raml-10/raml-10.md#optional-properties
+		switch {
+		case strings.HasSuffix(k, `\?`): // if ended with `\?` we make it optional property
+			k = k[:len(k)-2] + "?"
+		case strings.HasSuffix(k, "?"): // if only ended with `?`, we can ignore it
+			continue
+		}
+		props[k] = p
+	}
+	return props
 }
 
 // Bodies is Container of Body types, necessary because of technical reasons.
@@ -306,6 +486,10 @@ type Bodies struct {
 	//           {
 	//             "some_example" : "123"
 	//           }
+	//
+	// The body of the default media types is moved to ForMIMEType
+	// once the API definition is parsed, it is only kept here
+	// when the API doesn't declare its default media types.
 
 	// As in the Body type.
 	Schema string `yaml:"schema"`
@@ -314,59 +498,207 @@ type Bodies struct {
 	Description string `yaml:"description"`
 
 	// As in the Body type.
-	Example string `yaml:"example"`
+	Example interface{} `yaml:"example"`
+
+	// As in the Body type.
+	Examples map[string]interface{} `yaml:"examples"`
+
+	// As in the Body type.
+	Properties map[string]interface{} `yaml:"properties"`
 
 	// As in the Body type.
 	FormParameters map[string]NamedParameter `yaml:"formParameters"`
 
+	// As in the Body type.
+	// It must be declared before ForMIMEType to not be captured as a media type.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
 	// Resources CAN have alternate representations. For example, an API
 	// might support both JSON and XML representations. This is the map
 	// between MIME-type and the body definition related to it.
-	ForMIMEType map[string]Body `yaml:",regexp:.*"`
-
 	// TODO: For APIs without a priori knowledge of the response types for
 	// their responses, "*/*" MAY be used to indicate that responses that do
 	// not matching other defined data types MUST be accepted. Processing
 	// applications MUST match the most descriptive media type first if
 	// "*/*" is used.
-	ApplicationJSON *BodiesProperty `yaml:"application/json"`
+	ForMIMEType map[string]Body `yaml:",regexp:.*"`
 
 	// Request/response body type
 	Type string `yaml:"type"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// positions of the examples of the body declared without media type
+	examplePositions examplePositions
 }
 
-// inherit inherits bodies properties from a parent bodies
-// parent object could be from trait or response type
-func (b *Bodies) inherit(parent Bodies, dicts map[string]interface{}) {
-	b.Schema = substituteParams(b.Schema, parent.Schema, dicts)
-	b.Description = substituteParams(b.Description, parent.Description, dicts)
-	b.Example = substituteParams(b.Example, parent.Example, dicts)
+// UnmarshalYAML unmarshals the bodies and keeps the positions of the examples
+// of the body declared without media type
+func (b *Bodies) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	// the bodies without UnmarshalYAML method
+	type bodies Bodies
+	var decl bodies
+	if err := unmarshal(&decl); err != nil {
+		return err
+	}
+	*b = Bodies(decl)
+	b.examplePositions = unmarshalExamplePositions(unmarshal)
+	return nil
+}
 
-	b.Type = substituteParams(b.Type, parent.Type, dicts)
+// MediaTypes returns the sorted media types of the bodies
+func (b Bodies) MediaTypes() []string {
+	var mediaTypes []string
+	for mt := range b.ForMIMEType {
+		mediaTypes = append(mediaTypes, mt)
+	}
+	sort.Strings(mediaTypes)
+	return mediaTypes
+}
+
+// ForMediaType returns the body of a media type,
+// nil if the bodies don't declare that media type
+func (b Bodies) ForMediaType(mediaType string) *Body {
+	body, ok := b.ForMIMEType[mediaType]
+	if !ok {
+		return nil
+	}
+	return &body
+}
+
+// ApplicationJSON returns the application/json body,
+// nil if the bodies don't declare it.
+//
+// Deprecated: use ForMediaType("application/json").
+func (b Bodies) ApplicationJSON() *BodiesProperty {
+	body := b.ForMediaType("application/json")
+	if body == nil {
+		return nil
+	}
+	return &BodiesProperty{
+		Properties:  body.Properties,
+		Type:        body.Type,
+		Example:     body.Example,
+		Examples:    body.Examples,
+		Annotations: body.Annotations,
+		Position:    body.Position,
+	}
+}
 
-	// request body
-	if parent.ApplicationJSON != nil {
-		if b.ApplicationJSON == nil { // allocate if needed
-			b.ApplicationJSON = &BodiesProperty{Properties: map[string]interface{}{}}
+// defaultBody returns the body declared without media type
+func (b Bodies) defaultBody() Body {
+	return Body{
+		Schema:         b.Schema,
+		Type:           b.Type,
+		Properties:     b.Properties,
+		Description:    b.Description,
+		Example:        b.Example,
+		Examples:       b.Examples,
+		FormParameters: b.FormParameters,
+		Position:       b.Position,
+
+		examplePositions: b.examplePositions,
+	}
+}
+
+// hasDefaultBody returns true if the body is declared without media type
+func (b Bodies) hasDefaultBody() bool {
+	return b.Schema != "" || b.Type != "" || len(b.Properties) > 0 || b.Example != nil ||
+		len(b.Examples) > 0 || len(b.FormParameters) > 0
+}
+
+// applyMediaTypes makes the body declared without media type
+// the body of each default media type of the API.
+// A body declared for a default media type takes the type and the
+// examples it doesn't declare from that body, e.g. when the body
+// without media type is inherited from a trait.
+func (b *Bodies) applyMediaTypes(mediaTypes []string) {
+	if len(mediaTypes) == 0 || !b.hasDefaultBody() {
+		return
+	}
+	if b.ForMIMEType == nil {
+		b.ForMIMEType = map[string]Body{}
+	}
+	for _, mt := range mediaTypes {
+		body, ok := b.ForMIMEType[mt]
+		if !ok {
+			b.ForMIMEType[mt] = b.defaultBody()
+			continue
+		}
+		if body.Schema == "" && body.Type == "" && len(body.Properties) == 0 {
+			body.Schema, body.Type, body.Properties = b.Schema, b.Type, b.Properties
+		}
+		if body.Example == nil && len(body.Examples) == 0 {
+			body.Example, body.Examples = b.Example, b.Examples
+			body.examplePositions = b.examplePositions
+		}
+		if len(body.FormParameters) == 0 {
+			body.FormParameters = b.FormParameters
 		}
+		b.ForMIMEType[mt] = body
+	}
+	b.Schema, b.Type, b.Properties = "", "", nil
+	b.Example, b.Examples, b.FormParameters = nil, nil, nil
+	b.examplePositions = examplePositions{}
+}
 
-		b.ApplicationJSON.Type = substituteParams(b.ApplicationJSON.Type, parent.ApplicationJSON.Type, dicts)
+// setOptionalParams removes the `?` suffix of the names of the optional form parameters
+func (b *Bodies) setOptionalParams() {
+	setOptionalParams(b.FormParameters)
+	for _, body := range b.ForMIMEType {
+		setOptionalParams(body.FormParameters)
+	}
+}
 
-		for k, p := range parent.ApplicationJSON.Properties {
-			if _, ok := b.ApplicationJSON.Properties[k]; !ok {
+// inherit inherits bodies properties from a parent bodies
+// parent object could be from trait or response type
+func (b *Bodies) inherit(parent Bodies, dicts map[string]interface{}) error {
+	var err error
+	if b.Schema, err = substituteParams(b.Schema, parent.Schema, dicts); err != nil {
+		return err
+	}
+	if b.Description, err = substituteParams(b.Description, parent.Description, dicts); err != nil {
+		return err
+	}
+	if b.Example == nil && len(b.Examples) == 0 {
+		b.Example, b.Examples = parent.Example, parent.Examples
+		b.examplePositions = parent.examplePositions
+	}
+	if b.Type, err = substituteParams(b.Type, parent.Type, dicts); err != nil {
+		return err
+	}
+	b.Properties = inheritProperties(b.Properties, parent.Properties)
 
-				// handle optional properties as described in
-				// https://github.com/raml-org/raml-spec/blob/raml-10/versions/raml-10/raml-10.md#optional-properties
-				switch {
-				case strings.HasSuffix(k, `\?`): // if ended with `\?` we make it optional property
-					k = k[:len(k)-2] + "?"
-				case strings.HasSuffix(k, "?"): // if only ended with `?`, we can ignore it
-					continue
-				}
-				b.ApplicationJSON.Properties[k] = p
-			}
+	// bodies of each media type
+	if len(parent.ForMIMEType) > 0 && b.ForMIMEType == nil {
+		b.ForMIMEType = map[string]Body{}
+	}
+	for mt, parentBody := range parent.ForMIMEType {
+		body := b.ForMIMEType[mt]
+		if err := body.inherit(parentBody, dicts); err != nil {
+			return fmt.Errorf("body %v: %v", mt, err)
 		}
+		b.ForMIMEType[mt] = body
 	}
+	return nil
+}
+
+// IsJSONMediaType returns true if a media type is JSON,
+// including the vendor types, e.g. `application/vnd.api+json`
+func IsJSONMediaType(mediaType string) bool {
+	mt := baseMediaType(mediaType)
+	return mt == "application/json" || strings.HasSuffix(mt, "+json")
+}
+
+// IsXMLMediaType returns true if a media type is XML,
+// including the vendor types, e.g. `application/atom+xml`
+func IsXMLMediaType(mediaType string) bool {
+	mt := baseMediaType(mediaType)
+	return mt == "application/xml" || mt == "text/xml" || strings.HasSuffix(mt, "+xml")
+}
 
-	// TODO : formimeytype
+// baseMediaType returns a media type without its parameters
+func baseMediaType(mediaType string) string {
+	return strings.ToLower(strings.TrimSpace(strings.SplitN(mediaType, ";", 2)[0]))
 }
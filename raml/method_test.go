@@ -0,0 +1,79 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestBodies(t *testing.T) {
+	Convey("bodies of the media types", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/media_types.raml", apiDef)
+		So(err, ShouldBeNil)
+		So(apiDef.MediaTypes, ShouldResemble, MediaTypes{"application/json", "application/xml"})
+		So(apiDef.MediaType, ShouldEqual, "application/json")
+
+		users := apiDef.Resources["/users"]
+		user := users.Nested["/{userId}"]
+
+		Convey("body of the default media types", func() {
+			bodies := users.Post.Bodies
+			So(bodies.MediaTypes(), ShouldResemble, []string{"application/json", "application/xml"})
+			So(bodies.Properties, ShouldBeNil)
+			for _, mt := range bodies.MediaTypes() {
+				So(bodies.ForMIMEType[mt].Properties, ShouldContainKey, "age?")
+			}
+
+			resp := users.Post.Responses[201].Bodies
+			So(resp.Type, ShouldBeEmpty)
+			So(resp.ForMIMEType["application/xml"].Type, ShouldEqual, "User")
+			So(resp.ForMIMEType["application/json"].Example, ShouldNotBeNil)
+		})
+
+		Convey("body of a media type completed by the default body", func() {
+			bodies := users.Get.Bodies
+			So(bodies.ForMIMEType["application/json"].Type, ShouldEqual, "User")
+			So(bodies.ForMIMEType["application/json"].Example, ShouldNotBeNil)
+			So(bodies.ForMIMEType["application/xml"].Type, ShouldEqual, "User")
+		})
+
+		Convey("body of a media type", func() {
+			bodies := users.Post.Bodies
+			So(bodies.ForMediaType("application/xml").Properties, ShouldContainKey, "age?")
+			So(bodies.ForMediaType("text/plain"), ShouldBeNil)
+
+			// deprecated accessor
+			So(bodies.ApplicationJSON().Properties, ShouldContainKey, "age?")
+			So(user.Get.Responses[200].Bodies.ApplicationJSON(), ShouldBeNil)
+		})
+
+		Convey("bodies of other media types", func() {
+			bodies := user.Get.Responses[200].Bodies
+			So(bodies.MediaTypes(), ShouldResemble, []string{"application/vnd.user+json", "application/xml"})
+			So(bodies.ForMIMEType["application/vnd.user+json"].HasType(), ShouldBeTrue)
+
+			form := user.Put.Bodies.ForMIMEType["application/x-www-form-urlencoded"]
+			So(form.Properties, ShouldContainKey, "name")
+			So(user.Put.Bodies.MediaTypes(), ShouldHaveLength, 1)
+		})
+
+		Convey("examples of the JSON bodies", func() {
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/media_types.raml:41:17: error: /users/{userId}.get.responses.200.body.application/vnd.user+json.example.age: thirty is not a valid integer",
+			})
+		})
+
+		Convey("JSON and XML media types", func() {
+			So(IsJSONMediaType("application/json; charset=utf-8"), ShouldBeTrue)
+			So(IsJSONMediaType("application/vnd.api+json"), ShouldBeTrue)
+			So(IsJSONMediaType("application/xml"), ShouldBeFalse)
+			So(IsXMLMediaType("application/atom+xml"), ShouldBeTrue)
+			So(IsXMLMediaType("text/xml"), ShouldBeTrue)
+		})
+	})
+}
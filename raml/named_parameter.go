@@ -1,5 +1,7 @@
 package raml
 
+import "strings"
+
 // NamedParameter is collection of named parameters
 // The RAML Specification uses collections of named parameters for the
 // following properties: URI parameters, query string parameters, form
@@ -24,23 +26,17 @@ type NamedParameter struct {
 	// The intended use or meaning of the parameter
 	Description string `yaml:"description"`
 
-	// The primitive type of the parameter's resolved value. Can be:
-	//
-	// Type	Description
-	// string	- Value MUST be a string.
-	// number	- Value MUST be a number. Indicate floating point numbers as defined by YAML.
-	// integer	- Value MUST be an integer. Floating point numbers are not allowed. The integer type is a subset of the number type.
-	// date		- Value MUST be a string representation of a date as defined in RFC2616 Section 3.3 [RFC2616]. See Date Representations.
-	// boolean	- Value MUST be either the string "true" or "false" (without the quotes).
-	// file		- (Applicable only to Form properties) Value is a file. Client generators SHOULD use this type to handle file uploads correctly.
+	// The type of the parameter. In RAML 1.0 a parameter is a type declaration,
+	// its type is a type expression: a built-in type, e.g. `integer`,
+	// a user-defined type, an array, e.g. `string[]`, or an union, e.g. `integer | string`.
+	// The facets of the parameter restrict its type.
+	// The default type is string.
 	Type string
-	// TODO: Verify the enum options
 
-	// If the enum attribute is defined, API clients and servers MUST verify
-	// that a parameter's value matches a value in the enum array
-	// Enum parsing is currently disabled because of:
-	// https://github.com/Jumpscale/go-raml/issues/99
-	//Enum []Any `yaml:",flow"`
+	// Enumeration of the possible values of the parameter.
+	// The value is an array containing the possible values,
+	// or a single value if there is only one possible value.
+	Enum interface{} `yaml:"enum"`
 
 	// The pattern attribute is a regular expression that a parameter of type
 	// string MUST match. Regular expressions MUST follow the regular
@@ -48,13 +44,13 @@ type NamedParameter struct {
 	Pattern *string
 
 	// The minLength attribute specifies the parameter value's minimum number
-	// of characters (string only)
+	// of characters, or the minimum size in bytes of a file
 	MinLength *int `yaml:"minLength"`
 	// TODO: go-yaml doesn't raise an error when the minLength isn't an integer!
 	// find out why and fix it.
 
 	// The maxLength attribute specifies the parameter value's maximum number
-	// of characters (string only)
+	// of characters, or the maximum size in bytes of a file
 	MaxLength *int `yaml:"maxLength"`
 
 	// The minimum attribute specifies the parameter's minimum value. (numbers
@@ -65,51 +61,152 @@ type NamedParameter struct {
 	// only)
 	Maximum *float64
 
+	// The format of a number parameter, e.g. int32 or double,
+	// or of a datetime parameter, e.g. rfc2616
+	Format *string `yaml:"format"`
+
+	// A number parameter is valid if the result of dividing
+	// its value by this value is an integer. (numbers only)
+	MultipleOf *float64 `yaml:"multipleOf"`
+
+	// The type of the items of an array parameter.
+	// Can be a type expression or an inline type declaration.
+	Items interface{} `yaml:"items"`
+
+	// Minimum and maximum number of items of an array parameter
+	MinItems *int `yaml:"minItems"`
+	MaxItems *int `yaml:"maxItems"`
+
+	// The items of an array parameter must be unique
+	UniqueItems *bool `yaml:"uniqueItems"`
+
+	// The content types of a file parameter, e.g. `image/png`
+	FileTypes []string `yaml:"fileTypes"`
+
 	// An example value for the property. This can be used, e.g., by
 	// documentation generators to generate sample values for the property.
 	Example interface{}
 
-	// The repeat attribute specifies that the parameter can be repeated,
-	// i.e. the parameter can be used multiple times
-	Repeat *bool // TODO: What does this mean?
+	// The RAML 0.8 repeat attribute specifies that the parameter can be repeated,
+	// i.e. the parameter can be used multiple times, e.g. `?tag=a&tag=b`.
+	// A repeated parameter is an array of its type.
+	// The RAML 0.8 documents are converted to use an array instead.
+	Repeat *bool
 
 	// Whether the parameter and its value MUST be present when a call is made.
-	// In general, parameters are optional unless the required attribute is
-	// included and its value set to 'true'.
-	// For a URI parameter, its default value is 'true'.
+	// The RAML 1.0 parameters are required unless their name ends with `?`
+	// or the required attribute is set to 'false'.
+	// The RAML 0.8 parameters are optional unless the required attribute is
+	// set to 'true', except the URI parameters.
 	Required bool
 
+	// requiredSet is true if the parameter declares whether it is required,
+	// either with the required attribute or the `?` suffix of its name
+	requiredSet bool
+
 	// The default value to use for the property if the property is omitted or
 	// its value is not specified
 	Default Any
 
+	// Annotations to be applied to this parameter.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
 	format Any `ramlFormat:"Named parameters must be mappings. Example: userId: {displayName: 'User ID', description: 'Used to identify the user.', type: 'integer', minimum: 1, example: 5}"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// position of the example in the RAML source
+	examplePositions examplePositions
+}
+
+// UnmarshalYAML unmarshals a named parameter which is either a map
+// or a type expression, e.g. `page: integer`
+func (np *NamedParameter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var expr string
+	if err := unmarshal(&expr); err == nil {
+		np.Type = expr
+		np.Required = true
+		return nil
+	}
+
+	// the named parameter without UnmarshalYAML method
+	type namedParameter NamedParameter
+	var decl namedParameter
+	if err := unmarshal(&decl); err != nil {
+		return err
+	}
+	*np = NamedParameter(decl)
+
+	var required struct {
+		Required *bool `yaml:"required"`
+	}
+	if err := unmarshal(&required); err != nil {
+		return err
+	}
+	np.Required = required.Required == nil || *required.Required
+	np.requiredSet = required.Required != nil
+	np.examplePositions = unmarshalExamplePositions(unmarshal)
+	return nil
+}
+
+// UnmarshalYAML unmarshals a header which is either a map
+// or a type expression, e.g. `X-Token: string`
+func (h *Header) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var np NamedParameter
+	if err := np.UnmarshalYAML(unmarshal); err != nil {
+		return err
+	}
+	*h = Header(np)
+	return nil
 }
 
-// check if an element exist in enum field
-/*func (np *NamedParameter) existInEnum(elem Any) bool {
-	for _, e := range np.Enum {
-		if reflect.DeepEqual(e, elem) {
-			return true
+// setOptionalParams removes the `?` suffix of the names of the
+// optional parameters, e.g. `sort?: string`, they are not required
+func setOptionalParams(params map[string]NamedParameter) {
+	for name, np := range params {
+		if !strings.HasSuffix(name, "?") {
+			continue
 		}
+		delete(params, name)
+		np.Required, np.requiredSet = false, true
+		params[strings.TrimSuffix(name, "?")] = np
 	}
-	return false
 }
-*/
-
-func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interface{}) {
-	np.Name = substituteParams(np.Name, parent.Name, dicts)
-	np.DisplayName = substituteParams(np.DisplayName, parent.DisplayName, dicts)
-	np.Description = substituteParams(np.Description, parent.Description, dicts)
-
-	/*
-		for _, elem := range parent.Enum {
-			if !np.existInEnum(elem) {
-				np.Enum = append(np.Enum, elem)
-			}
+
+// setOptionalHeaders removes the `?` suffix of the names of the
+// optional headers, they are not required
+func setOptionalHeaders(headers map[HTTPHeader]Header) {
+	for name, h := range headers {
+		if !strings.HasSuffix(string(name), "?") {
+			continue
 		}
-	*/
-	np.Pattern = inheritStringPointer(np.Pattern, parent.Pattern, dicts)
+		delete(headers, name)
+		h.Required, h.requiredSet = false, true
+		headers[HTTPHeader(strings.TrimSuffix(string(name), "?"))] = h
+	}
+}
+
+func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interface{}) error {
+	var err error
+	if np.Name, err = substituteParams(np.Name, parent.Name, dicts); err != nil {
+		return err
+	}
+	if np.DisplayName, err = substituteParams(np.DisplayName, parent.DisplayName, dicts); err != nil {
+		return err
+	}
+	if np.Description, err = substituteParams(np.Description, parent.Description, dicts); err != nil {
+		return err
+	}
+	if np.Type, err = substituteParams(np.Type, parent.Type, dicts); err != nil {
+		return err
+	}
+	if np.Enum == nil {
+		np.Enum = parent.Enum
+	}
+	if np.Pattern, err = inheritStringPointer(np.Pattern, parent.Pattern, dicts); err != nil {
+		return err
+	}
 	np.MinLength = inheritIntPointer(np.MinLength, parent.MinLength)
 	np.MaxLength = inheritIntPointer(np.MaxLength, parent.MaxLength)
 	if parent.Maximum != nil {
@@ -118,23 +215,46 @@ func (np *NamedParameter) inherit(parent NamedParameter, dicts map[string]interf
 	if parent.Minimum != nil {
 		np.Minimum = parent.Minimum
 	}
+	if parent.MultipleOf != nil {
+		np.MultipleOf = parent.MultipleOf
+	}
+	if np.Format == nil {
+		np.Format = parent.Format
+	}
+	if np.Items == nil {
+		np.Items = parent.Items
+	}
+	np.MinItems = inheritIntPointer(np.MinItems, parent.MinItems)
+	np.MaxItems = inheritIntPointer(np.MaxItems, parent.MaxItems)
+	if parent.UniqueItems != nil {
+		np.UniqueItems = parent.UniqueItems
+	}
+	if np.FileTypes == nil {
+		np.FileTypes = parent.FileTypes
+	}
 	if parent.Repeat != nil {
 		np.Repeat = parent.Repeat
 	}
-	if parent.Required {
-		np.Required = true
+	if !np.requiredSet {
+		np.Required, np.requiredSet = parent.Required, parent.requiredSet
 	}
+	if np.Example == nil {
+		np.Example = parent.Example
+		np.examplePositions = parent.examplePositions
+	}
+	return nil
 }
 
-func inheritStringPointer(val, parent *string, dicts map[string]interface{}) *string {
+func inheritStringPointer(val, parent *string, dicts map[string]interface{}) (*string, error) {
 	if parent == nil {
-		return val
+		return val, nil
 	}
 	if val == nil {
 		val = new(string)
 	}
-	*val = substituteParams(*val, *parent, dicts)
-	return val
+	var err error
+	*val, err = substituteParams(*val, *parent, dicts)
+	return val, err
 }
 
 func inheritIntPointer(val, parent *int) *int {
@@ -143,3 +263,64 @@ func inheritIntPointer(val, parent *int) *int {
 	}
 	return parent
 }
+
+// facets returns the facets of the parameter which restrict its type
+func (np NamedParameter) facets() Facets {
+	return Facets{
+		Pattern:     np.Pattern,
+		MinLength:   np.MinLength,
+		MaxLength:   np.MaxLength,
+		Minimum:     np.Minimum,
+		Maximum:     np.Maximum,
+		MultipleOf:  np.MultipleOf,
+		Format:      np.Format,
+		FileTypes:   np.FileTypes,
+		MinItems:    np.MinItems,
+		MaxItems:    np.MaxItems,
+		UniqueItems: np.UniqueItems,
+	}
+}
+
+// ResolveParam resolves the type of a query parameter, header or URI parameter.
+// The facets and the enum of the parameter are merged with the ones of its type.
+func (tr *TypeResolver) ResolveParam(np NamedParameter) (*ResolvedType, error) {
+	typ := np.Type
+	if typ == "" {
+		typ = "string"
+	}
+	base, err := tr.resolveExpr(tr.root, typ, np.Position)
+	if err != nil {
+		return nil, err
+	}
+	if np.Repeat != nil && *np.Repeat && base.Kind != KindArray {
+		base = &ResolvedType{
+			Kind:     KindArray,
+			Builtin:  "array",
+			Items:    base,
+			Position: np.Position,
+		}
+	}
+
+	rt := &ResolvedType{
+		Kind:     base.Kind,
+		Builtin:  base.Builtin,
+		Parents:  []*ResolvedType{base},
+		Items:    base.Items,
+		Members:  base.Members,
+		Facets:   mergeFacets(base.Facets, np.facets()),
+		Enum:     base.Enum,
+		Position: np.Position,
+	}
+	if np.Enum != nil {
+		rt.Enum = enumValues(np.Enum)
+	}
+
+	// an array parameter which declares the type of its items
+	if np.Items != nil && base.Kind == KindArray {
+		if rt.Items, err = tr.resolveTypeValue(tr.root, np.Items, np.Position); err != nil {
+			return nil, err
+		}
+		rt.Parents = nil
+	}
+	return rt, nil
+}
@@ -0,0 +1,62 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestShorthandParams(t *testing.T) {
+	Convey("named parameters declared with a type expression", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/shorthand_params.raml", apiDef)
+		So(err, ShouldBeNil)
+		So(Validate(apiDef), ShouldBeEmpty)
+
+		r := apiDef.Resources["/users/{id}"]
+		Convey("uri parameters", func() {
+			So(r.URIParameters["id"].Type, ShouldEqual, "integer")
+			So(r.URIParameters["id"].Required, ShouldBeTrue)
+			So(apiDef.BaseURIParameters["region"].Type, ShouldEqual, "string")
+		})
+
+		Convey("query parameters", func() {
+			params := r.Get.QueryParameters
+			So(params["name"].Type, ShouldEqual, "string")
+			So(params["name"].Required, ShouldBeTrue)
+			So(params["tags"].Type, ShouldEqual, "string[]")
+
+			// the parameter of a trait
+			So(params["page"].Type, ShouldEqual, "integer")
+			So(params["page"].Required, ShouldBeTrue)
+
+			// the parameter overrides whether the parameter of a trait is required
+			So(params["limit"].Type, ShouldEqual, "integer")
+			So(*params["limit"].Maximum, ShouldEqual, 100)
+			So(params["limit"].Required, ShouldBeFalse)
+
+			// the `?` suffix of an optional parameter is removed
+			So(params, ShouldNotContainKey, "sort?")
+			So(params["sort"].Type, ShouldEqual, "string")
+			So(params["sort"].Required, ShouldBeFalse)
+		})
+
+		Convey("headers", func() {
+			headers := r.Get.Headers
+			So(headers["X-Token"].Type, ShouldEqual, "string")
+			So(headers["X-Token"].Required, ShouldBeTrue)
+			So(headers, ShouldNotContainKey, HTTPHeader("X-Trace?"))
+			So(headers["X-Trace"].Required, ShouldBeFalse)
+			So(r.Get.Responses[200].Headers["X-Rate-Limit"].Type, ShouldEqual, "integer")
+		})
+
+		Convey("form parameters", func() {
+			params := r.Post.Bodies.ForMIMEType["application/x-www-form-urlencoded"].FormParameters
+			So(params["name"].Type, ShouldEqual, "string")
+			So(params["name"].Required, ShouldBeTrue)
+			So(params, ShouldNotContainKey, "nickname?")
+			So(*params["nickname"].MaxLength, ShouldEqual, 10)
+			So(params["nickname"].Required, ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,287 @@
+package raml
+
+// This file contains overlays and extensions support.
+// see https://github.com/raml-org/raml-spec/blob/master/versions/raml-10/raml-10.md#overlays-and-extensions
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+const (
+	fragmentOverlay   = "Overlay"
+	fragmentExtension = "Extension"
+)
+
+var (
+	// nodes that could be added or changed by an overlay.
+	// All other nodes of an overlay must have the same values as in the master RAML.
+	overlayAllowedNodes = map[string]bool{
+		"title":           true,
+		"description":     true,
+		"displayName":     true,
+		"documentation":   true,
+		"usage":           true,
+		"example":         true,
+		"examples":        true,
+		"annotationTypes": true,
+		"uses":            true,
+	}
+)
+
+// resolveExtends applies an overlay or extension to the RAML document
+// it extends, following the `extends` chain.
+// It returns the merged document.
+func (p *Parser) resolveExtends(filePath, kind string, doc *yaml.Node) (*yaml.Node, error) {
+	absPath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	tree, err := p.loadExtensionTree(filePath, kind, doc, map[string]bool{absPath: true})
+	if err != nil {
+		return nil, err
+	}
+	return &yaml.Node{
+		Kind:     yaml.DocumentNode,
+		File:     doc.File,
+		Line:     doc.Line,
+		Column:   doc.Column,
+		Children: []*yaml.Node{tree},
+	}, nil
+}
+
+// loadAPITree loads a RAML API definition, overlay or extension file
+// and returns the root mapping node.
+func (p *Parser) loadAPITree(filePath string, visited map[string]bool) (*yaml.Node, error) {
+	// libraries path of the tree are relative to its file, see rebaseUses
+	docParser := *p
+	docParser.baseDir = filepath.Dir(filePath)
+	kind, doc, err := docParser.loadDocument(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case "":
+		return rootMapping(filePath, doc)
+	case fragmentOverlay, fragmentExtension:
+		return p.loadExtensionTree(filePath, kind, doc, visited)
+	default:
+		return nil, newError(Position{File: filePath}, "can't extend a RAML %v", kind)
+	}
+}
+
+// loadExtensionTree loads the master RAML of an overlay/extension and merge it
+// with the overlay/extension
+func (p *Parser) loadExtensionTree(filePath, kind string, doc *yaml.Node, visited map[string]bool) (*yaml.Node, error) {
+	ext, err := rootMapping(filePath, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// find master file
+	extendsNode := mappingValue(ext, "extends")
+	if extendsNode == nil || extendsNode.Kind != yaml.ScalarNode || extendsNode.Value == "" {
+		return nil, newError(nodePosition(ext), "%v must have `extends` node", kind)
+	}
+	extends := strings.TrimSpace(extendsNode.Value)
+	masterPath := filepath.Join(filepath.Dir(filePath), extends)
+
+	absPath, err := filepath.Abs(masterPath)
+	if err != nil {
+		return nil, err
+	}
+	if visited[absPath] {
+		return nil, newError(nodePosition(extendsNode), "cyclic extends of %v", extends)
+	}
+	visited[absPath] = true
+
+	master, err := p.loadAPITree(masterPath, visited)
+	if err != nil {
+		return nil, err
+	}
+
+	// libraries path of the master are relative to the master file
+	rebaseUses(master, filepath.Dir(masterPath), filepath.Dir(filePath))
+
+	m := merger{
+		isOverlay: kind == fragmentOverlay,
+		errs:      &Error{},
+	}
+	m.mergeMap(master, ext, "", !m.isOverlay)
+	if err := m.errs.orNil(); err != nil {
+		return nil, err
+	}
+	return master, nil
+}
+
+// rootMapping returns the root mapping node of a document
+func rootMapping(filePath string, doc *yaml.Node) (*yaml.Node, error) {
+	if doc == nil || len(doc.Children) == 0 || doc.Children[0].Kind != yaml.MappingNode {
+		return nil, newError(Position{File: filePath}, "RAML document must be a mapping")
+	}
+	return doc.Children[0], nil
+}
+
+// rebaseUses changes the libraries path of a document
+// to be relative to the given directory
+func rebaseUses(tree *yaml.Node, fromDir, toDir string) {
+	uses := mappingValue(tree, "uses")
+	if uses == nil || uses.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 1; i < len(uses.Children); i += 2 {
+		lib := uses.Children[i]
+		if lib.Kind != yaml.ScalarNode || filepath.IsAbs(lib.Value) {
+			continue
+		}
+		if rel, err := filepath.Rel(toDir, filepath.Join(fromDir, lib.Value)); err == nil {
+			lib.Value = rel
+		}
+	}
+}
+
+// merger merges an overlay/extension tree into it's master tree
+// according to the RAML merging rules
+type merger struct {
+	isOverlay bool
+	errs      *Error
+}
+
+func (m *merger) errorf(n *yaml.Node, path, format string, args ...interface{}) {
+	if path == "" {
+		path = "/"
+	}
+	m.errs.add(nodePosition(n), "%v: %v", path, fmt.Sprintf(format, args...))
+}
+
+// mergeMap merges the extension object into the target object.
+// changeable is false if the target value must not be changed,
+// which is the case of behavioural nodes of an overlay
+func (m *merger) mergeMap(target, ext *yaml.Node, path string, changeable bool) {
+	for i := 0; i+1 < len(ext.Children); i += 2 {
+		keyNode, valNode := ext.Children[i], ext.Children[i+1]
+		key := keyNode.Value
+
+		// ignored properties
+		if path == "" && (key == "extends" || key == "usage") {
+			continue
+		}
+
+		childPath := path + "." + key
+		if path == "" || strings.HasPrefix(key, "/") {
+			childPath = path + key
+		}
+		childChangeable := changeable || overlayAllowedNodes[key] || isAnnotationKey(key)
+
+		tv := mappingValue(target, key)
+		if tv == nil { // doesn't exist in the target, add it
+			if !childChangeable {
+				m.errorf(keyNode, childPath, "overlay can't add new node")
+				continue
+			}
+			target.Children = append(target.Children, keyNode, valNode)
+			continue
+		}
+		m.mergeValue(tv, valNode, childPath, childChangeable)
+	}
+}
+
+// mergeValue merges the extension value into the target value
+func (m *merger) mergeValue(target, ext *yaml.Node, path string, changeable bool) {
+	if isNullNode(target) && ext.Kind != yaml.ScalarNode {
+		target.Kind = ext.Kind
+		target.Tag = ""
+		target.Value = ""
+	}
+
+	switch ext.Kind {
+	case yaml.MappingNode: // object property
+		if target.Kind != yaml.MappingNode {
+			m.errorf(ext, path, "conflicting node kinds, can't merge object into %v", target.Value)
+			return
+		}
+		m.mergeMap(target, ext, path, changeable)
+	case yaml.SequenceNode: // array property
+		if target.Kind != yaml.SequenceNode {
+			m.errorf(ext, path, "conflicting node kinds, can't merge array into %v", target.Value)
+			return
+		}
+		for _, elem := range ext.Children {
+			if containsNode(target.Children, elem) {
+				continue
+			}
+			if !changeable {
+				m.errorf(elem, path, "overlay can't add %v", elem.Value)
+				continue
+			}
+			target.Children = append(target.Children, elem)
+		}
+	default: // single value property
+		if target.Kind != yaml.ScalarNode {
+			m.errorf(ext, path, "conflicting node kinds, can't replace the node with %v", ext.Value)
+			return
+		}
+		if nodesEqual(target, ext) {
+			return
+		}
+		if !changeable {
+			m.errorf(ext, path, "overlay can't change value %v to %v", target.Value, ext.Value)
+			return
+		}
+		*target = *ext
+	}
+}
+
+// returns value node of a key in a mapping node
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Children); i += 2 {
+		if n.Children[i].Value == key {
+			return n.Children[i+1]
+		}
+	}
+	return nil
+}
+
+// isNullNode returns true if the node is a null value
+func isNullNode(n *yaml.Node) bool {
+	if n.Kind != yaml.ScalarNode || !n.Implicit {
+		return false
+	}
+	switch n.Value {
+	case "", "~", "null", "Null", "NULL":
+		return true
+	}
+	return false
+}
+
+// nodesEqual returns true if both nodes have the same value,
+// regardless of their position
+func nodesEqual(a, b *yaml.Node) bool {
+	if a.Kind != b.Kind || a.Value != b.Value || a.Tag != b.Tag || len(a.Children) != len(b.Children) {
+		return false
+	}
+	for i := range a.Children {
+		if !nodesEqual(a.Children[i], b.Children[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// check if a list of nodes contains the given node
+func containsNode(nodes []*yaml.Node, n *yaml.Node) bool {
+	for _, c := range nodes {
+		if nodesEqual(c, n) {
+			return true
+		}
+	}
+	return false
+}
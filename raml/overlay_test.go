@@ -0,0 +1,74 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestOverlaysAndExtensions(t *testing.T) {
+	Convey("Overlays and extensions", t, func() {
+		apiDef := new(APIDefinition)
+
+		Convey("extension", func() {
+			err := ParseFile("./samples/overlay/acme.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// single value property replaced, array property merged
+			So(apiDef.Title, ShouldEqual, "Books API")
+			So(apiDef.Version, ShouldEqual, "v1-acme")
+			So(apiDef.Protocols, ShouldResemble, []string{"HTTP", "HTTPS"})
+
+			// object property merged
+			So(apiDef.Types["Book"].Properties, ShouldContainKey, "title")
+			So(apiDef.Types["Book"].Properties, ShouldContainKey, "isbn")
+
+			// master library is relative to the master file
+			So(apiDef.Libraries, ShouldContainKey, "lib")
+			So(apiDef.Libraries["lib"].Types, ShouldContainKey, "Author")
+
+			// resources
+			So(apiDef.Resources, ShouldContainKey, "/authors")
+			books := apiDef.Resources["/books"]
+			So(books.Description, ShouldEqual, "All books")
+			So(books.Get, ShouldNotBeNil)
+			So(books.Post, ShouldNotBeNil)
+			So(books.Post.Description, ShouldEqual, "Add a book")
+		})
+
+		Convey("overlay of an extension", func() {
+			err := ParseFile("./samples/overlay/acme_fr.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			So(apiDef.Title, ShouldEqual, "API des livres")
+			So(apiDef.Version, ShouldEqual, "v1-acme")
+			So(len(apiDef.Documentation), ShouldEqual, 1)
+			So(apiDef.Documentation[0].Content, ShouldEqual, "Bienvenue")
+
+			books := apiDef.Resources["/books"]
+			So(books.Description, ShouldEqual, "Tous les livres")
+			So(books.Get.Description, ShouldEqual, "Liste des livres")
+			So(books.Post.Description, ShouldEqual, "Ajouter un livre")
+			So(apiDef.Resources, ShouldContainKey, "/authors")
+		})
+
+		Convey("overlay can't change behaviour", func() {
+			err := ParseFile("./samples/overlay/bad_overlay.raml", apiDef)
+			So(err, ShouldNotBeNil)
+
+			ramlErr, ok := err.(*Error)
+			So(ok, ShouldBeTrue)
+			So(len(ramlErr.Errors), ShouldEqual, 3)
+			So(ramlErr.Errors[0].Position, ShouldResemble, Position{File: "./samples/overlay/bad_overlay.raml", Line: 3, Column: 10})
+			So(ramlErr.Errors[0].Message, ShouldEqual, "version: overlay can't change value v1 to v2")
+			So(err.Error(), ShouldContainSubstring, "bad_overlay.raml:7:7: types.Book.properties.isbn: overlay can't add new node")
+			So(err.Error(), ShouldContainSubstring, "bad_overlay.raml:10:3: /books.delete: overlay can't add new node")
+		})
+
+		Convey("cyclic extends", func() {
+			err := ParseFile("./samples/overlay/cyclic_a.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "cyclic extends")
+		})
+	})
+}
@@ -0,0 +1,327 @@
+package raml
+
+// This file contains the substitution of the parameters of
+// the traits and resource types. The parameters are substituted in
+// the YAML node tree of a declaration before it is applied, which
+// includes the keys, the examples, the enum values and the response codes.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+var (
+	// number and boolean facets, which can't be decoded
+	// when their value is a parameter
+	paramFacets = map[string]bool{
+		"required":             true,
+		"minLength":            true,
+		"maxLength":            true,
+		"minimum":              true,
+		"maximum":              true,
+		"multipleOf":           true,
+		"minItems":             true,
+		"maxItems":             true,
+		"uniqueItems":          true,
+		"minProperties":        true,
+		"maxProperties":        true,
+		"additionalProperties": true,
+	}
+)
+
+// declNodes are the nodes of the trait and
+// resource type declarations of a document
+type declNodes struct {
+	traits        map[string]*yaml.Node
+	resourceTypes map[string]*yaml.Node
+}
+
+// takeDeclNodes returns the nodes of the trait and resource type
+// declarations of a document.
+// The values of the declarations which can't be decoded before the parameters
+// are substituted are removed from the document, they only exist once
+// the declaration is applied.
+func takeDeclNodes(doc *yaml.Node) declNodes {
+	decls := declNodes{
+		traits:        map[string]*yaml.Node{},
+		resourceTypes: map[string]*yaml.Node{},
+	}
+	if doc == nil || len(doc.Children) == 0 {
+		return decls
+	}
+	forEachMapping08(doc.Children[0], func(key string, val *yaml.Node) {
+		var nodes map[string]*yaml.Node
+		switch key {
+		case "traits":
+			nodes = decls.traits
+		case "resourceTypes":
+			nodes = decls.resourceTypes
+		default:
+			return
+		}
+		forEachMapping08(val, func(name string, decl *yaml.Node) {
+			nodes[name] = cloneNode(decl)
+			removeParamValues(decl, true)
+		})
+	})
+	return decls
+}
+
+// setDeclNodes sets the declaration nodes of the traits and resource types of a document
+func setDeclNodes(root Root, decls declNodes) {
+	var traits map[string]Trait
+	var resourceTypes map[string]ResourceType
+	switch r := root.(type) {
+	case *APIDefinition:
+		traits, resourceTypes = r.Traits, r.ResourceTypes
+	case *Library:
+		traits, resourceTypes = r.Traits, r.ResourceTypes
+	default:
+		return
+	}
+	for name, t := range traits {
+		t.node = decls.traits[name]
+		traits[name] = t
+	}
+	for name, rt := range resourceTypes {
+		rt.node = decls.resourceTypes[name]
+		resourceTypes[name] = rt
+	}
+}
+
+// removeParamValues removes the values of a declaration which
+// can't be decoded before the parameters are substituted:
+// the responses whose code is a parameter and the number
+// and boolean facets whose value is a parameter.
+// The parameters of the applied traits and resource types are kept.
+func removeParamValues(n *yaml.Node, decl bool) {
+	switch n.Kind {
+	case yaml.MappingNode:
+		var children []*yaml.Node
+		for i := 0; i+1 < len(n.Children); i += 2 {
+			key, val := n.Children[i], n.Children[i+1]
+			if key.Value == "is" || (decl && key.Value == "type") {
+				children = append(children, key, val)
+				continue
+			}
+			if paramFacets[key.Value] && val.Kind == yaml.ScalarNode && strings.Contains(val.Value, "<<") {
+				continue
+			}
+			if strings.TrimSuffix(key.Value, "?") == "responses" && val.Kind == yaml.MappingNode {
+				var responses []*yaml.Node
+				for j := 0; j+1 < len(val.Children); j += 2 {
+					if !strings.Contains(val.Children[j].Value, "<<") {
+						responses = append(responses, val.Children[j], val.Children[j+1])
+					}
+				}
+				val.Children = responses
+			}
+			removeParamValues(val, false)
+			children = append(children, key, val)
+		}
+		n.Children = children
+	case yaml.SequenceNode:
+		for _, c := range n.Children {
+			removeParamValues(c, false)
+		}
+	}
+}
+
+// cloneNode returns a deep copy of a node tree
+func cloneNode(n *yaml.Node) *yaml.Node {
+	clone := *n
+	clone.Children = nil
+	for _, c := range n.Children {
+		clone.Children = append(clone.Children, cloneNode(c))
+	}
+	return &clone
+}
+
+// substituteDecl returns the node of a trait or resource type declaration
+// in which the parameters are substituted.
+// reserved are the reserved parameters, e.g. `resourcePathName`.
+// All parameters must be used by the declaration.
+func substituteDecl(n *yaml.Node, params DefinitionParameters, reserved map[string]interface{}) (*yaml.Node, error) {
+	ps := &paramSubstituter{
+		dicts: map[string]interface{}{},
+		used:  map[string]bool{},
+	}
+	for name, val := range params {
+		ps.dicts[name] = val
+	}
+	for name, val := range reserved {
+		ps.dicts[name] = val
+	}
+
+	substituted, err := ps.substitute(n, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var unused []string
+	for name := range params {
+		if _, ok := reserved[name]; !ok && !ps.used[name] {
+			unused = append(unused, name)
+		}
+	}
+	if len(unused) > 0 {
+		sort.Strings(unused)
+		return nil, fmt.Errorf("unused parameter <<%v>>", strings.Join(unused, ">>, <<"))
+	}
+	return substituted, nil
+}
+
+// paramSubstituter substitutes the parameters in a node tree
+type paramSubstituter struct {
+	dicts map[string]interface{} // values of the parameters
+	used  map[string]bool        // used parameters
+}
+
+// substitute returns a copy of a node tree in which the parameters are substituted.
+// path is the keys of the node, used in the error messages.
+func (ps *paramSubstituter) substitute(n *yaml.Node, path []string) (*yaml.Node, error) {
+	substituted := *n
+	substituted.Children = nil
+
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if !strings.Contains(n.Value, "<<") {
+			return &substituted, nil
+		}
+
+		// a parameter whose value isn't a scalar, e.g. the values of an enum
+		if val, ok := ps.paramValue(n.Value); ok {
+			switch val.(type) {
+			case []interface{}, map[interface{}]interface{}:
+				return valueNode(val, n), nil
+			}
+		}
+
+		for _, match := range dcRe.FindAllStringSubmatch(n.Value, -1) {
+			ps.used[paramName(match[1])] = true
+		}
+		value, err := substituteParams("", n.Value, ps.dicts)
+		if err != nil {
+			if context := describeNodePath(path); context != "" {
+				return nil, fmt.Errorf("%v: %v", context, err)
+			}
+			return nil, err
+		}
+		substituted.Value = value
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Children); i += 2 {
+			key, err := ps.substitute(n.Children[i], append(path[:len(path):len(path)], n.Children[i].Value))
+			if err != nil {
+				return nil, err
+			}
+			val, err := ps.substitute(n.Children[i+1], append(path[:len(path):len(path)], key.Value))
+			if err != nil {
+				return nil, err
+			}
+			substituted.Children = append(substituted.Children, key, val)
+		}
+	default:
+		for _, c := range n.Children {
+			sc, err := ps.substitute(c, path)
+			if err != nil {
+				return nil, err
+			}
+			substituted.Children = append(substituted.Children, sc)
+		}
+	}
+	return &substituted, nil
+}
+
+// paramValue returns the value of the parameter of a scalar
+// which only consists of the parameter, e.g. `<<values>>`
+func (ps *paramSubstituter) paramValue(scalar string) (interface{}, bool) {
+	scalar = strings.TrimSpace(scalar)
+	match := dcRe.FindStringSubmatch(scalar)
+	if match == nil || match[0] != scalar || strings.Contains(match[1], "|") {
+		return nil, false
+	}
+	name := paramName(match[1])
+	val, ok := ps.dicts[name]
+	if ok {
+		ps.used[name] = true
+	}
+	return val, ok
+}
+
+// paramName returns the name of a parameter without its inflector,
+// e.g. `item` for `item | !pluralize`
+func paramName(param string) string {
+	return strings.TrimSpace(strings.SplitN(param, "|", 2)[0])
+}
+
+// valueNode creates the node of a parameter value, at the position of node n
+func valueNode(val interface{}, n *yaml.Node) *yaml.Node {
+	vn := &yaml.Node{File: n.File, Line: n.Line, Column: n.Column}
+	switch v := val.(type) {
+	case []interface{}:
+		vn.Kind = yaml.SequenceNode
+		for _, item := range v {
+			vn.Children = append(vn.Children, valueNode(item, n))
+		}
+	case map[interface{}]interface{}:
+		vn.Kind = yaml.MappingNode
+		var keys []string
+		values := map[string]interface{}{}
+		for k, item := range v {
+			key := fmt.Sprintf("%v", k)
+			keys = append(keys, key)
+			values[key] = item
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			vn.Children = append(vn.Children, valueNode(key, n), valueNode(values[key], n))
+		}
+	default:
+		vn.Kind = yaml.ScalarNode
+		vn.Implicit = true
+		if val != nil {
+			vn.Value = fmt.Sprintf("%v", val)
+		}
+	}
+	return vn
+}
+
+// describeNodePath describes the path of a node in a trait or a resource type
+// for the error messages, e.g. `GET: query parameter limit`.
+func describeNodePath(path []string) string {
+	var descs []string
+	for i := 0; i < len(path); i++ {
+		key := strings.TrimSuffix(path[i], "?")
+		var name string
+		if i+1 < len(path) {
+			name = path[i+1]
+		}
+
+		switch {
+		case methodKeys[key]:
+			descs = append(descs, strings.ToUpper(key))
+			continue
+		case name == "":
+			continue
+		case key == "queryParameters":
+			descs = append(descs, "query parameter "+name)
+		case key == "headers":
+			descs = append(descs, "header "+name)
+		case key == "uriParameters":
+			descs = append(descs, "uri parameter "+name)
+		case key == "baseUriParameters":
+			descs = append(descs, "base uri parameter "+name)
+		case key == "responses":
+			descs = append(descs, "response "+name)
+		case key == "properties":
+			descs = append(descs, "property "+name)
+		default:
+			continue
+		}
+		i++
+	}
+	return strings.Join(descs, ": ")
+}
@@ -30,223 +30,320 @@ package raml
 // This file contains all of the RAML parser related code.
 
 import (
-	"bufio"
 	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"path/filepath"
-	"reflect"
 	"strings"
 
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
 	log "github.com/Sirupsen/logrus"
-	"github.com/gigforks/yaml"
-	"github.com/kr/pretty"
 )
 
-var (
-	// directory of raml file
-	// we need to save it as global variable because
-	// library inside library file path is not relative to
-	// the library that include it.
-	// But relative to RAML file
-	ramlFileDir string
-)
+// IncludeResolver returns path of the file included by an `!include` directive.
+// includingFile is the path of the file which contains the directive.
+type IncludeResolver func(includingFile, includedFile string) (string, error)
+
+// DefaultIncludeResolver resolves included file relative to the including file.
+func DefaultIncludeResolver(includingFile, includedFile string) (string, error) {
+	return filepath.Join(filepath.Dir(includingFile), includedFile), nil
+}
+
+// ParserOptions are the options of a RAML parser
+type ParserOptions struct {
+	// BaseDir is the directory to which the libraries (`uses`) paths are relative.
+	// Default to the directory of the parsed file.
+	// The libraries used by a library are relative to the library file.
+	BaseDir string
+
+	// IncludeResolver resolves the path of `!include` directive.
+	// Default to DefaultIncludeResolver.
+	IncludeResolver IncludeResolver
+
+	// FileLoader loads the RAML file, the included files and the libraries.
+	// Default to OSFileLoader.
+	FileLoader FileLoader
+
+	// Logger used by the parser.
+	// Default to logrus standard logger.
+	Logger *log.Logger
+}
+
+// Parser is a RAML parser.
+// A Parser doesn't keep state of the parsed documents,
+// it is safe to parse many documents concurrently with the same parser.
+type Parser struct {
+	baseDir         string
+	includeResolver IncludeResolver
+	fileLoader      FileLoader
+	logger          *log.Logger
+}
+
+// NewParser creates a new RAML parser
+func NewParser(opts ParserOptions) *Parser {
+	p := &Parser{
+		baseDir:         opts.BaseDir,
+		includeResolver: opts.IncludeResolver,
+		fileLoader:      opts.FileLoader,
+		logger:          opts.Logger,
+	}
+	if p.includeResolver == nil {
+		p.includeResolver = DefaultIncludeResolver
+	}
+	if p.fileLoader == nil {
+		p.fileLoader = OSFileLoader{}
+	}
+	if p.logger == nil {
+		p.logger = log.StandardLogger()
+	}
+	return p
+}
 
 // ParseFile parses an RAML file.
 // Returns a raml.APIDefinition value or an error if
 // something went wrong.
 func ParseFile(filePath string, root Root) error {
-	_, err := ParseReadFile(filePath, root)
-	return err
+	return NewParser(ParserOptions{}).ParseFile(filePath, root)
 }
 
 // ParseReadFile parse an .raml file.
 // It returns API definition and the concatenated .raml file.
 func ParseReadFile(filePath string, root Root) ([]byte, error) {
+	return NewParser(ParserOptions{}).ParseReadFile(filePath, root)
+}
 
-	// Get the working directory
-	workingDirectory, fileName := filepath.Split(filePath)
-	if strings.HasSuffix(fmt.Sprint(reflect.TypeOf(root)), "APIDefinition") { // when we parse for APIDefinition, we reset ramlFileDir
-		ramlFileDir = workingDirectory
-	}
+// ParseFile parses an RAML file.
+func (p *Parser) ParseFile(filePath string, root Root) error {
+	_, err := p.ParseReadFile(filePath, root)
+	return err
+}
 
-	// Read original file contents into a byte array
-	mainFileBytes, err := readFileContents(workingDirectory, fileName)
+// ParseReadFile parse an .raml file.
+// It returns API definition and the concatenated .raml file.
+func (p *Parser) ParseReadFile(filePath string, root Root) ([]byte, error) {
+	// libraries of this document and of the libraries it uses
+	// are relative to the directory of this document
+	docParser := *p
+	if docParser.baseDir == "" {
+		docParser.baseDir = filepath.Dir(filePath)
+	}
+	return docParser.parseReadFile(filePath, root)
+}
 
+// Parse parses a RAML document read from r.
+// filePath is the path of the document, it is used to resolve
+// the included files and the libraries, which are loaded by the parser file loader.
+func (p *Parser) Parse(r io.Reader, filePath string, root Root) error {
+	contents, err := ioutil.ReadAll(r)
 	if err != nil {
-		return []byte{}, err
+		return err
 	}
 
-	// Get the contents of the main file
-	mainFileBuffer := bytes.NewBuffer(mainFileBytes)
+	docParser := *p
+	docParser.fileLoader = readerFileLoader{
+		path:    filePath,
+		content: contents,
+		loader:  p.fileLoader,
+	}
+	return docParser.ParseFile(filePath, root)
+}
 
-	// Verify the YAML version
-	var ramlVersion string
-	firstLine, err := mainFileBuffer.ReadString('\n')
+func (p *Parser) parseReadFile(filePath string, root Root) ([]byte, error) {
+	// Read the file and resolve the !include directives
+	kind, doc, err := p.loadDocument(filePath)
 	if err != nil {
-		return []byte{}, fmt.Errorf("Problem reading RAML file (Error: %s)", err.Error())
+		return []byte{}, err
 	}
 
-	// We read some data...
-	if len(firstLine) >= 10 {
-		ramlVersion = firstLine[:10]
-	}
-	if ramlVersion != "#%RAML 1.0" {
-		return []byte{}, errors.New("Input file is not a RAML 1.0 file. Make " +
-			"sure the file starts with #%RAML 1.0")
+	if err := checkRootKind(root, kind, filePath); err != nil {
+		return []byte{}, err
 	}
 
-	// Pre-process the original file, following !include directive
-	preprocessedContentsBytes, err :=
-		preProcess(mainFileBuffer, workingDirectory)
+	switch kind {
+	case fragmentOverlay, fragmentExtension:
+		// Merge the overlay/extension with the API it extends
+		if _, ok := root.(*APIDefinition); !ok {
+			return []byte{}, fmt.Errorf("%v file %v can only be parsed as API definition", kind, filePath)
+		}
+		if doc, err = p.resolveExtends(filePath, kind, doc); err != nil {
+			return []byte{}, err
+		}
+	}
 
+	// The concatenated document
+	var tree yaml.MapSlice
+	if err := yaml.UnmarshalNode(doc, &tree); err != nil {
+		return []byte{}, newParseError(filePath, err)
+	}
+	contents, err := yaml.Marshal(tree)
 	if err != nil {
-		return []byte{},
-			fmt.Errorf("Error preprocessing RAML file (Error: %s)", err.Error())
+		return []byte{}, err
 	}
 
-	if log.GetLevel() == log.DebugLevel {
-		pretty.Println(string(preprocessedContentsBytes))
-	}
+	p.logger.Debugf("RAML document %v:\n%s", filePath, contents)
 
-	// Unmarshal into an APIDefinition value
-	//apiDefinition := new(APIDefinition)
-	//apiDefinition.RAMLVersion = ramlVersion
+	// the parameters of the traits and resource types
+	// are substituted when they are applied
+	decls := takeDeclNodes(doc)
 
 	// Go!
-	err = yaml.Unmarshal(preprocessedContentsBytes, root)
-
-	// Any errors?
-	if err != nil {
-
-		// Create a RAML error value
-		ramlError := new(Error)
-
-		// Copy the YAML errors into it..
-		if yamlErrors, ok := err.(*yaml.TypeError); ok {
-			populateRAMLError(ramlError, yamlErrors)
-		} else {
-			// Or just any other error, though this shouldn't happen.
-			ramlError.Errors = append(ramlError.Errors, err.Error())
-		}
+	if err := yaml.UnmarshalNode(doc, root); err != nil {
+		return []byte{}, newParseError(filePath, err)
+	}
+	setDeclNodes(root, decls)
 
-		return []byte{}, ramlError
+	// libraries
+	if err := p.loadLibraries(root, filePath); err != nil {
+		return contents, err
 	}
 
 	if err := root.PostProcess(filePath); err != nil {
-		return preprocessedContentsBytes, err
+		return contents, err
 	}
 
 	// Good.
-	return preprocessedContentsBytes, nil
+	return contents, nil
 }
 
-// Reads the contents of a file, returns a bytes buffer
-func readFileContents(workingDirectory string, fileName string) ([]byte, error) {
-
-	filePath := filepath.Join(workingDirectory, fileName)
-
-	if fileName == "" {
-		return nil, fmt.Errorf("File name cannot be nil: %s", filePath)
+// loadLibraries parses all libraries used by a document.
+// The libraries used by a library are relative to the library file,
+// the others are relative to the base directory.
+func (p *Parser) loadLibraries(root Root, filePath string) error {
+	var uses map[string]string
+	var libraries map[string]*Library
+	var pos Position
+	dir := p.baseDir
+
+	switch r := root.(type) {
+	case *APIDefinition:
+		r.Libraries = map[string]*Library{}
+		uses, libraries, pos = r.Uses, r.Libraries, r.Position
+	case *Library:
+		r.Libraries = map[string]*Library{}
+		uses, libraries, pos = r.Uses, r.Libraries, r.Position
+		dir = filepath.Dir(filePath)
+	case *Fragment:
+		r.Libraries = map[string]*Library{}
+		uses, libraries, pos = r.Uses, r.Libraries, r.Position
+	default:
+		return nil
 	}
 
-	// Read the file
-	fileContentsArray, err := ioutil.ReadFile(filePath)
-	if err != nil {
-		return nil,
-			fmt.Errorf("Could not read file %s (Error: %s)",
-				filePath, err.Error())
+	for name, path := range uses {
+		libPath := filepath.Join(dir, path)
+		lib := &Library{Filename: path}
+		if dir != p.baseDir {
+			if rel, err := filepath.Rel(p.baseDir, libPath); err == nil {
+				lib.Filename = rel
+			}
+		}
+		if _, err := p.parseReadFile(libPath, lib); err != nil {
+			if ramlErr, ok := err.(*Error); ok {
+				return ramlErr
+			}
+			return newError(pos, "failed to parse library name=%v, path=%v: %v", name, path, err)
+		}
+		libraries[name] = lib
 	}
-
-	return fileContentsArray, nil
+	return nil
 }
 
-// preProcess acts as a preprocessor for a RAML document in YAML format,
-// including files referenced via !include. It returns a pre-processed document.
-func preProcess(originalContents io.Reader, workingDirectory string) ([]byte, error) {
-
-	// NOTE: Since YAML doesn't support !include directives, and since go-yaml
-	// does NOT play nice with !include tags, this has to be done like this.
-	// I am considering modifying go-yaml to add custom handlers for specific
-	// tags, to add support for !include, but for now - this method is
-	// GoodEnough(TM) and since it will only happen once, I am not prematurely
-	// optimizing it.
-
-	var preprocessedContents bytes.Buffer
-
-	// Go over each line, looking for !include tags
-	scanner := bufio.NewScanner(originalContents)
-	var line string
-
-	// Scan the file until we reach EOF or error out
-	for scanner.Scan() {
-		line = scanner.Text()
-
-		// Did we find an !include directive to handle?
-		if idx := strings.Index(line, "!include"); idx != -1 {
-
-			// TODO: Do this better
-			includeLength := len("!include ")
-
-			includedFile := line[idx+includeLength:]
-
-			preprocessedContents.Write([]byte(line[:idx]))
-
-			// Get the included file contents
-			includedContents, err :=
-				readFileContents(workingDirectory, includedFile)
-
-			if err != nil {
-				return nil,
-					fmt.Errorf("Error including file %s:\n    %s",
-						includedFile, err.Error())
-			}
-
-			// add newline to included content
-			includedContents = append([]byte("\n"), includedContents...)
-
-			// TODO: Check that you only insert .yaml, .raml, .txt and .md files
-			// In case of .raml or .yaml, remove the comments
-			// In case of other files, Base64 them first.
-
-			// TODO: Better, step by step checks .. though prolly it'll panic
-			// Write text files in the same indentation as the first line
-			internalScanner :=
-				bufio.NewScanner(bytes.NewBuffer(includedContents))
-
-			// Indent by this much
-			firstLine := true
-			indentationString := ""
+// loadDocument reads a RAML file into a YAML node tree,
+// all `!include` in the tree are resolved.
+// It returns the document kind and the node tree.
+func (p *Parser) loadDocument(filePath string) (string, *yaml.Node, error) {
+	// Read original file contents into a byte array
+	mainFileBytes, err := p.readFile(filePath)
+	if err != nil {
+		return "", nil, err
+	}
 
-			// Go over each line, write it
-			for internalScanner.Scan() {
-				internalLine := internalScanner.Text()
+	// Verify the YAML version
+	var ramlVersion string
+	firstLine, err := bytes.NewBuffer(mainFileBytes).ReadString('\n')
+	if err != nil {
+		return "", nil, fmt.Errorf("Problem reading RAML file (Error: %s)", err.Error())
+	}
 
-				preprocessedContents.WriteString(indentationString)
-				if firstLine {
-					indentationString = strings.Repeat(" ", idx)
-					firstLine = false
-				}
+	// We read some data...
+	if len(firstLine) >= 10 {
+		ramlVersion = firstLine[:10]
+	}
+	isRAML08 := ramlVersion == raml08VersionHeader
+	if ramlVersion != ramlVersionHeader && !isRAML08 {
+		return "", nil, errors.New("Input file is not a RAML 1.0 or 0.8 file. Make " +
+			"sure the file starts with #%RAML 1.0")
+	}
+	kind := fragmentKind(firstLine)
+	if isRAML08 {
+		kind = "" // RAML 0.8 has no fragments
+	}
+	if kind != "" && !fragmentKinds[kind] {
+		return "", nil, fmt.Errorf("%v: unknown RAML fragment %v", filePath, kind)
+	}
 
-				preprocessedContents.WriteString(internalLine)
-				preprocessedContents.WriteByte('\n')
-			}
+	doc, err := yaml.Parse(mainFileBytes)
+	if err != nil {
+		return "", nil, newParseError(filePath, err)
+	}
+	setNodeFile(doc, filePath)
 
-		} else {
+	// Follow the !include directives,
+	// the libraries used by the included fragments are used by the document
+	usesDir := p.baseDir
+	if kind == fragmentLibrary {
+		usesDir = filepath.Dir(filePath)
+	}
+	var docUses *yaml.Node
+	if doc != nil && len(doc.Children) > 0 && doc.Children[0].Kind == yaml.MappingNode {
+		docUses = mappingValue(doc.Children[0], "uses")
+	}
+	uses := newFragmentUses(usesDir, docUses)
+	location := includeLocation(kind)
+	if isRAML08 {
+		location = ""
+	}
+	if err := p.resolveIncludes(doc, []string{filepath.Clean(filePath)}, location, uses); err != nil {
+		return "", nil, err
+	}
+	uses.addTo(doc, filePath)
 
-			// No, just a simple line.. write it
-			preprocessedContents.WriteString(line)
-			preprocessedContents.WriteByte('\n')
+	// a RAML 0.8 document is parsed as the equivalent RAML 1.0 document
+	if isRAML08 {
+		if err := upgradeRAML08(doc); err != nil {
+			return "", nil, err
 		}
 	}
+	return kind, doc, nil
+}
 
-	// Any errors encountered?
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("Error reading YAML file: %s", err.Error())
+// newParseError creates RAML error from YAML parser error
+func newParseError(filePath string, err error) error {
+	// Create a RAML error value
+	ramlError := new(Error)
+
+	// Copy the YAML errors into it..
+	switch yamlErr := err.(type) {
+	case *yaml.TypeError:
+		populateRAMLError(ramlError, yamlErr)
+	case *yaml.SyntaxError:
+		ramlError.add(Position{File: filePath, Line: yamlErr.Line, Column: yamlErr.Column},
+			"YAML syntax error, %s", yamlErr.Problem)
+	default:
+		// Or just any other error, though this shouldn't happen.
+		ramlError.add(Position{File: filePath}, "%s", err.Error())
 	}
+	return ramlError
+}
+
+// ramlVersionHeader is the header of a RAML 1.0 document
+const ramlVersionHeader = "#%RAML 1.0"
 
-	// Return the preprocessed contents
-	return preprocessedContents.Bytes(), nil
+// fragmentKind returns kind of a RAML document from it's header line,
+// e.g. "Overlay" for "#%RAML 1.0 Overlay".
+// It returns empty string for a RAML API definition.
+func fragmentKind(firstLine string) string {
+	return strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(firstLine), "#%RAML 1.0"))
 }
@@ -0,0 +1,72 @@
+package raml
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestParser(t *testing.T) {
+	Convey("Parser", t, func() {
+		Convey("concurrent parsing of documents in different directories", func() {
+			files := []string{
+				"./samples/simple_with_lib.raml",
+				"./samples/overlay/base/api.raml",
+			}
+			errs := make([]error, 10)
+			apiDefs := make([]*APIDefinition, 10)
+
+			p := NewParser(ParserOptions{})
+			var wg sync.WaitGroup
+			for i := range errs {
+				wg.Add(1)
+				go func(i int) {
+					defer wg.Done()
+					apiDefs[i] = new(APIDefinition)
+					errs[i] = p.ParseFile(files[i%2], apiDefs[i])
+				}(i)
+			}
+			wg.Wait()
+
+			for i, err := range errs {
+				So(err, ShouldBeNil)
+				if i%2 == 0 {
+					So(apiDefs[i].Libraries, ShouldContainKey, "files")
+				} else {
+					So(apiDefs[i].Libraries, ShouldContainKey, "lib")
+				}
+			}
+		})
+
+		Convey("base dir", func() {
+			apiDef := new(APIDefinition)
+			p := NewParser(ParserOptions{BaseDir: "./samples/overlay/base"})
+			err := p.ParseFile("./samples/overlay/base/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(apiDef.Libraries, ShouldContainKey, "lib")
+
+			// libraries path are relative to the base dir
+			p = NewParser(ParserOptions{BaseDir: "./samples"})
+			err = p.ParseFile("./samples/overlay/base/api.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "samples/lib.raml")
+		})
+
+		Convey("include resolver", func() {
+			var included []string
+			resolver := func(includingFile, includedFile string) (string, error) {
+				included = append(included, includedFile)
+				return filepath.Join("./samples/include/types", filepath.Base(includedFile)), nil
+			}
+
+			apiDef := new(APIDefinition)
+			p := NewParser(ParserOptions{IncludeResolver: resolver})
+			err := p.ParseFile("./samples/positions/type_error.raml", apiDef)
+			So(err, ShouldNotBeNil)
+			So(included, ShouldResemble, []string{"name.raml"})
+			So(err.Error(), ShouldContainSubstring, "samples/include/types/name.raml")
+		})
+	})
+}
@@ -0,0 +1,288 @@
+package raml
+
+// This file contains the RAML 0.8 front end.
+// A RAML 0.8 document is converted into a RAML 1.0 document
+// before being parsed, the conversion is done on the YAML node tree:
+// - `schemas` is converted into `types`, the `schema` of a body into `type`
+// - the sequences of traits, resource types and security schemes into maps
+// - the form parameters of a body into the properties of the body type
+// - the named parameters into type declarations: the default of `required`
+//   is made explicit, `date` is converted into `datetime`,
+//   a parameter with multiple types into an union and
+//   a repeated parameter into an array
+
+import (
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+const (
+	raml08VersionHeader = "#%RAML 0.8"
+)
+
+var (
+	// facets of a repeated named parameter which apply to its items
+	raml08ItemFacets = map[string]bool{
+		"type":      true,
+		"enum":      true,
+		"pattern":   true,
+		"minLength": true,
+		"maxLength": true,
+		"minimum":   true,
+		"maximum":   true,
+		"format":    true,
+	}
+)
+
+// upgradeRAML08 converts the tree of a RAML 0.8 API definition
+// into the tree of a RAML 1.0 API definition.
+func upgradeRAML08(doc *yaml.Node) error {
+	if doc == nil || len(doc.Children) == 0 {
+		return nil
+	}
+	root := doc.Children[0]
+	if root.Kind != yaml.MappingNode {
+		return newError(nodePosition(root), "RAML document must be a mapping")
+	}
+
+	for i := 0; i+1 < len(root.Children); i += 2 {
+		key, val := root.Children[i], root.Children[i+1]
+		switch {
+		case key.Value == "schemas":
+			key.Value = "types"
+			if err := upgradeDeclarations(val, nil); err != nil {
+				return err
+			}
+		case key.Value == "traits":
+			if err := upgradeDeclarations(val, upgradeMethod08); err != nil {
+				return err
+			}
+		case key.Value == "resourceTypes":
+			if err := upgradeDeclarations(val, upgradeResource08); err != nil {
+				return err
+			}
+		case key.Value == "securitySchemes":
+			if err := upgradeDeclarations(val, upgradeSecurityScheme08); err != nil {
+				return err
+			}
+		case key.Value == "baseUriParameters":
+			upgradeNamedParams08(val, true)
+		case strings.HasPrefix(key.Value, "/"):
+			upgradeResource08(val)
+		}
+	}
+	return nil
+}
+
+// upgradeDeclarations converts a sequence of declarations,
+// e.g. `[{paged: {...}}, {secured: {...}}]`, into a map of declarations
+// and converts each declaration with the given function.
+func upgradeDeclarations(n *yaml.Node, upgrade func(*yaml.Node)) error {
+	if n.Kind == yaml.SequenceNode {
+		decls := &yaml.Node{
+			Kind:   yaml.MappingNode,
+			File:   n.File,
+			Line:   n.Line,
+			Column: n.Column,
+		}
+		for _, item := range n.Children {
+			if item.Kind != yaml.MappingNode {
+				return newError(nodePosition(item), "declarations must be mappings")
+			}
+			decls.Children = append(decls.Children, item.Children...)
+		}
+		*n = *decls
+	}
+	if n.Kind != yaml.MappingNode || upgrade == nil {
+		return nil
+	}
+	for i := 1; i < len(n.Children); i += 2 {
+		upgrade(n.Children[i])
+	}
+	return nil
+}
+
+// upgradeResource08 converts a resource or a resource type
+func upgradeResource08(n *yaml.Node) {
+	forEachMapping08(n, func(key string, val *yaml.Node) {
+		switch {
+		case key == "uriParameters", key == "baseUriParameters":
+			upgradeNamedParams08(val, true)
+		case strings.HasPrefix(key, "/"):
+			upgradeResource08(val)
+		case methodKeys[key]:
+			upgradeMethod08(val)
+		}
+	})
+}
+
+// upgradeMethod08 converts a method or a trait
+func upgradeMethod08(n *yaml.Node) {
+	forEachMapping08(n, func(key string, val *yaml.Node) {
+		switch key {
+		case "queryParameters", "headers":
+			upgradeNamedParams08(val, false)
+		case "baseUriParameters":
+			upgradeNamedParams08(val, true)
+		case "body":
+			upgradeBody08(val)
+		case "responses":
+			forEachMapping08(val, func(_ string, resp *yaml.Node) {
+				forEachMapping08(resp, func(key string, val *yaml.Node) {
+					switch key {
+					case "headers":
+						upgradeNamedParams08(val, false)
+					case "body":
+						upgradeBody08(val)
+					}
+				})
+			})
+		}
+	})
+}
+
+// upgradeSecurityScheme08 converts a security scheme
+func upgradeSecurityScheme08(n *yaml.Node) {
+	forEachMapping08(n, func(key string, val *yaml.Node) {
+		if key == "describedBy" {
+			upgradeMethod08(val)
+		}
+	})
+}
+
+// upgradeBody08 converts a body, which is either a map of media types
+// or the body of the default media type.
+func upgradeBody08(n *yaml.Node) {
+	if n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Children); i += 2 {
+		key, val := n.Children[i], n.Children[i+1]
+		switch {
+		case key.Value == "schema":
+			key.Value = "type"
+		case key.Value == "formParameters":
+			key.Value = "properties"
+			upgradeNamedParams08(val, false)
+		case strings.Contains(key.Value, "/"): // media type
+			upgradeBody08(val)
+		}
+	}
+}
+
+// upgradeNamedParams08 converts a map of named parameters into a map
+// of type declarations. The parameters of an URI are required by default.
+func upgradeNamedParams08(n *yaml.Node, uri bool) {
+	forEachMapping08(n, func(_ string, param *yaml.Node) {
+		if param.Kind == yaml.SequenceNode && len(param.Children) > 0 {
+			upgradeMultipleTypes08(param)
+		}
+		// a parameter without facets, e.g. `page:`
+		if isNullNode(param) {
+			*param = yaml.Node{Kind: yaml.MappingNode, File: param.File, Line: param.Line, Column: param.Column}
+		}
+		if param.Kind != yaml.MappingNode {
+			return
+		}
+
+		if !uri && mappingValue(param, "required") == nil {
+			appendMapping08(param, "required", "false")
+		}
+		if typ := mappingValue(param, "type"); typ != nil && typ.Value == "date" {
+			typ.Value = "datetime"
+			appendMapping08(param, "format", "rfc2616")
+		}
+		if repeat := mappingValue(param, "repeat"); repeat != nil {
+			upgradeRepeat08(param, repeat.Value == "true")
+		}
+	})
+}
+
+// upgradeMultipleTypes08 converts a named parameter with multiple types,
+// e.g. `[{type: number, minimum: 0}, {type: string}]`, into a parameter
+// of the union of its types, e.g. `number | string`.
+// A facet of the union is the facet of the first type which declares it.
+func upgradeMultipleTypes08(param *yaml.Node) {
+	union := &yaml.Node{
+		Kind:   yaml.MappingNode,
+		File:   param.File,
+		Line:   param.Line,
+		Column: param.Column,
+	}
+	var types []string
+	for _, alt := range param.Children {
+		typ := "string"
+		if alt.Kind == yaml.MappingNode {
+			for i := 0; i+1 < len(alt.Children); i += 2 {
+				key, val := alt.Children[i], alt.Children[i+1]
+				switch {
+				case key.Value == "type":
+					typ = val.Value
+				case mappingValue(union, key.Value) == nil:
+					union.Children = append(union.Children, key, val)
+				}
+			}
+		}
+		if typ == "date" {
+			typ = "datetime"
+			if mappingValue(union, "format") == nil {
+				appendMapping08(union, "format", "rfc2616")
+			}
+		}
+		types = append(types, typ)
+	}
+	appendMapping08(union, "type", strings.Join(types, " | "))
+	*param = *union
+}
+
+// upgradeRepeat08 removes the `repeat` facet of a named parameter,
+// a repeated parameter is converted into an array of its type.
+func upgradeRepeat08(param *yaml.Node, repeat bool) {
+	items := &yaml.Node{
+		Kind:   yaml.MappingNode,
+		File:   param.File,
+		Line:   param.Line,
+		Column: param.Column,
+	}
+	var children []*yaml.Node
+	for i := 0; i+1 < len(param.Children); i += 2 {
+		key, val := param.Children[i], param.Children[i+1]
+		switch {
+		case key.Value == "repeat":
+		case repeat && raml08ItemFacets[key.Value]:
+			items.Children = append(items.Children, key, val)
+		default:
+			children = append(children, key, val)
+		}
+	}
+	param.Children = children
+	if !repeat {
+		return
+	}
+	if mappingValue(items, "type") == nil {
+		appendMapping08(items, "type", "string")
+	}
+	appendMapping08(param, "type", "array")
+	param.Children = append(param.Children,
+		&yaml.Node{Kind: yaml.ScalarNode, File: param.File, Line: param.Line, Column: param.Column, Value: "items", Implicit: true},
+		items)
+}
+
+// forEachMapping08 calls fn for each key and value of a mapping node
+func forEachMapping08(n *yaml.Node, fn func(key string, val *yaml.Node)) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return
+	}
+	for i := 0; i+1 < len(n.Children); i += 2 {
+		fn(strings.TrimSuffix(n.Children[i].Value, "?"), n.Children[i+1])
+	}
+}
+
+// appendMapping08 appends a scalar key and value to a mapping node
+func appendMapping08(n *yaml.Node, key, val string) {
+	newScalar := func(val string) *yaml.Node {
+		return &yaml.Node{Kind: yaml.ScalarNode, File: n.File, Line: n.Line, Column: n.Column, Value: val, Implicit: true}
+	}
+	n.Children = append(n.Children, newScalar(key), newScalar(val))
+}
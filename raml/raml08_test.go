@@ -0,0 +1,65 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestRAML08(t *testing.T) {
+	Convey("RAML 0.8", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/raml08/api.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		Convey("schemas", func() {
+			So(apiDef.Types, ShouldContainKey, "Book")
+			So(apiDef.Types, ShouldContainKey, "Books")
+			So(apiDef.Types, ShouldContainKey, "Error")
+
+			book, err := apiDef.TypeResolver().Resolve("Book")
+			So(err, ShouldBeNil)
+			So(book.Properties, ShouldContainKey, "isbn")
+			So(book.Properties["title"].Required, ShouldBeTrue)
+			So(book.Properties["price"].Required, ShouldBeFalse)
+
+			body := apiDef.Resources["/books"].Post.Bodies.ForMIMEType["application/json"]
+			So(body.Type, ShouldEqual, "Book")
+		})
+
+		Convey("declarations", func() {
+			So(apiDef.Traits, ShouldContainKey, "paged")
+			So(apiDef.Traits, ShouldContainKey, "filtered")
+			So(apiDef.ResourceTypes, ShouldContainKey, "collection")
+			So(apiDef.SecuritySchemes["token"].Type, ShouldEqual, "x-token")
+
+			books := apiDef.Resources["/books"]
+			So(books.Description, ShouldEqual, "collection of books")
+			So(books.Get.QueryParameters, ShouldContainKey, "page")
+			So(books.Get.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Books")
+		})
+
+		Convey("named parameters", func() {
+			So(apiDef.BaseURIParameters, ShouldContainKey, "region")
+			So(apiDef.BaseURIParameters["region"].Required, ShouldBeTrue)
+
+			get := apiDef.Resources["/books"].Get
+			So(get.QueryParameters["page"].Required, ShouldBeFalse)
+			So(get.QueryParameters["since"].Type, ShouldEqual, "datetime")
+			So(get.QueryParameters["tag"].Type, ShouldEqual, "array")
+			So(get.QueryParameters["tag"].Required, ShouldBeFalse)
+			So(get.QueryParameters["author"].Type, ShouldEqual, "")
+			So(get.QueryParameters["author"].Required, ShouldBeFalse)
+
+			put := apiDef.Resources["/books"].Nested["/{isbn}"].Put
+			props := put.Bodies.ForMIMEType["application/x-www-form-urlencoded"].Properties
+			So(props, ShouldContainKey, "title")
+			So(props, ShouldContainKey, "price")
+
+			// the parameter with multiple types is an union
+			price := ToProperty("price", props["price"])
+			So(price.Type, ShouldEqual, "number | string")
+			So(*price.Minimum, ShouldEqual, 0)
+		})
+	})
+}
@@ -0,0 +1,377 @@
+package raml
+
+// This file contains the lookup of the types, traits, resource types and
+// security schemes referenced by their library qualified name,
+// e.g. `lib.secured`, through the libraries used by the libraries.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+// declaration kinds, used in the error messages
+const (
+	declType           = "type"
+	declTrait          = "trait"
+	declResourceType   = "resource type"
+	declSecurityScheme = "security scheme"
+)
+
+// allTraits returns the traits of a document and the traits of
+// the libraries it uses, including the libraries used by these libraries.
+// The traits of a library are qualified by the library name,
+// e.g. `lib.secured` or `lib.sublib.secured`.
+func allTraits(traits map[string]Trait, libraries map[string]*Library) map[string]Trait {
+	all := map[string]Trait{}
+	for _, libName := range libraryNames(libraries) {
+		l := libraries[libName]
+		for name, t := range allTraits(l.Traits, l.Libraries) {
+			all[libName+"."+name] = t.qualify(libName, l)
+		}
+	}
+
+	// the declarations of the document hide the library ones,
+	// ambiguous references are reported by checkReferences
+	for name, t := range traits {
+		all[name] = t
+	}
+	return all
+}
+
+// allResourceTypes returns the resource types of a document
+// and the resource types of the libraries it uses, including
+// the libraries used by these libraries.
+// The resource types of a library are qualified by the library name.
+func allResourceTypes(resourceTypes map[string]ResourceType, libraries map[string]*Library) map[string]ResourceType {
+	all := map[string]ResourceType{}
+	for _, libName := range libraryNames(libraries) {
+		l := libraries[libName]
+		for name, rt := range allResourceTypes(l.ResourceTypes, l.Libraries) {
+			all[libName+"."+name] = rt.qualify(libName, l)
+		}
+	}
+
+	// the declarations of the document hide the library ones,
+	// ambiguous references are reported by checkReferences
+	for name, rt := range resourceTypes {
+		all[name] = rt
+	}
+	return all
+}
+
+// qualify returns a copy of a library trait in which the names
+// it references are qualified by the library name, see qualifyRef.
+func (t Trait) qualify(libName string, l *Library) Trait {
+	if t.node != nil {
+		t.node = qualifyDeclNode(t.node, fragmentTrait, libName, l)
+	}
+	return t
+}
+
+// qualify returns a copy of a library resource type in which the
+// resource type it inherits and the traits it applies
+// are qualified by the library name.
+// The names referenced by the declaration are qualified in its node,
+// they are qualified when the resource type is applied.
+func (rt ResourceType) qualify(libName string, l *Library) ResourceType {
+	if rt.Type != nil && rt.Type.Name != "" {
+		rt.Type = &DefinitionChoice{
+			Name:       libName + "." + rt.Type.Name,
+			Parameters: rt.Type.Parameters,
+		}
+	}
+	is := make([]DefinitionChoice, 0, len(rt.Is))
+	for _, tDef := range rt.Is {
+		is = append(is, DefinitionChoice{
+			Name:       libName + "." + tDef.Name,
+			Parameters: tDef.Parameters,
+		})
+	}
+	rt.Is = is
+	if rt.node != nil {
+		rt.node = qualifyDeclNode(rt.node, fragmentResourceType, libName, l)
+	}
+	return rt
+}
+
+// qualifyDeclNode returns a copy of the node of a declaration of library l
+// in which the names it references are qualified by the library name.
+// The parameters are not qualified, their value is given where the
+// declaration is applied.
+func qualifyDeclNode(n *yaml.Node, location, libName string, l *Library) *yaml.Node {
+	n = cloneNode(n)
+	renameNodeRefs(n, location, func(kind, ref string) string {
+		return qualifyRef(libName, l, kind, ref)
+	}, nil)
+	return n
+}
+
+// qualifyRef qualifies a name referenced by a declaration of library l
+// by the library name, e.g. `File` becomes `lib.File` and `sub.File` becomes
+// `lib.sub.File`. The names which aren't declared by the library
+// or by a library it uses, e.g. the built-in types, are not changed.
+func qualifyRef(libName string, l *Library, kind, ref string) string {
+	if i := strings.Index(ref, "."); i > 0 {
+		if _, ok := l.Libraries[ref[:i]]; ok {
+			return libName + "." + ref
+		}
+		return ref
+	}
+
+	var declared bool
+	switch kind {
+	case locationTypes:
+		_, declared = l.Types[ref]
+	case locationTraits:
+		_, declared = l.Traits[ref]
+	case locationResourceTypes:
+		_, declared = l.ResourceTypes[ref]
+	case locationSecuritySchemes:
+		_, declared = l.SecuritySchemes[ref]
+	case locationAnnotationTypes:
+		_, declared = l.AnnotationTypes[ref]
+	}
+	if declared {
+		return libName + "." + ref
+	}
+	return ref
+}
+
+// renameNodeRefs renames the references to the declarations in a tree
+// at a location of a document: the type expressions, the applied traits,
+// resource types and security schemes and the annotations.
+// renameRef returns the new name of a reference to a declaration of a kind,
+// e.g. locationTypes. The subtrees for which skip returns true are not renamed.
+func renameNodeRefs(n *yaml.Node, location string, renameRef func(kind, ref string) string, skip func(*yaml.Node) bool) {
+	if n == nil || (skip != nil && skip(n)) {
+		return
+	}
+	switch n.Kind {
+	case yaml.ScalarNode:
+		if location == fragmentDataType || location == fragmentAnnotationTypeDeclaration {
+			n.Value = renameTypeNames(n.Value, func(name string) string {
+				return renameRef(locationTypes, name)
+			})
+		}
+	case yaml.SequenceNode: // multiple inheritance
+		for _, c := range n.Children {
+			renameNodeRefs(c, location, renameRef, skip)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Children); i += 2 {
+			key := n.Children[i].Value
+			if isAnnotationKey(key) {
+				n.Children[i].Value = "(" + renameRef(locationAnnotationTypes, annotationName(key)) + ")"
+				continue
+			}
+			child, kind := childRef(location, key)
+			switch {
+			case kind != "":
+				renameChoiceNodes(n.Children[i+1], kind, renameRef)
+			case child != "":
+				renameNodeRefs(n.Children[i+1], child, renameRef, skip)
+			}
+		}
+	}
+}
+
+// renameChoiceNodes renames the applied traits, resource types or security schemes
+func renameChoiceNodes(n *yaml.Node, kind string, renameRef func(kind, ref string) string) {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		n.Value = renameRef(kind, n.Value)
+	case yaml.SequenceNode:
+		for _, c := range n.Children {
+			renameChoiceNodes(c, kind, renameRef)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Children); i += 2 {
+			n.Children[i].Value = renameRef(kind, n.Children[i].Value)
+		}
+	}
+}
+
+// findSecurityScheme finds a security scheme by its name,
+// which is qualified by the library name for a library security scheme.
+func findSecurityScheme(name string, schemes map[string]SecurityScheme,
+	libraries map[string]*Library) (SecurityScheme, bool) {
+	name = strings.TrimSpace(name)
+	if ss, ok := schemes[name]; ok {
+		return ss, true
+	}
+
+	splitted := strings.SplitN(name, ".", 2)
+	if len(splitted) != 2 {
+		return SecurityScheme{}, false
+	}
+	l, ok := libraries[splitted[0]]
+	if !ok {
+		return SecurityScheme{}, false
+	}
+	return findSecurityScheme(splitted[1], l.SecuritySchemes, l.Libraries)
+}
+
+// libraryNames returns the sorted names of the libraries
+func libraryNames(libraries map[string]*Library) []string {
+	var names []string
+	for name := range libraries {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// referenceChecker checks the library qualified names
+// referenced by a document.
+type referenceChecker struct {
+	traits          map[string]Trait
+	resourceTypes   map[string]ResourceType
+	securitySchemes map[string]SecurityScheme
+	libraries       map[string]*Library
+	errs            *Error
+}
+
+// checkReferences checks that the traits, resource types and security
+// schemes referenced by their library qualified name exist and that
+// these names don't also reference a declaration of the document.
+func checkReferences(traits map[string]Trait, resourceTypes map[string]ResourceType,
+	schemes map[string]SecurityScheme, libraries map[string]*Library,
+	resources map[string]Resource, securedBy []DefinitionChoice, pos Position) error {
+	rc := &referenceChecker{
+		traits:          traits,
+		resourceTypes:   resourceTypes,
+		securitySchemes: schemes,
+		libraries:       libraries,
+		errs:            &Error{},
+	}
+	rc.checkSecuredBy(securedBy, pos)
+
+	for _, rt := range resourceTypes {
+		if rt.Type != nil {
+			rc.check(declResourceType, rt.Type.Name, rt.Position)
+		}
+		rc.checkIs(rt.Is, rt.Position)
+		for _, m := range []*Method{rt.Get, rt.Head, rt.Post, rt.Put, rt.Delete, rt.Patch,
+			rt.OptionalGet, rt.OptionalHead, rt.OptionalPost, rt.OptionalPut, rt.OptionalDelete, rt.OptionalPatch} {
+			rc.checkMethod(m, rt.Position)
+		}
+	}
+
+	for k := range resources {
+		r := resources[k]
+		rc.checkResource(&r)
+	}
+
+	rc.errs.sort()
+	return rc.errs.orNil()
+}
+
+func (rc *referenceChecker) checkResource(r *Resource) {
+	if r.Type != nil {
+		rc.check(declResourceType, r.Type.Name, r.Position)
+	}
+	rc.checkIs(r.Is, r.Position)
+	rc.checkSecuredBy(r.SecuredBy, r.Position)
+	for _, m := range []*Method{r.Get, r.Patch, r.Put, r.Head, r.Post, r.Delete, r.Options} {
+		rc.checkMethod(m, r.Position)
+	}
+	for _, n := range r.Nested {
+		rc.checkResource(n)
+	}
+}
+
+func (rc *referenceChecker) checkMethod(m *Method, pos Position) {
+	if m == nil {
+		return
+	}
+	if m.Line > 0 {
+		pos = m.Position
+	}
+	rc.checkIs(m.Is, pos)
+	rc.checkSecuredBy(m.SecuredBy, pos)
+}
+
+func (rc *referenceChecker) checkIs(is []DefinitionChoice, pos Position) {
+	for _, tDef := range is {
+		rc.check(declTrait, tDef.Name, pos)
+	}
+}
+
+func (rc *referenceChecker) checkSecuredBy(securedBy []DefinitionChoice, pos Position) {
+	for _, sb := range securedBy {
+		if sb.Name != "" && sb.Name != "null" {
+			rc.check(declSecurityScheme, sb.Name, pos)
+		}
+	}
+}
+
+// check checks a referenced name.
+// The names which aren't qualified by a library name are checked
+// when the declaration is applied.
+func (rc *referenceChecker) check(kind, name string, pos Position) {
+	name = strings.TrimSpace(name)
+	if !strings.Contains(name, ".") {
+		return
+	}
+
+	err := findLibraryDecl(kind, name, "", rc.libraries)
+	switch {
+	case rc.declared(kind, name) && err == nil:
+		rc.errs.add(pos, "%v %v is ambiguous, it is declared by the document and by library %v",
+			kind, name, strings.SplitN(name, ".", 2)[0])
+	case rc.declared(kind, name):
+	case err != nil:
+		rc.errs.add(pos, "%v", err)
+	}
+}
+
+// declared returns true if a declaration of the document has the given name
+func (rc *referenceChecker) declared(kind, name string) bool {
+	var ok bool
+	switch kind {
+	case declTrait:
+		_, ok = rc.traits[name]
+	case declResourceType:
+		_, ok = rc.resourceTypes[name]
+	case declSecurityScheme:
+		_, ok = rc.securitySchemes[name]
+	}
+	return ok
+}
+
+// findLibraryDecl finds the declaration of a library qualified name
+// in the given libraries.
+// prefix is the qualifier of the libraries, used in the error messages.
+func findLibraryDecl(kind, name, prefix string, libraries map[string]*Library) error {
+	splitted := strings.SplitN(name, ".", 2)
+	if len(splitted) != 2 {
+		return fmt.Errorf("unknown %v %v%v", kind, prefix, name)
+	}
+	libName, declName := splitted[0], splitted[1]
+	l, ok := libraries[libName]
+	if !ok {
+		return fmt.Errorf("unknown library %v%v of %v %v%v", prefix, libName, kind, prefix, name)
+	}
+
+	var declared bool
+	switch kind {
+	case declType:
+		_, declared = l.Types[declName]
+	case declTrait:
+		_, declared = l.Traits[declName]
+	case declResourceType:
+		_, declared = l.ResourceTypes[declName]
+	case declSecurityScheme:
+		_, declared = l.SecuritySchemes[declName]
+	}
+	if declared {
+		return nil
+	}
+	if !strings.Contains(declName, ".") {
+		return fmt.Errorf("library %v%v has no %v %v", prefix, libName, kind, declName)
+	}
+	return findLibraryDecl(kind, declName, prefix+libName+".", l.Libraries)
+}
@@ -0,0 +1,74 @@
+package raml
+
+import (
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestLibraryReferences(t *testing.T) {
+	Convey("library qualified names", t, func() {
+		Convey("names referenced by the library resource types and traits", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/simple_with_lib.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// they are qualified by the library which declares them
+			get := apiDef.Resources["/files/{name}"].Get
+			So(get.Responses[201].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "files.file-type.File")
+			So(get.Headers, ShouldContainKey, HTTPHeader("drm-key"))
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+
+		Convey("nested libraries", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/nested_libraries/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			// the path of a library used by a library is relative to that library
+			So(apiDef.Libraries["lib"].Uses["sub"], ShouldEqual, "sub.raml")
+			So(apiDef.Libraries["lib"].Libraries["sub"].Filename, ShouldEqual, filepath.Join("libs", "sub.raml"))
+			So(apiDef.FindLibFile("sub"), ShouldEqual, filepath.Join("libs", "sub.raml"))
+
+			r := apiDef.Resources["/books"]
+			So(r.Description, ShouldEqual, "the books")
+
+			// traits of a library and of a library it uses
+			So(r.Get.Description, ShouldEqual, "get the books")
+			So(r.Get.Headers, ShouldContainKey, HTTPHeader("Authorization"))
+			So(r.Get.QueryParameters, ShouldContainKey, "page")
+			So(r.Get.QueryParameters, ShouldContainKey, "sort")
+			So(r.Post.Description, ShouldEqual, "create a book")
+			So(r.Post.QueryParameters, ShouldContainKey, "page")
+
+			// security schemes
+			ss, ok := apiDef.GetSecurityScheme("lib.sub.oauth")
+			So(ok, ShouldBeTrue)
+			So(ss.Type, ShouldEqual, "OAuth 2.0")
+			_, ok = apiDef.GetSecurityScheme("lib.token")
+			So(ok, ShouldBeTrue)
+
+			// types
+			books, err := apiDef.TypeResolver().Resolve("Books")
+			So(err, ShouldBeNil)
+			So(books.Items.Properties["author"].Type.Name, ShouldEqual, "lib.sub.Author")
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+
+		Convey("ambiguous name", func() {
+			err := ParseFile("./samples/nested_libraries/ambiguous.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"ambiguous.raml:10:5: trait lib.secured is ambiguous, it is declared by the document and by library lib")
+		})
+
+		Convey("missing declarations", func() {
+			err := ParseFile("./samples/nested_libraries/missing.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "missing.raml:6:3: library lib.sub has no resource type missing")
+			So(err.Error(), ShouldContainSubstring, "missing.raml:6:3: unknown library other of security scheme other.oauth")
+			So(err.Error(), ShouldContainSubstring, "missing.raml:9:5: library lib has no trait sorted")
+		})
+	})
+}
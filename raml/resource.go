@@ -2,7 +2,6 @@ package raml
 
 import (
 	"fmt"
-	"log"
 	"path/filepath"
 	"strings"
 )
@@ -24,7 +23,8 @@ type Resource struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description"`
 
-	// TODO : annotationName
+	// Annotations to be applied to this resource.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
 
 	// In a RESTful API, methods are operations that are performed on a
 	// resource. A method MUST be one of the HTTP methods defined in the
@@ -63,6 +63,9 @@ type Resource struct {
 
 	// all methods of this resource
 	Methods []*Method `yaml:"-"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 // postProcess doing post processing of a resource after being constructed by the parser.
@@ -74,11 +77,34 @@ func (r *Resource) postProcess(uri string, parent *Resource, resourceTypes map[s
 	r.URI = strings.TrimSpace(uri)
 	r.Parent = parent
 
-	r.setMethods(traitsMap)
+	setOptionalParams(r.URIParameters)
+	for _, m := range []*Method{r.Get, r.Post, r.Put, r.Patch, r.Head, r.Delete, r.Options} {
+		if m != nil {
+			m.setOptionalParams()
+		}
+	}
+
+	// resource types inherited by this resource
+	chain, err := r.resourceTypeChain(resourceTypes, traitsMap)
+	if err != nil {
+		return r.wrapError(err)
+	}
+
+	// the methods of the resource types are methods of this resource,
+	// they are created before applying the traits of this resource
+	if err := r.addResourceTypeMethods(chain); err != nil {
+		return r.wrapError(err)
+	}
+
+	if err := r.setMethods(traitsMap); err != nil {
+		return r.wrapError(err)
+	}
 
 	// inherit from resource types
-	if err := r.inheritResourceType(resourceTypes); err != nil {
-		return err
+	for i := range chain {
+		if err := r.inheritResourceType(chain[i:], traitsMap); err != nil {
+			return r.wrapError(err)
+		}
 	}
 
 	// process nested/child resources
@@ -92,18 +118,89 @@ func (r *Resource) postProcess(uri string, parent *Resource, resourceTypes map[s
 	return nil
 }
 
-// inherit from a resource type
-func (r *Resource) inheritResourceType(resourceTypes map[string]ResourceType) error {
-	// get resource type object to inherit
+// applyMediaTypes makes the bodies declared without media type the
+// bodies of the default media types, in this resource and its nested resources
+func (r *Resource) applyMediaTypes(mediaTypes []string) {
+	for _, m := range r.Methods {
+		m.applyMediaTypes(mediaTypes)
+	}
+	for _, n := range r.Nested {
+		n.applyMediaTypes(mediaTypes)
+	}
+}
+
+// appliedResourceType is a resource type applied to a resource
+// with the values of its parameters
+type appliedResourceType struct {
+	rt    *ResourceType
+	dicts map[string]interface{}
+}
+
+// resourceTypeChain returns the resource type of this resource
+// followed by the resource types it inherits, from the nearest to the farthest.
+// The parameters of a base resource type are given by the resource type
+// which inherits it, their values can use the parameters of that resource type.
+func (r *Resource) resourceTypeChain(resourceTypes map[string]ResourceType, traitsMap map[string]Trait) ([]appliedResourceType, error) {
 	rt, err := r.getResourceType(resourceTypes)
 	if rt == nil || err != nil {
-		return err
+		return nil, err
 	}
 
-	// initialize dicts
-	dicts := initResourceTypeDicts(r, r.Type.Parameters)
+	var chain []appliedResourceType
+	params := r.Type.Parameters
+	for {
+		applied, err := rt.apply(r, params, traitsMap)
+		if err != nil {
+			return nil, fmt.Errorf("resource type %v: %v", rt.Name, err)
+		}
+		rt = applied
+		dicts := initResourceTypeDicts(r, params)
+		chain = append(chain, appliedResourceType{rt: rt, dicts: dicts})
+		if rt.Type == nil || rt.Type.Name == "" {
+			return chain, nil
+		}
+		if len(chain) > len(resourceTypes) {
+			return nil, fmt.Errorf("resource type %v: inheritance cycle", rt.Name)
+		}
 
-	r.Description = substituteParams(r.Description, rt.Description, dicts)
+		params, err = substituteDefinitionParams(rt.Type.Parameters, dicts)
+		if err != nil {
+			return nil, fmt.Errorf("resource type %v: %v", rt.Name, err)
+		}
+		base, ok := resourceTypes[rt.Type.Name]
+		if !ok {
+			return nil, fmt.Errorf("resource type %v: can't find resource type named :%v", rt.Name, rt.Type.Name)
+		}
+		rt = &base
+	}
+}
+
+// addResourceTypeMethods creates the methods declared by the resource types
+// which are not declared by this resource
+func (r *Resource) addResourceTypeMethods(chain []appliedResourceType) error {
+	for _, art := range chain {
+		for _, rtm := range art.rt.methods {
+			if r.MethodByName(rtm.Name) != nil {
+				continue
+			}
+			if err := r.assignMethod(newMethod(rtm.Name), rtm.Name); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// inherit from the first resource type of a resource type chain,
+// the rest of the chain are the resource types it inherits.
+func (r *Resource) inheritResourceType(chain []appliedResourceType, traitsMap map[string]Trait) error {
+	rt, dicts := chain[0].rt, chain[0].dicts
+
+	var err error
+	r.Description, err = substituteParams(r.Description, rt.Description, dicts)
+	if err != nil {
+		return fmt.Errorf("resource type %v: %v", rt.Name, err)
+	}
 
 	// uri parameters
 	if len(r.URIParameters) == 0 {
@@ -114,27 +211,40 @@ func (r *Resource) inheritResourceType(resourceTypes map[string]ResourceType) er
 		if !ok {
 			p = NamedParameter{}
 		}
-		p.inherit(up, dicts)
+		if err := p.inherit(up, dicts); err != nil {
+			return fmt.Errorf("resource type %v: uri parameter %v: %v", rt.Name, name, err)
+		}
 		r.URIParameters[name] = p
 	}
 
 	// methods
-	r.inheritMethods(rt)
+	if err := r.inheritMethods(rt, dicts); err != nil {
+		return fmt.Errorf("resource type %v: %v", rt.Name, err)
+	}
 
+	// the traits of the resource type apply to the methods
+	// it inherits from its base resource types
+	if err := r.applyResourceTypeTraits(chain, traitsMap); err != nil {
+		return fmt.Errorf("resource type %v: %v", rt.Name, err)
+	}
 	return nil
 }
 
 // inherit methods inherits all methods based on it's resource type
-func (r *Resource) inheritMethods(rt *ResourceType) {
+func (r *Resource) inheritMethods(rt *ResourceType, dicts map[string]interface{}) error {
 	// inherit all methods from resource type
 	// if it doesn't have the methods, we create it
 	for _, rtm := range rt.methods {
 		m := r.MethodByName(rtm.Name)
 		if m == nil {
 			m = newMethod(rtm.Name)
-			r.assignMethod(m, m.Name)
+			if err := r.assignMethod(m, m.Name); err != nil {
+				return err
+			}
+		}
+		if err := m.inheritFromResourceType(rtm, dicts); err != nil {
+			return fmt.Errorf("%v: %v", rtm.Name, err)
 		}
-		m.inheritFromResourceType(r, rtm, rt)
 	}
 
 	// inherit optional methods if only the resource also has the method
@@ -143,9 +253,49 @@ func (r *Resource) inheritMethods(rt *ResourceType) {
 		if m == nil {
 			continue
 		}
-		m.inheritFromResourceType(r, rtm, rt)
+		if err := m.inheritFromResourceType(rtm, dicts); err != nil {
+			return fmt.Errorf("%v?: %v", rtm.Name, err)
+		}
 	}
+	return nil
+}
 
+// applyResourceTypeTraits applies the traits of the first resource type
+// of a resource type chain to the methods declared by the rest of the chain.
+// The traits are already applied to the methods declared by the resource type itself.
+func (r *Resource) applyResourceTypeTraits(chain []appliedResourceType, traitsMap map[string]Trait) error {
+	rt, dicts := chain[0].rt, chain[0].dicts
+	if len(rt.Is) == 0 {
+		return nil
+	}
+
+	// the parameters of the traits can use the parameters of the resource type
+	is := make([]DefinitionChoice, 0, len(rt.Is))
+	for _, tDef := range rt.Is {
+		params, err := substituteDefinitionParams(tDef.Parameters, dicts)
+		if err != nil {
+			return fmt.Errorf("trait %v: %v", tDef.Name, err)
+		}
+		is = append(is, DefinitionChoice{Name: tDef.Name, Parameters: params})
+	}
+
+	applied := map[string]bool{}
+	for _, rtm := range rt.methods {
+		applied[rtm.Name] = true
+	}
+	for _, art := range chain[1:] {
+		for _, rtm := range art.rt.methods {
+			if applied[rtm.Name] {
+				continue
+			}
+			applied[rtm.Name] = true
+			m := r.MethodByName(rtm.Name)
+			if err := m.inheritFromTraits(r, is, traitsMap); err != nil {
+				return fmt.Errorf("%v: %v", rtm.Name, err)
+			}
+		}
+	}
+	return nil
 }
 
 // get resource type from which this resource will inherit
@@ -161,42 +311,43 @@ func (r *Resource) getResourceType(resourceTypes map[string]ResourceType) (*Reso
 			return &rt, nil
 		}
 	}
-	return nil, fmt.Errorf("can't find resource type named :%v", r.Type.Name)
+	return nil, newError(r.Position, "%v: can't find resource type named :%v", r.FullURI(), r.Type.Name)
 }
 
-// set methods set all methods name
+// set methods set all methods name, apply the traits
 // and add it to Methods slice
-func (r *Resource) setMethods(traitsMap map[string]Trait) {
-	if r.Get != nil {
-		r.Get.Name = "GET"
-		r.Get.inheritFromTraits(r, append(r.Is, r.Get.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Get)
-	}
-	if r.Post != nil {
-		r.Post.Name = "POST"
-		r.Post.inheritFromTraits(r, append(r.Is, r.Post.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Post)
-	}
-	if r.Put != nil {
-		r.Put.Name = "PUT"
-		r.Put.inheritFromTraits(r, append(r.Is, r.Put.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Put)
-	}
-	if r.Patch != nil {
-		r.Patch.Name = "PATCH"
-		r.Patch.inheritFromTraits(r, append(r.Is, r.Patch.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Patch)
-	}
-	if r.Head != nil {
-		r.Head.Name = "HEAD"
-		r.Head.inheritFromTraits(r, append(r.Is, r.Head.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Head)
-	}
-	if r.Delete != nil {
-		r.Delete.Name = "DELETE"
-		r.Delete.inheritFromTraits(r, append(r.Is, r.Delete.Is...), traitsMap)
-		r.Methods = append(r.Methods, r.Delete)
+func (r *Resource) setMethods(traitsMap map[string]Trait) error {
+	methods := []struct {
+		name string
+		m    *Method
+	}{
+		{"GET", r.Get},
+		{"POST", r.Post},
+		{"PUT", r.Put},
+		{"PATCH", r.Patch},
+		{"HEAD", r.Head},
+		{"DELETE", r.Delete},
+	}
+	for _, v := range methods {
+		if v.m == nil {
+			continue
+		}
+		v.m.Name = v.name
+		if err := v.m.inheritFromTraits(r, append(r.Is, v.m.Is...), traitsMap); err != nil {
+			return fmt.Errorf("%v: %v", v.name, err)
+		}
+		r.Methods = append(r.Methods, v.m)
 	}
+	return nil
+}
+
+// wrapError adds the position and URI of this resource to an error
+// which doesn't have a position
+func (r *Resource) wrapError(err error) error {
+	if ramlErr, ok := err.(*Error); ok {
+		return ramlErr
+	}
+	return newError(r.Position, "%v: %v", r.FullURI(), err)
 }
 
 // MethodByName return resource's method by it's name
@@ -219,7 +370,7 @@ func (r *Resource) MethodByName(name string) *Method {
 	}
 }
 
-func (r *Resource) assignMethod(m *Method, name string) {
+func (r *Resource) assignMethod(m *Method, name string) error {
 	switch name {
 	case "GET":
 		r.Get = m
@@ -234,20 +385,38 @@ func (r *Resource) assignMethod(m *Method, name string) {
 	case "DELETE":
 		r.Delete = m
 	default:
-		log.Fatalf("assignMethod fatal error, invalid method name:%v", name)
+		return fmt.Errorf("invalid method name:%v", name)
 	}
+	return nil
+}
+
+// substituteDefinitionParams returns the parameters of a resource type
+// or a trait in which the parameters inside double chevron of the values
+// are substituted, the values are obtained from dicts map
+func substituteDefinitionParams(params DefinitionParameters, dicts map[string]interface{}) (DefinitionParameters, error) {
+	result := DefinitionParameters{}
+	for name, val := range params {
+		if str, ok := val.(string); ok {
+			var err error
+			if val, err = substituteParams("", str, dicts); err != nil {
+				return nil, fmt.Errorf("parameter %v: %v", name, err)
+			}
+		}
+		result[name] = val
+	}
+	return result, nil
 }
 
 // substituteParams substitute all params inside double chevron to the correct value
 // param value will be obtained from dicts map
-func substituteParams(toReplace, words string, dicts map[string]interface{}) string {
+func substituteParams(toReplace, words string, dicts map[string]interface{}) (string, error) {
 	// non empty scalar node remain unchanged
 	// except it has double chevron bracket
 	if toReplace != "" && (strings.Index(toReplace, "<<") < 0 && strings.Index(toReplace, ">>") < 0) {
-		return toReplace
+		return toReplace, nil
 	}
 	if words == "" {
-		return toReplace
+		return toReplace, nil
 	}
 
 	removeParamBracket := func(param string) string {
@@ -260,14 +429,17 @@ func substituteParams(toReplace, words string, dicts map[string]interface{}) str
 
 	// substitute the params
 	for _, p := range params {
-		pVal := getParamValue(removeParamBracket(p), dicts)
+		pVal, err := getParamValue(removeParamBracket(p), dicts)
+		if err != nil {
+			return "", err
+		}
 		words = strings.Replace(words, p, pVal, -1)
 	}
-	return words
+	return words, nil
 }
 
 // get value of a resource type param
-func getParamValue(param string, dicts map[string]interface{}) string {
+func getParamValue(param string, dicts map[string]interface{}) (string, error) {
 	// split between inflector and real param
 	// real param and inflector is seperated by `|`
 	cleanParam, inflector := func() (string, string) {
@@ -278,25 +450,24 @@ func getParamValue(param string, dicts map[string]interface{}) string {
 		return strings.TrimSpace(arr[0]), strings.TrimSpace(arr[1])
 	}()
 
-	// get the value
-	val := func() string {
-		// get from type parameters
-		val, ok := dicts[cleanParam]
-		if !ok {
-			log.Fatalf("getParamValue unknown param:%v, dicts=%v", cleanParam, dicts)
-		}
-		return fmt.Sprintf("%v", val)
-	}()
+	// get from type parameters
+	rawVal, ok := dicts[cleanParam]
+	if !ok {
+		return "", fmt.Errorf("unknown parameter <<%v>>", cleanParam)
+	}
+	if _, ok := rawVal.(resourceParam); ok {
+		return "<<" + param + ">>", nil
+	}
+	val := fmt.Sprintf("%v", rawVal)
 
 	// inflect the value if needed
 	if inflector != "" {
-		var ok bool
 		val, ok = doInflect(val, inflector)
 		if !ok {
-			log.Fatalf("invalid inflector " + inflector)
+			return "", fmt.Errorf("invalid inflector %v of parameter <<%v>>", inflector, cleanParam)
 		}
 	}
-	return val
+	return val, nil
 }
 
 // CleanURI returns URI without `/`, `\`', `{`, and `}`
@@ -20,12 +20,12 @@ func TestResourceTypeInheritance(t *testing.T) {
 
 			So(r.Get, ShouldNotBeNil)
 			So(r.Get.Description, ShouldEqual, "Get all Users, optionally filtered")
-			So(r.Get.Responses[200].Bodies.Type, ShouldEqual, "Users")
+			So(r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Users")
 
 			So(r.Post, ShouldNotBeNil)
 			So(r.Post.Description, ShouldEqual, "Create a new User")
-			So(r.Post.Bodies.ApplicationJSON.Type, ShouldEqual, "User")
-			So(r.Post.Responses[200].Bodies.Type, ShouldEqual, "User")
+			So(r.Post.Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "User")
+			So(r.Post.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "User")
 		})
 
 		Convey("checking queues - optional method", func() {
@@ -44,7 +44,7 @@ func TestResourceTypeInheritance(t *testing.T) {
 
 			So(r.Post, ShouldNotBeNil)
 
-			props := r.Post.Bodies.ApplicationJSON.Properties
+			props := r.Post.Bodies.ForMIMEType["application/json"].Properties
 			So(ToProperty("name", props["name"]).Type, ShouldEqual, "string")
 			So(ToProperty("age", props["age"]).Type, ShouldEqual, "int")
 			So(r.Post.Headers["X-Chargeback"].Required, ShouldBeTrue)
@@ -88,7 +88,7 @@ func TestResourceTypeInheritance(t *testing.T) {
 			r := apiDef.Resources["/servers"]
 			So(r, ShouldNotBeNil)
 
-			props := r.Post.Bodies.ApplicationJSON.Properties
+			props := r.Post.Bodies.ForMIMEType["application/json"].Properties
 
 			So(props, ShouldContainKey, "name")
 			So(props, ShouldContainKey, "address?")
@@ -105,3 +105,152 @@ func TestResourceTypeInheritance(t *testing.T) {
 		})
 	})
 }
+
+func TestResourceTypeChain(t *testing.T) {
+	apiDef := new(APIDefinition)
+	err := ParseFile("./samples/resource_type_inheritance.raml", apiDef)
+	Convey("resource types inheriting other resource types", t, func() {
+		So(err, ShouldBeNil)
+
+		Convey("inherit the whole chain", func() {
+			r := apiDef.Resources["/books"]
+			So(r.Description, ShouldEqual, "collection of Books")
+
+			So(r.Get, ShouldNotBeNil)
+			So(r.Get.Description, ShouldEqual, "get the books")
+			So(r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Book[]")
+			So(r.Get.Responses[404].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Error")
+
+			So(r.Post, ShouldNotBeNil)
+			So(r.Post.Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Book")
+
+			So(r.Delete.DisplayName, ShouldEqual, "deleteBooks")
+			So(r.Delete.Description, ShouldEqual, "delete the Books")
+			So(r.Methods, ShouldHaveLength, 3)
+		})
+
+		Convey("parameters passed to the base resource types", func() {
+			qps := apiDef.Resources["/books"].Get.QueryParameters
+			So(qps["limit"].Description, ShouldEqual, "at most 50 books")
+			So(qps["q"].Description, ShouldEqual, "filter the books")
+
+			qps = apiDef.Resources["/authors"].Get.QueryParameters
+			So(qps["limit"].Description, ShouldEqual, "at most 10 authors")
+
+			r := apiDef.Resources["/tags"]
+			So(r.Description, ShouldEqual, "the tags")
+			So(r.Get.Description, ShouldEqual, "get the tags")
+		})
+
+		Convey("traits of the resource applied before the resource types", func() {
+			r := apiDef.Resources["/authors"]
+			So(r.Get, ShouldNotBeNil)
+			So(r.Get.Description, ShouldEqual, "requires a token to get")
+			So(r.Get.Headers, ShouldContainKey, HTTPHeader("Authorization"))
+			So(r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Author[]")
+			So(r.Methods, ShouldHaveLength, 1)
+		})
+	})
+}
+
+func TestResourceTypeParameters(t *testing.T) {
+	apiDef := new(APIDefinition)
+	err := ParseFile("./samples/resource_type_params.raml", apiDef)
+	Convey("parameters substituted in the whole resource types & traits", t, func() {
+		So(err, ShouldBeNil)
+		r := apiDef.Resources["/colors"]
+
+		Convey("keys", func() {
+			So(r.Get.Headers, ShouldContainKey, HTTPHeader("X-Color-Token"))
+			So(r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Properties, ShouldContainKey, "colors")
+			So(r.Post.Bodies.ForMIMEType["application/json"].Properties, ShouldContainKey, "name")
+		})
+
+		Convey("response codes", func() {
+			So(r.Get.Responses[401].Bodies.ForMIMEType["application/json"].Type, ShouldEqual, "Error")
+			So(r.Post.Responses[201].Description, ShouldEqual, "name created")
+		})
+
+		Convey("examples", func() {
+			So(r.Get.Headers["X-Color-Token"].Example, ShouldEqual, "X-Color-Token abcd")
+			example := r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Example.(map[interface{}]interface{})
+			So(example["colors"], ShouldResemble, []interface{}{map[interface{}]interface{}{"name": "red"}})
+		})
+
+		Convey("nested inline types", func() {
+			colors := r.Get.Responses[200].Bodies.ForMIMEType["application/json"].Properties["colors"].(map[interface{}]interface{})
+			items := colors["items"].(map[interface{}]interface{})
+			name := items["properties"].(map[interface{}]interface{})["name"].(map[interface{}]interface{})
+			So(name["enum"], ShouldResemble, []interface{}{"red", "green", "blue"})
+
+			name = r.Post.Bodies.ForMIMEType["application/json"].Properties["name"].(map[interface{}]interface{})
+			So(name["minLength"], ShouldEqual, 3)
+		})
+	})
+}
+
+func TestResourceTypeErrors(t *testing.T) {
+	Convey("resource type & traits application errors", t, func() {
+		Convey("unknown resource type parameter", func() {
+			err := ParseFile("./samples/resource_type_errors/unknown_param.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"unknown_param.raml:7:3: /users: resource type collection: unknown parameter <<item>>")
+		})
+
+		Convey("invalid inflector", func() {
+			err := ParseFile("./samples/resource_type_errors/invalid_inflector.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"/users: resource type collection: GET: invalid inflector !shout of parameter <<resourcePathName>>")
+		})
+
+		Convey("resource type inheritance cycle", func() {
+			err := ParseFile("./samples/resource_type_errors/cycle.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"cycle.raml:5:5: resource type base: inheritance cycle base -> collection -> readOnlyCollection -> base")
+		})
+
+		Convey("unknown base resource type", func() {
+			err := ParseFile("./samples/resource_type_errors/unknown_base.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"unknown_base.raml:5:5: resource type collection: can't find resource type named :base")
+		})
+
+		Convey("unknown parameter of a base resource type", func() {
+			err := ParseFile("./samples/resource_type_errors/base_param.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"/users: resource type base: unknown parameter <<item>>")
+		})
+
+		Convey("unknown trait", func() {
+			err := ParseFile("./samples/resource_type_errors/unknown_trait.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "/users: GET: invalid traits name:paged")
+		})
+
+		Convey("unknown trait parameter", func() {
+			err := ParseFile("./samples/resource_type_errors/trait_param.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"/users: GET: trait paged: query parameter limit: unknown parameter <<maxItems>>")
+		})
+
+		Convey("unknown parameter of a key", func() {
+			err := ParseFile("./samples/resource_type_errors/key_param.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"key_param.raml:10:3: /users: resource type collection: GET: header <<tokenName>>: unknown parameter <<tokenName>>")
+		})
+
+		Convey("unused parameters", func() {
+			err := ParseFile("./samples/resource_type_errors/unused_param.raml", new(APIDefinition))
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring,
+				"unused_param.raml:9:3: /users: GET: trait paged: unused parameter <<maxItems>>, <<offset>>")
+		})
+	})
+}
@@ -1,7 +1,12 @@
 package raml
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
 )
 
 var (
@@ -52,6 +57,10 @@ type ResourceType struct {
 	// Briefly describes what the resource type
 	Description string
 
+	// The resource type that this resource type inherits.
+	// The resources which apply this resource type inherit it too.
+	Type *DefinitionChoice `yaml:"type"`
+
 	// As in Resource.
 	URIParameters map[string]NamedParameter `yaml:"uriParameters"`
 
@@ -62,6 +71,10 @@ type ResourceType struct {
 	// Individual methods can override this declaration.
 	Is []DefinitionChoice `yaml:"is"`
 
+	// Annotations to be applied to this resource type.
+	// Annotations are not inherited by the resources which apply the resource type.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
 	// In a RESTful API, methods are operations that are performed on a
 	// resource. A method MUST be one of the HTTP methods defined in the
 	// HTTP version 1.1 specification [RFC2616] and its extension,
@@ -95,6 +108,13 @@ type ResourceType struct {
 
 	methods         []*Method // all non-nil methods
 	optionalMethods []*Method // all non-nil optional methods
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// node of the declaration, in which the parameters are substituted
+	// when the resource type is applied
+	node *yaml.Node
 }
 
 // postProcess doing post processing of a resource type after being constructed
@@ -102,49 +122,98 @@ type ResourceType struct {
 // - assign all properties that can't be obtained from RAML document
 // - inherit from other resource type
 // - apply traits
-func (rt *ResourceType) postProcess(name string, traitsMap map[string]Trait) {
+func (rt *ResourceType) postProcess(name string, traitsMap map[string]Trait) error {
 	rt.Name = name
-	rt.setMethods(traitsMap)
+	if err := rt.setMethods(nil, traitsMap); err != nil {
+		return newError(rt.Position, "resource type %v: %v", name, err)
+	}
 	rt.setOptionalMethods()
+	return nil
+}
+
+// apply returns the resource type which is applied to resource r,
+// the parameters are substituted in the whole resource type
+// and its traits are applied.
+func (rt *ResourceType) apply(r *Resource, params DefinitionParameters, traitsMap map[string]Trait) (*ResourceType, error) {
+	if rt.node == nil {
+		return rt, nil
+	}
+	n, err := substituteDecl(rt.node, params, initResourceTypeDicts(r, nil))
+	if err != nil {
+		return nil, err
+	}
 
-	// TODO : inherit from other resource type
+	applied := &ResourceType{}
+	if err := yaml.UnmarshalNode(n, applied); err != nil {
+		return nil, newParseError(n.File, err)
+	}
+	applied.Name, applied.node = rt.Name, rt.node
+	if err := applied.setMethods(r, traitsMap); err != nil {
+		return nil, err
+	}
+	applied.setOptionalMethods()
+	return applied, nil
+}
 
-	// TODO : apply traits
+// checkBaseResourceTypes checks that the resource types inherited by
+// the declared resource types exist and that no resource type inherits itself.
+// resourceTypes are all resource types which can be inherited.
+func checkBaseResourceTypes(declared, resourceTypes map[string]ResourceType) error {
+	var names []string
+	for name := range declared {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		rt := declared[name]
+		path := []string{name}
+		for rt.Type != nil && rt.Type.Name != "" {
+			base := rt.Type.Name
+			for _, inherited := range path {
+				if inherited == base {
+					return newError(declared[name].Position, "resource type %v: inheritance cycle %v",
+						name, strings.Join(append(path, base), " -> "))
+				}
+			}
+			baseRt, ok := resourceTypes[base]
+			if !ok {
+				return newError(rt.Position, "resource type %v: can't find resource type named :%v", rt.Name, base)
+			}
+			path = append(path, base)
+			rt = baseRt
+		}
+	}
+	return nil
 }
 
-// set methods set all methods name
-// and add it to methods slice
-func (rt *ResourceType) setMethods(traitsMap map[string]Trait) {
-	if rt.Get != nil {
-		rt.Get.Name = "GET"
-		rt.Get.inheritFromTraits(nil, append(rt.Is, rt.Get.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Get)
-	}
-	if rt.Post != nil {
-		rt.Post.Name = "POST"
-		rt.Post.inheritFromTraits(nil, append(rt.Is, rt.Post.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Post)
-	}
-	if rt.Put != nil {
-		rt.Put.Name = "PUT"
-		rt.Put.inheritFromTraits(nil, append(rt.Is, rt.Put.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Put)
-	}
-	if rt.Patch != nil {
-		rt.Patch.Name = "PATCH"
-		rt.Patch.inheritFromTraits(nil, append(rt.Is, rt.Patch.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Patch)
-	}
-	if rt.Head != nil {
-		rt.Head.Name = "HEAD"
-		rt.Head.inheritFromTraits(nil, append(rt.Is, rt.Head.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Head)
-	}
-	if rt.Delete != nil {
-		rt.Delete.Name = "DELETE"
-		rt.Delete.inheritFromTraits(nil, append(rt.Is, rt.Delete.Is...), traitsMap)
-		rt.methods = append(rt.methods, rt.Delete)
+// set methods set all methods name, apply the traits
+// and add it to methods slice.
+// r is the resource the resource type is applied to,
+// it is nil for the declaration of the resource type.
+func (rt *ResourceType) setMethods(r *Resource, traitsMap map[string]Trait) error {
+	methods := []struct {
+		name string
+		m    *Method
+	}{
+		{"GET", rt.Get},
+		{"POST", rt.Post},
+		{"PUT", rt.Put},
+		{"PATCH", rt.Patch},
+		{"HEAD", rt.Head},
+		{"DELETE", rt.Delete},
 	}
+	for _, v := range methods {
+		if v.m == nil {
+			continue
+		}
+		v.m.Name = v.name
+		if err := v.m.inheritFromTraits(r, append(rt.Is, v.m.Is...), traitsMap); err != nil {
+			return fmt.Errorf("%v: %v", v.name, err)
+		}
+		rt.methods = append(rt.methods, v.m)
+	}
+	return nil
 }
 
 // setOptionalMethods set name of all optional methods
@@ -183,6 +252,16 @@ func initResourceTypeDicts(r *Resource, dicts map[string]interface{}) map[string
 	if r != nil {
 		dicts["resourcePathName"] = r.CleanURI()
 		dicts["resourcePath"] = r.FullURI()
+	} else {
+		// the traits of a resource type are applied before
+		// the resource type is applied to a resource
+		dicts["resourcePathName"] = resourceParam{}
+		dicts["resourcePath"] = resourceParam{}
 	}
 	return dicts
 }
+
+// resourceParam is the value of a reserved parameter which
+// is only known when the resource type is applied to a resource.
+// The parameter is kept to be substituted at that time.
+type resourceParam struct{}
@@ -0,0 +1,143 @@
+package raml
+
+// This file contains the translation of the types defined by
+// a JSON schema or an XML schema into RAML types.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// schemaSource returns the JSON or XML schema which defines a type,
+// empty if the type is not defined by a schema.
+func schemaSource(t Type) string {
+	if expr, ok := t.Type.(string); ok && isSchemaExpr(expr) {
+		return strings.TrimSpace(expr)
+	}
+	if t.Type == nil {
+		if schema, ok := t.Schema.(string); ok && isSchemaExpr(schema) {
+			return strings.TrimSpace(schema)
+		}
+	}
+	return ""
+}
+
+// isJSONSchema returns true if a schema is a JSON schema,
+// otherwise it is an XML schema.
+func isJSONSchema(schema string) bool {
+	return strings.HasPrefix(schema, "{")
+}
+
+// schemaTypes returns the types of a document in which the types defined
+// by a JSON or XML schema are replaced by the equivalent RAML types.
+// The types defined inside the schemas are added to the types,
+// their name is prefixed by the name of the type which includes the schema.
+// It also returns the error of the schemas which can't be translated.
+func schemaTypes(types map[string]Type) (map[string]Type, map[string]error) {
+	var names []string
+	for name, t := range types {
+		if schemaSource(t) != "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return types, nil
+	}
+	sort.Strings(names)
+
+	result := map[string]Type{}
+	for name, t := range types {
+		result[name] = t
+	}
+	errs := map[string]error{}
+	for _, name := range names {
+		t := types[name]
+		namer := &schemaTypeNamer{prefix: name, types: result}
+
+		var translated map[string]Type
+		var err error
+		schema := schemaSource(t)
+		if isJSONSchema(schema) {
+			translated, err = jsonSchemaToTypes(name, schema, namer)
+		} else {
+			translated, err = xmlSchemaToTypes(name, schema, namer)
+		}
+		if err != nil {
+			for _, reserved := range namer.reserved {
+				delete(result, reserved)
+			}
+			errs[name] = err
+			continue
+		}
+
+		for typeName, st := range translated {
+			st.Position = t.Position
+			if typeName == name { // the type itself keeps its own declarations
+				st = withSchemaDecl(t, st)
+			}
+			result[typeName] = st
+		}
+	}
+	return result, errs
+}
+
+// withSchemaDecl returns the type translated from a schema
+// with the declarations of the type which includes the schema
+func withSchemaDecl(t, st Type) Type {
+	if t.Description != "" {
+		st.Description = t.Description
+	}
+	st.DisplayName = t.DisplayName
+	st.Example = t.Example
+	st.Examples = t.Examples
+	st.Annotations = t.Annotations
+	st.Facets = t.Facets
+	st.FacetValues = t.FacetValues
+	return st
+}
+
+// schemaTypeNamer names the types defined inside a schema
+type schemaTypeNamer struct {
+	prefix string          // name of the type which includes the schema
+	types  map[string]Type // types of the document, to avoid name collision
+
+	reserved []string // names given by the namer
+}
+
+// name returns an unused type name made of the prefix and the given name,
+// e.g. `PersonAddress` for the `address` definition of the `Person` schema.
+func (stn *schemaTypeNamer) name(name string) string {
+	base := stn.prefix + toTypeName(name)
+	typeName := base
+	for i := 2; ; i++ {
+		if _, ok := stn.types[typeName]; !ok {
+			break
+		}
+		typeName = fmt.Sprintf("%v%v", base, i)
+	}
+	stn.types[typeName] = Type{} // reserve it
+	stn.reserved = append(stn.reserved, typeName)
+	return typeName
+}
+
+// toTypeName converts a schema name into a type name,
+// e.g. `phone-number` into `PhoneNumber`
+func toTypeName(name string) string {
+	var result []rune
+	upper := true
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			result = append(result, r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	return string(result)
+}
@@ -6,9 +6,12 @@ package raml
 type SecuritySchemeMethod struct {
 	Headers         map[HTTPHeader]Header     `yaml:"headers"`
 	QueryParameters map[string]NamedParameter `yaml:"queryParameters"`
-	QueryString     map[string]NamedParameter `yaml:"queryString"`
+	QueryString     *Type                     `yaml:"queryString"`
 	Responses       map[HTTPCode]Response     `yaml:"responses"`
-	// TODO annotation
+	Annotations     Annotations               `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 // SecurityScheme defines mechanisms to secure data access, identify
@@ -44,4 +47,10 @@ type SecurityScheme struct {
 
 	// The settings attribute MAY be used to provide security scheme-specific information.
 	Settings map[string]Any `yaml:"settings"`
+
+	// Annotations to be applied to this security scheme.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
@@ -0,0 +1,945 @@
+package raml
+
+// This file contains the RAML serializer, which writes an API definition
+// and the libraries it uses as RAML 1.0 documents.
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
+)
+
+const (
+	// prefix of the scalar which stands for an `!include` directive
+	// until the document is marshaled, the YAML encoder can't write tags
+	includeMarker = "\x00" + includeTag + " "
+
+	// file name of an API definition which has no file name
+	defaultRootFile = "api.raml"
+)
+
+var (
+	includeMarkerRe = regexp.MustCompile(`"\\0` + includeTag + ` ([^"]*)"`)
+
+	// parameters of the traits and resource types which are
+	// given by the parser, they are not written
+	reservedParams = map[string]bool{
+		"resourcePath":     true,
+		"resourcePathName": true,
+		"methodName":       true,
+	}
+
+	// HTTP methods of a resource, in the order they are written
+	resourceMethods = []string{"get", "put", "post", "patch", "delete", "head", "options"}
+)
+
+// MarshalOptions are the options of the RAML serializer
+type MarshalOptions struct {
+	// KeepIncludes writes the declarations, the documentation items and the resources
+	// which were included from another file back to this file, which is `!include`d.
+	// By default they are written in the document which includes them.
+	// The examples and the other values included from a file are always inlined.
+	KeepIncludes bool
+}
+
+// Documents are the RAML documents of a serialized API definition
+type Documents struct {
+	// Path of the API definition document
+	Root string
+
+	// Content of the documents by their path, which is relative
+	// to the directory of the API definition:
+	// the API definition, the libraries it uses and the included files.
+	Files map[string][]byte
+}
+
+// Save writes the documents in a directory
+func (docs *Documents) Save(dir string) error {
+	var paths []string
+	for path := range docs.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		filePath := filepath.Join(dir, filepath.FromSlash(path))
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil || filepath.IsAbs(filepath.FromSlash(path)) ||
+			rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("can't write %v outside of %v", path, dir)
+		}
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(filePath, docs.Files[path], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalRAML serializes an API definition into RAML 1.0 documents.
+// The libraries are written in the files of their `uses` path.
+//
+// The resources are written as they are in the API definition, in which
+// the traits, the resource types and the default media types are applied.
+// Their `is` and `type` are kept, applying them again doesn't change the resources.
+// The traits and the resource types are written as they are declared,
+// their parameters are not substituted.
+//
+// The documents are deterministic: the declarations, the resources and
+// the other mappings are sorted by their key.
+func (apiDef *APIDefinition) MarshalRAML(opts MarshalOptions) (*Documents, error) {
+	s := &serializer{
+		opts:  opts,
+		dir:   filepath.Dir(apiDef.Filename),
+		files: map[string][]byte{},
+	}
+	root := filepath.Base(apiDef.Filename)
+	if apiDef.Filename == "" {
+		root = defaultRootFile
+	}
+
+	s.write(root, ramlVersionHeader, s.in(apiDef.Filename).apiDefinition(apiDef))
+	s.writeLibraries("", apiDef.Uses, apiDef.Libraries)
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &Documents{
+		Root:  root,
+		Files: s.files,
+	}, nil
+}
+
+// serializer writes the documents of an API definition
+type serializer struct {
+	opts  MarshalOptions
+	dir   string            // directory of the API definition
+	files map[string][]byte // written documents by their path
+	err   error             // first error
+}
+
+// docSerializer creates the values of the nodes of a document
+type docSerializer struct {
+	*serializer
+	file string // file of the document, as it was parsed
+}
+
+// in returns the serializer of the values of a document
+func (s *serializer) in(file string) *docSerializer {
+	return &docSerializer{
+		serializer: s,
+		file:       file,
+	}
+}
+
+// fail records the first error
+func (s *serializer) fail(err error) {
+	if s.err == nil {
+		s.err = err
+	}
+}
+
+// write marshals a document, header is the first line of the document
+func (s *serializer) write(path, header string, v interface{}) {
+	content, err := marshalDocument(header, v)
+	if err != nil {
+		s.fail(fmt.Errorf("%v: %v", path, err))
+		return
+	}
+	s.files[path] = content
+}
+
+// writeLibraries writes the libraries used by a document
+// and the libraries they use, dir is the directory of the document
+// relative to the directory of the API definition
+func (s *serializer) writeLibraries(dir string, uses map[string]string, libraries map[string]*Library) {
+	var names []string
+	for name := range uses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		lib, ok := libraries[name]
+		if !ok {
+			continue
+		}
+		path := filepath.ToSlash(filepath.Join(dir, uses[name]))
+		if _, ok := s.files[path]; ok {
+			continue
+		}
+		s.write(path, ramlVersionHeader+" "+fragmentLibrary, s.in(filepath.Join(s.dir, path)).library(lib))
+		s.writeLibraries(filepath.Dir(path), lib.Uses, lib.Libraries)
+	}
+}
+
+// docPath returns the path of a file relative to the directory of the API definition,
+// false if the file isn't in this directory
+func (s *serializer) docPath(file string) (string, bool) {
+	rel, err := filepath.Rel(s.dir, file)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+// marshalDocument marshals the value of a document
+func marshalDocument(header string, v interface{}) ([]byte, error) {
+	content, err := yaml.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	content = includeMarkerRe.ReplaceAll(content, []byte(includeTag+" $1"))
+	if header != "" {
+		content = append([]byte(header+"\n"), content...)
+	}
+	return content, nil
+}
+
+// includeRef is the path of an included file,
+// it is marshaled as an `!include` directive
+type includeRef string
+
+// MarshalYAML implements yaml.Marshaler
+func (ref includeRef) MarshalYAML() (interface{}, error) {
+	return includeMarker + string(ref), nil
+}
+
+// include returns the value created by build for a node at position pos.
+// When the includes are kept and the node was included from another file,
+// the value is written to this file and the `!include` of the file is returned.
+// kind is the fragment kind of the value, empty if it isn't a fragment.
+func (s *docSerializer) include(pos Position, kind string, build func(*docSerializer) interface{}) interface{} {
+	if !s.opts.KeepIncludes || pos.File == "" || filepath.Clean(pos.File) == filepath.Clean(s.file) {
+		return build(s)
+	}
+	path, ok := s.docPath(pos.File)
+	if !ok {
+		return build(s)
+	}
+	ref, err := filepath.Rel(filepath.Dir(s.file), pos.File)
+	if err != nil {
+		return build(s)
+	}
+
+	// the content of a file which isn't a RAML or YAML file is a string
+	var content []byte
+	v := build(s.in(pos.File))
+	if yamlFileExts[strings.ToLower(filepath.Ext(pos.File))] {
+		header := ""
+		if kind != "" {
+			header = ramlVersionHeader + " " + kind
+		}
+		if content, err = marshalDocument(header, v); err != nil {
+			s.fail(fmt.Errorf("%v: %v", path, err))
+			return v
+		}
+	} else if str, ok := v.(string); ok {
+		content = []byte(str)
+	} else {
+		return build(s)
+	}
+
+	// the same file included with another content, e.g. a resource
+	// which isn't applied the same traits
+	if prev, ok := s.files[path]; ok && !bytes.Equal(prev, content) {
+		return build(s)
+	}
+	s.files[path] = content
+	return includeRef(filepath.ToSlash(ref))
+}
+
+// declaration is a declaration of a type, trait, resource type,
+// security scheme or annotation type
+type declaration struct {
+	pos   Position
+	build func(*docSerializer) interface{}
+}
+
+// declarations returns the mapping of the declarations of a kind.
+// When the includes are kept, all declarations are included from
+// their file if they were declared in the same file.
+func (s *docSerializer) declarations(decls map[string]declaration, kind string) interface{} {
+	if len(decls) == 0 {
+		return nil
+	}
+	var names []string
+	var file string
+	for name, d := range decls {
+		names = append(names, name)
+		file = d.pos.File
+	}
+	sort.Strings(names)
+
+	build := func(s *docSerializer) interface{} {
+		var m mapping
+		for _, name := range names {
+			m.set(name, s.include(decls[name].pos, kind, decls[name].build))
+		}
+		return m
+	}
+
+	for _, d := range decls {
+		if d.pos.File != file {
+			return build(s)
+		}
+	}
+	if len(decls) > 1 {
+		return s.include(Position{File: file}, "", build)
+	}
+	return build(s)
+}
+
+// apiDefinition returns the root mapping of an API definition
+func (s *docSerializer) apiDefinition(apiDef *APIDefinition) mapping {
+	var m mapping
+	m.add("title", apiDef.Title)
+	m.add("description", apiDef.Description)
+	m.add("version", apiDef.Version)
+	m.add("baseUri", apiDef.BaseURI)
+	m.add("baseUriParameters", s.namedParameters(apiDef.BaseURIParameters))
+	m.add("protocols", apiDef.Protocols)
+	switch {
+	case len(apiDef.MediaTypes) == 1:
+		m.add("mediaType", apiDef.MediaTypes[0])
+	case len(apiDef.MediaTypes) > 1:
+		m.add("mediaType", []string(apiDef.MediaTypes))
+	default:
+		m.add("mediaType", apiDef.MediaType)
+	}
+	m.add("documentation", s.documentation(apiDef.Documentation))
+	m.add("uses", stringMapping(apiDef.Uses))
+	var schemas []interface{}
+	for _, sm := range apiDef.Schemas {
+		schemas = append(schemas, stringMapping(sm))
+	}
+	m.add("schemas", schemas)
+	m.add("types", s.types(apiDef.Types))
+	m.add("traits", s.traits(apiDef.Traits))
+	m.add("resourceTypes", s.resourceTypes(apiDef.ResourceTypes))
+	m.add("annotationTypes", s.annotationTypes(apiDef.AnnotationTypes))
+	m.add("securitySchemes", s.securitySchemes(apiDef.SecuritySchemes))
+	m.add("securedBy", definitionChoices(apiDef.SecuredBy))
+	m.addAnnotations(apiDef.Annotations)
+
+	resources := map[string]*Resource{}
+	for uri := range apiDef.Resources {
+		r := apiDef.Resources[uri]
+		resources[uri] = &r
+	}
+	s.addResources(&m, resources)
+	return m
+}
+
+// library returns the root mapping of a library
+func (s *docSerializer) library(lib *Library) mapping {
+	var m mapping
+	m.add("usage", lib.Usage)
+	m.add("uses", stringMapping(lib.Uses))
+	m.add("types", s.types(lib.Types))
+	m.add("traits", s.traits(lib.Traits))
+	m.add("resourceTypes", s.resourceTypes(lib.ResourceTypes))
+	m.add("annotationTypes", s.annotationTypes(lib.AnnotationTypes))
+	m.add("securitySchemes", s.securitySchemes(lib.SecuritySchemes))
+	m.addAnnotations(lib.Annotations)
+	return m
+}
+
+// documentation returns the sequence of the documentation items.
+// When the includes are kept, the whole sequence is included
+// from its file if all items were declared in the same file.
+func (s *docSerializer) documentation(docs []Documentation) interface{} {
+	if len(docs) == 0 {
+		return nil
+	}
+	build := func(s *docSerializer) interface{} {
+		var items []interface{}
+		for _, doc := range docs {
+			doc := doc
+			items = append(items, s.include(doc.Position, fragmentDocumentationItem, func(s *docSerializer) interface{} {
+				var m mapping
+				m.add("title", doc.Title)
+				m.add("content", doc.Content)
+				m.addAnnotations(doc.Annotations)
+				return m
+			}))
+		}
+		return items
+	}
+
+	for _, doc := range docs[1:] {
+		if doc.File != docs[0].File {
+			return build(s)
+		}
+	}
+	if len(docs) > 1 {
+		return s.include(Position{File: docs[0].File}, "", build)
+	}
+	return build(s)
+}
+
+// types returns the mapping of the type declarations
+func (s *docSerializer) types(types map[string]Type) interface{} {
+	decls := map[string]declaration{}
+	for name, t := range types {
+		t := t
+		decls[name] = declaration{
+			pos:   t.Position,
+			build: func(s *docSerializer) interface{} { return s.typeDecl(t) },
+		}
+	}
+	return s.declarations(decls, fragmentDataType)
+}
+
+// typeDecl returns a type declaration, it is a type expression
+// if the type only declares its base type
+func (s *docSerializer) typeDecl(t Type) interface{} {
+	var m mapping
+	m.add("type", yamlValue(t.Type))
+	m.add("schema", yamlValue(t.Schema))
+	m.add("displayName", t.DisplayName)
+	m.add("description", t.Description)
+	m.addValue("default", yamlValue(t.Default))
+	m.add("facets", yamlValue(t.Facets))
+	var names []string
+	for name := range t.FacetValues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		m.set(name, yamlValue(t.FacetValues[name]))
+	}
+	m.add("properties", yamlValue(t.Properties))
+	m.add("minProperties", t.MinProperties)
+	m.add("maxProperties", t.MaxProperties)
+	m.add("additionalProperties", t.AdditionalProperties)
+	m.add("discriminator", t.Discriminator)
+	m.add("discriminatorValue", t.DiscriminatorValue)
+	m.add("items", yamlValue(t.Items))
+	m.add("minItems", t.MinItems)
+	m.add("maxItems", t.MaxItems)
+	m.add("uniqueItems", t.UniqueItems)
+	m.addValue("enum", yamlValue(t.Enum))
+	m.add("pattern", t.Pattern)
+	m.add("minLength", t.MinLength)
+	m.add("maxLength", t.MaxLength)
+	m.add("minimum", t.Minimum)
+	m.add("maximum", t.Maximum)
+	m.add("format", t.Format)
+	m.add("multipleOf", t.MultipleOf)
+	m.add("fileTypes", t.FileTypes)
+	if t.XML != nil {
+		var xml mapping
+		xml.add("attribute", t.XML.Attribute)
+		xml.add("wrapped", t.XML.Wrapped)
+		xml.add("name", t.XML.Name)
+		xml.add("namespace", t.XML.Namespace)
+		xml.add("prefix", t.XML.Prefix)
+		m.set("xml", xml)
+	}
+	m.addValue("example", yamlValue(t.Example))
+	m.addValue("examples", yamlValue(t.Examples))
+	m.addAnnotations(t.Annotations)
+
+	if _, ok := t.Type.(string); ok && len(m) == 1 {
+		return t.Type
+	}
+	return m
+}
+
+// traits returns the mapping of the trait declarations
+func (s *docSerializer) traits(traits map[string]Trait) interface{} {
+	decls := map[string]declaration{}
+	for name, t := range traits {
+		t := t
+		decls[name] = declaration{
+			pos:   t.Position,
+			build: func(s *docSerializer) interface{} { return s.trait(t) },
+		}
+	}
+	return s.declarations(decls, fragmentTrait)
+}
+
+// trait returns a trait declaration
+func (s *docSerializer) trait(t Trait) interface{} {
+	if t.node != nil {
+		return s.nodeValue(t.node)
+	}
+
+	var m mapping
+	m.add("usage", t.Usage)
+	m.add("description", t.Description)
+	m.add("protocols", t.Protocols)
+	m.add("queryParameters", s.namedParameters(t.QueryParameters))
+	m.add("queryParameters?", s.namedParameters(t.OptionalQueryParameters))
+	if t.QueryString != nil {
+		m.set("queryString", s.typeDecl(*t.QueryString))
+	}
+	m.add("headers", s.headers(t.Headers))
+	m.add("headers?", s.headers(t.OptionalHeaders))
+	m.add("body", s.bodies(t.Bodies))
+	m.add("body?", s.bodies(t.OptionalBodies))
+	m.add("responses", s.responses(t.Responses))
+	m.add("responses?", s.responses(t.OptionalResponses))
+	m.addAnnotations(t.Annotations)
+	return m
+}
+
+// resourceTypes returns the mapping of the resource type declarations
+func (s *docSerializer) resourceTypes(resourceTypes map[string]ResourceType) interface{} {
+	decls := map[string]declaration{}
+	for name, rt := range resourceTypes {
+		rt := rt
+		decls[name] = declaration{
+			pos:   rt.Position,
+			build: func(s *docSerializer) interface{} { return s.resourceType(rt) },
+		}
+	}
+	return s.declarations(decls, fragmentResourceType)
+}
+
+// resourceType returns a resource type declaration
+func (s *docSerializer) resourceType(rt ResourceType) interface{} {
+	if rt.node != nil {
+		return s.nodeValue(rt.node)
+	}
+
+	var m mapping
+	m.add("usage", rt.Usage)
+	m.add("description", rt.Description)
+	if rt.Type != nil {
+		m.add("type", definitionChoice(*rt.Type))
+	}
+	m.add("is", definitionChoices(rt.Is))
+	m.add("uriParameters", s.namedParameters(rt.URIParameters))
+	m.add("uriParameters?", s.namedParameters(rt.OptionalURIParameters))
+	m.add("baseUriParameters", s.namedParameters(rt.BaseURIParameters))
+	m.add("baseUriParameters?", s.namedParameters(rt.OptionalBaseURIParameters))
+	methods := map[string]*Method{
+		"get":    rt.Get,
+		"put":    rt.Put,
+		"post":   rt.Post,
+		"patch":  rt.Patch,
+		"delete": rt.Delete,
+		"head":   rt.Head,
+	}
+	optionalMethods := map[string]*Method{
+		"get":    rt.OptionalGet,
+		"put":    rt.OptionalPut,
+		"post":   rt.OptionalPost,
+		"patch":  rt.OptionalPatch,
+		"delete": rt.OptionalDelete,
+		"head":   rt.OptionalHead,
+	}
+	for _, name := range resourceMethods {
+		if method := methods[name]; method != nil {
+			m.set(name, s.method(method))
+		}
+		if method := optionalMethods[name]; method != nil {
+			m.set(name+"?", s.method(method))
+		}
+	}
+	m.addAnnotations(rt.Annotations)
+	return m
+}
+
+// annotationTypes returns the mapping of the annotation type declarations
+func (s *docSerializer) annotationTypes(annotationTypes map[string]AnnotationType) interface{} {
+	decls := map[string]declaration{}
+	for name, at := range annotationTypes {
+		at := at
+		decls[name] = declaration{
+			pos:   at.Position,
+			build: func(s *docSerializer) interface{} { return s.annotationType(at) },
+		}
+	}
+	return s.declarations(decls, fragmentAnnotationTypeDeclaration)
+}
+
+// annotationType returns an annotation type declaration, it is
+// a type expression if the annotation type only declares its type
+func (s *docSerializer) annotationType(at AnnotationType) interface{} {
+	var m mapping
+	m.add("type", yamlValue(at.Type))
+	m.add("displayName", at.DisplayName)
+	m.add("description", at.Description)
+	m.add("properties", yamlValue(at.Properties))
+	m.addValue("enum", yamlValue(at.Enum))
+	m.add("allowedTargets", yamlValue(at.AllowedTargets))
+	m.addAnnotations(at.Annotations)
+
+	if _, ok := at.Type.(string); ok && len(m) == 1 {
+		return at.Type
+	}
+	return m
+}
+
+// securitySchemes returns the mapping of the security scheme declarations
+func (s *docSerializer) securitySchemes(schemes map[string]SecurityScheme) interface{} {
+	decls := map[string]declaration{}
+	for name, ss := range schemes {
+		ss := ss
+		decls[name] = declaration{
+			pos:   ss.Position,
+			build: func(s *docSerializer) interface{} { return s.securityScheme(ss) },
+		}
+	}
+	return s.declarations(decls, fragmentSecurityScheme)
+}
+
+// securityScheme returns a security scheme declaration
+func (s *docSerializer) securityScheme(ss SecurityScheme) interface{} {
+	var describedBy mapping
+	describedBy.add("headers", s.headers(ss.DescribedBy.Headers))
+	describedBy.add("queryParameters", s.namedParameters(ss.DescribedBy.QueryParameters))
+	if ss.DescribedBy.QueryString != nil {
+		describedBy.set("queryString", s.typeDecl(*ss.DescribedBy.QueryString))
+	}
+	describedBy.add("responses", s.responses(ss.DescribedBy.Responses))
+	describedBy.addAnnotations(ss.DescribedBy.Annotations)
+
+	var m mapping
+	m.add("type", ss.Type)
+	m.add("displayName", ss.DisplayName)
+	m.add("description", ss.Description)
+	m.add("describedBy", describedBy)
+	settings := map[string]interface{}{}
+	for k, v := range ss.Settings {
+		settings[k] = v
+	}
+	m.add("settings", yamlValue(settings))
+	m.addAnnotations(ss.Annotations)
+	return m
+}
+
+// addResources adds resources to a mapping, sorted by their URI
+func (s *docSerializer) addResources(m *mapping, resources map[string]*Resource) {
+	var uris []string
+	for uri := range resources {
+		uris = append(uris, uri)
+	}
+	sort.Strings(uris)
+
+	for _, uri := range uris {
+		r := resources[uri]
+		m.set(uri, s.include(r.Position, "", func(s *docSerializer) interface{} {
+			return s.resource(r)
+		}))
+	}
+}
+
+// resource returns a resource and its nested resources
+func (s *docSerializer) resource(r *Resource) interface{} {
+	var m mapping
+	m.add("displayName", r.DisplayName)
+	m.add("description", r.Description)
+	if r.Type != nil {
+		m.add("type", definitionChoice(*r.Type))
+	}
+	m.add("is", definitionChoices(r.Is))
+	m.add("securedBy", definitionChoices(r.SecuredBy))
+	m.add("uriParameters", s.namedParameters(r.URIParameters))
+	methods := map[string]*Method{
+		"get":     r.Get,
+		"put":     r.Put,
+		"post":    r.Post,
+		"patch":   r.Patch,
+		"delete":  r.Delete,
+		"head":    r.Head,
+		"options": r.Options,
+	}
+	for _, name := range resourceMethods {
+		if method := methods[name]; method != nil {
+			m.set(name, s.method(method))
+		}
+	}
+	m.addAnnotations(r.Annotations)
+	s.addResources(&m, r.Nested)
+	return m
+}
+
+// method returns a method
+func (s *docSerializer) method(method *Method) interface{} {
+	var m mapping
+	m.add("displayName", method.DisplayName)
+	m.add("description", method.Description)
+	m.add("is", definitionChoices(method.Is))
+	m.add("securedBy", definitionChoices(method.SecuredBy))
+	m.add("protocols", method.Protocols)
+	m.add("queryParameters", s.namedParameters(method.QueryParameters))
+	if method.QueryString != nil {
+		m.set("queryString", s.typeDecl(*method.QueryString))
+	}
+	m.add("headers", s.headers(method.Headers))
+	m.add("body", s.bodies(method.Bodies))
+	m.add("responses", s.responses(method.Responses))
+	m.addAnnotations(method.Annotations)
+	return m
+}
+
+// responses returns the mapping of the responses, sorted by status code
+func (s *docSerializer) responses(responses map[HTTPCode]Response) interface{} {
+	var codes []int
+	for code := range responses {
+		codes = append(codes, int(code))
+	}
+	sort.Ints(codes)
+
+	var m mapping
+	for _, code := range codes {
+		resp := responses[HTTPCode(code)]
+		var rm mapping
+		rm.add("description", resp.Description)
+		rm.add("headers", s.headers(resp.Headers))
+		rm.add("body", s.bodies(resp.Bodies))
+		rm.addAnnotations(resp.Annotations)
+		m.set(code, rm)
+	}
+	return m
+}
+
+// bodies returns the body of a request or a response,
+// followed by the bodies of each media type
+func (s *docSerializer) bodies(b Bodies) interface{} {
+	var m mapping
+	m.add("type", b.Type)
+	m.add("schema", b.Schema)
+	m.add("description", b.Description)
+	m.add("properties", yamlValue(b.Properties))
+	m.add("formParameters", s.namedParameters(b.FormParameters))
+	m.addValue("example", yamlValue(b.Example))
+	m.addValue("examples", yamlValue(b.Examples))
+	m.addAnnotations(b.Annotations)
+
+	for _, mt := range b.MediaTypes() {
+		body := b.ForMIMEType[mt]
+		var bm mapping
+		bm.add("type", body.Type)
+		bm.add("schema", body.Schema)
+		bm.add("description", body.Description)
+		bm.add("properties", yamlValue(body.Properties))
+		bm.add("formParameters", s.namedParameters(body.FormParameters))
+		bm.add("headers", s.headers(body.Headers))
+		bm.addValue("example", yamlValue(body.Example))
+		bm.addValue("examples", yamlValue(body.Examples))
+		bm.addAnnotations(body.Annotations)
+		m.set(mt, bm)
+	}
+	return m
+}
+
+// headers returns the mapping of the headers
+func (s *docSerializer) headers(headers map[HTTPHeader]Header) interface{} {
+	nps := map[string]NamedParameter{}
+	for name, h := range headers {
+		nps[string(name)] = NamedParameter(h)
+	}
+	return s.namedParameters(nps)
+}
+
+// namedParameters returns the mapping of the parameters, sorted by name.
+// The RAML 1.0 parameters are required by default, only `required: false` is written.
+func (s *docSerializer) namedParameters(nps map[string]NamedParameter) interface{} {
+	var names []string
+	for name := range nps {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var m mapping
+	for _, name := range names {
+		np := nps[name]
+		var pm mapping
+		pm.add("displayName", np.DisplayName)
+		pm.add("description", np.Description)
+		pm.add("type", np.Type)
+		pm.addValue("enum", yamlValue(np.Enum))
+		pm.add("pattern", np.Pattern)
+		pm.add("minLength", np.MinLength)
+		pm.add("maxLength", np.MaxLength)
+		pm.add("minimum", np.Minimum)
+		pm.add("maximum", np.Maximum)
+		pm.add("format", np.Format)
+		pm.add("multipleOf", np.MultipleOf)
+		pm.add("items", yamlValue(np.Items))
+		pm.add("minItems", np.MinItems)
+		pm.add("maxItems", np.MaxItems)
+		pm.add("uniqueItems", np.UniqueItems)
+		pm.add("fileTypes", np.FileTypes)
+		pm.add("repeat", np.Repeat)
+		if !np.Required {
+			pm.set("required", false)
+		}
+		pm.addValue("default", yamlValue(np.Default))
+		pm.addValue("example", yamlValue(np.Example))
+		pm.addAnnotations(np.Annotations)
+		m.set(name, pm)
+	}
+	return m
+}
+
+// nodeValue returns the value of a YAML node, its mappings keep their order
+func (s *docSerializer) nodeValue(n *yaml.Node) interface{} {
+	var v interface{}
+	var err error
+	if n.Kind == yaml.MappingNode {
+		var ms yaml.MapSlice
+		err = yaml.UnmarshalNode(n, &ms)
+		v = ms
+	} else {
+		err = yaml.UnmarshalNode(n, &v)
+	}
+	if err != nil {
+		s.fail(newParseError(n.File, err))
+	}
+	return yamlValue(v)
+}
+
+// mapping is a YAML mapping whose keys keep their order
+type mapping []yaml.MapItem
+
+// add adds a key to the mapping if its value is not empty
+func (m *mapping) add(key interface{}, value interface{}) {
+	if isEmptyValue(value) {
+		return
+	}
+	m.set(key, value)
+}
+
+// addValue adds a key to the mapping if it has a value,
+// which can be empty, e.g. an example
+func (m *mapping) addValue(key interface{}, value interface{}) {
+	if value == nil {
+		return
+	}
+	m.set(key, value)
+}
+
+// set adds a key and its value to the mapping
+func (m *mapping) set(key interface{}, value interface{}) {
+	*m = append(*m, yaml.MapItem{Key: key, Value: value})
+}
+
+// addAnnotations adds the annotations to the mapping, sorted by name
+func (m *mapping) addAnnotations(annotations Annotations) {
+	var keys []string
+	for key := range annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		m.set(key, yamlValue(annotations[key]))
+	}
+}
+
+// isEmptyValue returns true if a value is nil, a nil pointer,
+// an empty string or an empty map or slice
+func isEmptyValue(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Invalid:
+		return true
+	case reflect.Ptr, reflect.Interface:
+		return rv.IsNil()
+	case reflect.Map, reflect.Slice, reflect.String:
+		return rv.Len() == 0
+	}
+	return false
+}
+
+// definitionChoice returns the name of an applied trait, resource type
+// or security scheme, and its parameters if it has parameters
+func definitionChoice(dc DefinitionChoice) interface{} {
+	if dc.Name == "" {
+		return nil
+	}
+	params := map[string]interface{}{}
+	for name, val := range dc.Parameters {
+		if !reservedParams[name] {
+			params[name] = val
+		}
+	}
+	if len(params) == 0 {
+		return dc.Name
+	}
+	return mapping{{Key: dc.Name, Value: yamlValue(params)}}
+}
+
+// definitionChoices returns the sequence of the applied traits or security schemes
+func definitionChoices(dcs []DefinitionChoice) interface{} {
+	var choices []interface{}
+	for _, dc := range dcs {
+		choices = append(choices, definitionChoice(dc))
+	}
+	return choices
+}
+
+// stringMapping returns a mapping of strings sorted by key
+func stringMapping(sm map[string]string) interface{} {
+	values := map[string]interface{}{}
+	for k, v := range sm {
+		values[k] = v
+	}
+	return yamlValue(values)
+}
+
+// yamlValue returns a value decoded by the YAML parser,
+// in which the maps are sorted by key
+func yamlValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case map[interface{}]interface{}:
+		values := map[string]interface{}{}
+		keys := map[string]interface{}{}
+		for k, item := range val {
+			key := fmt.Sprint(k)
+			values[key], keys[key] = item, k
+		}
+		m := sortedMapping(values)
+		for i := range m {
+			m[i].Key = keys[m[i].Key.(string)]
+		}
+		return m
+	case map[string]interface{}:
+		if len(val) == 0 {
+			return nil
+		}
+		return sortedMapping(val)
+	case yaml.MapSlice:
+		m := make(mapping, 0, len(val))
+		for _, item := range val {
+			m.set(item.Key, yamlValue(item.Value))
+		}
+		return m
+	case []interface{}:
+		items := make([]interface{}, 0, len(val))
+		for _, item := range val {
+			items = append(items, yamlValue(item))
+		}
+		return items
+	}
+	return v
+}
+
+// sortedMapping returns the mapping of a map sorted by key
+func sortedMapping(values map[string]interface{}) mapping {
+	var keys []string
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	m := make(mapping, 0, len(keys))
+	for _, k := range keys {
+		m.set(k, yamlValue(values[k]))
+	}
+	return m
+}
@@ -0,0 +1,271 @@
+package raml
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestMarshalRAML(t *testing.T) {
+	Convey("RAML serializer", t, func() {
+		apiDef := new(APIDefinition)
+
+		// parse the serialized documents
+		parseDocs := func(docs *Documents) *APIDefinition {
+			parsed := new(APIDefinition)
+			err := NewParser(ParserOptions{FileLoader: MemFileLoader(docs.Files)}).ParseFile(docs.Root, parsed)
+			So(err, ShouldBeNil)
+			return parsed
+		}
+
+		Convey("round trip", func() {
+			files := []string{
+				"./samples/congo/api.raml",
+				"./samples/resource_types.raml",
+				"./samples/resource_type_params.raml",
+				"./samples/resource_type_inheritance.raml",
+				"./samples/annotations.raml",
+				"./samples/media_types.raml",
+				"./samples/nested_libraries/api.raml",
+				"./samples/fragments/api.raml",
+				"./samples/raml08/api.raml",
+				"./samples/types/builtin_facets.raml",
+				"./samples/types/nullable.raml",
+			}
+			for _, file := range files {
+				for _, keepIncludes := range []bool{false, true} {
+					apiDef := new(APIDefinition)
+					So(ParseFile(file, apiDef), ShouldBeNil)
+					opts := MarshalOptions{KeepIncludes: keepIncludes}
+					docs, err := apiDef.MarshalRAML(opts)
+					So(err, ShouldBeNil)
+
+					// the serialized API definition is serialized the same way
+					parsed := parseDocs(docs)
+					So(parsed.Title, ShouldEqual, apiDef.Title)
+					So(len(parsed.Types), ShouldEqual, len(apiDef.Types))
+					So(len(parsed.Resources), ShouldEqual, len(apiDef.Resources))
+					So(len(parsed.Libraries), ShouldEqual, len(apiDef.Libraries))
+					again, err := parsed.MarshalRAML(opts)
+					So(err, ShouldBeNil)
+					So(again, ShouldResemble, docs)
+				}
+			}
+		})
+
+		Convey("references of the library resource types and traits", func() {
+			files := []string{
+				"./samples/simple_with_lib.raml",
+				"./samples/nested_libraries/api.raml",
+				"./samples/bundle/api.raml",
+				"../codegen/fixtures/libraries/api.raml",
+			}
+			for _, file := range files {
+				apiDef := new(APIDefinition)
+				So(ParseFile(file, apiDef), ShouldBeNil)
+				So(Validate(apiDef), ShouldBeEmpty)
+				docs, err := apiDef.MarshalRAML(MarshalOptions{})
+				So(err, ShouldBeNil)
+
+				// the applied names are qualified by the library which declares them
+				parsed := parseDocs(docs)
+				So(Validate(parsed), ShouldBeEmpty)
+			}
+
+			So(ParseFile("./samples/simple_with_lib.raml", apiDef), ShouldBeNil)
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			content := string(docs.Files[docs.Root])
+			So(content, ShouldContainSubstring, "type: files.file-type.File\n")
+		})
+
+		Convey("resources", func() {
+			err := ParseFile("./samples/resource_type_params.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			So(docs.Root, ShouldEqual, "resource_type_params.raml")
+			So(docs.Files, ShouldHaveLength, 1)
+
+			content := string(docs.Files[docs.Root])
+			So(content, ShouldStartWith, "#%RAML 1.0\ntitle: Resource type and trait parameters\n")
+
+			// the declarations are written as they are declared
+			So(content, ShouldContainSubstring, "        description: token to <<methodName>> the <<resourcePathName>>\n")
+
+			// the traits and resource types are applied to the resources,
+			// without the parameters given by the parser
+			So(content, ShouldContainSubstring, "        description: token to get the colors\n")
+			So(content, ShouldContainSubstring, "  type:\n    collection:\n      createdCode: 201\n")
+			So(content, ShouldNotContainSubstring, "resourcePathName: colors")
+
+			parsed := parseDocs(docs)
+			colors := parsed.Resources["/colors"]
+			So(colors.Post.Bodies.ForMIMEType["application/json"].Properties, ShouldContainKey, "name")
+			So(colors.Post.Responses, ShouldContainKey, HTTPCode(201))
+			So(colors.Get.Headers, ShouldContainKey, HTTPHeader("X-Color-Token"))
+		})
+
+		Convey("libraries", func() {
+			err := ParseFile("./samples/nested_libraries/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			So(docs.Files, ShouldContainKey, "libs/lib.raml")
+			So(docs.Files, ShouldContainKey, "libs/sub.raml")
+			So(string(docs.Files["libs/lib.raml"]), ShouldStartWith, "#%RAML 1.0 Library\n")
+			So(string(docs.Files[docs.Root]), ShouldContainSubstring, "uses:\n  lib: libs/lib.raml\n")
+		})
+
+		Convey("flatten the includes", func() {
+			err := ParseFile("./samples/fragments/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			So(docs.Files, ShouldHaveLength, 2)
+			So(docs.Files, ShouldContainKey, "libs/common.raml")
+
+			content := string(docs.Files[docs.Root])
+			So(content, ShouldNotContainSubstring, includeTag)
+			So(content, ShouldContainSubstring, "types:\n  Person:\n    type: object\n")
+
+			// the libraries used by the fragments are used by the API definition
+			So(content, ShouldContainSubstring, "uses:\n  common: libs/common.raml\n")
+		})
+
+		Convey("keep the includes", func() {
+			err := ParseFile("./samples/fragments/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{KeepIncludes: true})
+			So(err, ShouldBeNil)
+
+			content := string(docs.Files[docs.Root])
+			So(content, ShouldContainSubstring, "documentation:\n- !include docs/intro.raml\n")
+			So(content, ShouldContainSubstring, "  Person: !include types/person.raml\n")
+			So(content, ShouldContainSubstring, "  paged: !include traits/paged.raml\n")
+			So(content, ShouldContainSubstring, "  collection: !include resource-types/collection.raml\n")
+			So(content, ShouldContainSubstring, "  token: !include security/token.raml\n")
+			So(content, ShouldContainSubstring, "  audited: !include annotations/audited.raml\n")
+
+			// the included fragments
+			So(string(docs.Files["types/person.raml"]), ShouldStartWith, "#%RAML 1.0 DataType\ntype: object\n")
+			So(string(docs.Files["traits/paged.raml"]), ShouldStartWith, "#%RAML 1.0 Trait\n")
+			So(string(docs.Files["docs/intro.raml"]), ShouldEqual,
+				"#%RAML 1.0 DocumentationItem\ntitle: Introduction\ncontent: The persons API\n")
+
+			// the examples are inlined
+			So(docs.Files, ShouldNotContainKey, "examples/persons.raml")
+			So(content, ShouldContainSubstring, "            examples:\n              alice:\n")
+		})
+
+		Convey("API definition created in Go", func() {
+			minLength := 1
+			apiDef := &APIDefinition{
+				Title:      "Users API",
+				MediaTypes: MediaTypes{"application/json"},
+				Types: map[string]Type{
+					"User": {
+						Type: "object",
+						Properties: map[string]interface{}{
+							"name":     map[interface{}]interface{}{"type": "string", "minLength": minLength},
+							"age?":     "integer",
+							"nickname": "string?",
+						},
+					},
+					"Users": {Type: "User[]"},
+				},
+				Resources: map[string]Resource{
+					"/users": {
+						Get: &Method{
+							QueryParameters: map[string]NamedParameter{
+								"name": {Type: "string", MinLength: &minLength},
+							},
+							Responses: map[HTTPCode]Response{
+								200: {Bodies: Bodies{ForMIMEType: map[string]Body{"application/json": {Type: "Users"}}}},
+							},
+						},
+						Nested: map[string]*Resource{
+							"/{id}": {Delete: &Method{Responses: map[HTTPCode]Response{204: {}}}},
+						},
+					},
+				},
+			}
+
+			docs, err := apiDef.MarshalRAML(MarshalOptions{})
+			So(err, ShouldBeNil)
+			So(docs.Root, ShouldEqual, "api.raml")
+			So(string(docs.Files["api.raml"]), ShouldEqual, `#%RAML 1.0
+title: Users API
+mediaType: application/json
+types:
+  User:
+    type: object
+    properties:
+      age?: integer
+      name:
+        minLength: 1
+        type: string
+      nickname: string?
+  Users: User[]
+/users:
+  get:
+    queryParameters:
+      name:
+        type: string
+        minLength: 1
+        required: false
+    responses:
+      200:
+        body:
+          application/json:
+            type: Users
+  /{id}:
+    delete:
+      responses:
+        204: {}
+`)
+			parsed := parseDocs(docs)
+			So(Validate(parsed), ShouldBeEmpty)
+		})
+
+		Convey("save", func() {
+			err := ParseFile("./samples/fragments/api.raml", apiDef)
+			So(err, ShouldBeNil)
+			docs, err := apiDef.MarshalRAML(MarshalOptions{KeepIncludes: true})
+			So(err, ShouldBeNil)
+
+			dir, err := ioutil.TempDir("", "")
+			So(err, ShouldBeNil)
+			defer os.RemoveAll(dir)
+			So(docs.Save(dir), ShouldBeNil)
+
+			saved := new(APIDefinition)
+			So(ParseFile(filepath.Join(dir, docs.Root), saved), ShouldBeNil)
+			So(saved.Types, ShouldContainKey, "Person")
+			So(saved.Libraries, ShouldContainKey, "common")
+
+			docs.Files["../outside.raml"] = []byte("#%RAML 1.0 Library\n")
+			So(docs.Save(dir), ShouldNotBeNil)
+			delete(docs.Files, "../outside.raml")
+
+			// the paths are cleaned before they are checked
+			docs.Files["out/../../etc/x"] = []byte("#%RAML 1.0 Library\n")
+			So(docs.Save(dir), ShouldNotBeNil)
+			_, err = os.Stat(filepath.Join(dir, "..", "etc", "x"))
+			So(os.IsNotExist(err), ShouldBeTrue)
+			delete(docs.Files, "out/../../etc/x")
+
+			docs.Files["out/../inside.raml"] = []byte("#%RAML 1.0 Library\n")
+			So(docs.Save(dir), ShouldBeNil)
+			_, err = os.Stat(filepath.Join(dir, "inside.raml"))
+			So(err, ShouldBeNil)
+		})
+	})
+}
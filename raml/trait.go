@@ -2,6 +2,8 @@ package raml
 
 import (
 	"strings"
+
+	"github.com/Jumpscale/go-raml/raml/internal/yaml"
 )
 
 // A Trait is a partial method definition that, like a method, can provide
@@ -30,9 +32,16 @@ type Trait struct {
 	// As in Method.
 	QueryParameters map[string]NamedParameter `yaml:"queryParameters"`
 
+	// As in Method.
+	QueryString *Type `yaml:"queryString"`
+
 	// As in Method.
 	Protocols []string `yaml:"protocols"`
 
+	// Annotations to be applied to this trait.
+	// Annotations are not inherited by the methods which apply the trait.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
 	// When defining resource types and traits, it can be useful to capture
 	// patterns that manifest several levels below the inheriting resource or
 	// method, without requiring the creation of the intermediate levels.
@@ -48,12 +57,39 @@ type Trait struct {
 	OptionalHeaders         map[HTTPHeader]Header     `yaml:"headers?"`
 	OptionalResponses       map[HTTPCode]Response     `yaml:"responses?"`
 	OptionalQueryParameters map[string]NamedParameter `yaml:"queryParameters?"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// node of the declaration, in which the parameters are substituted
+	// when the trait is applied
+	node *yaml.Node
 }
 
 func (t *Trait) postProcess(name string) {
 	t.Name = name
 }
 
+// apply returns the trait which is applied to method m of resource r,
+// the parameters are substituted in the whole trait.
+func (t *Trait) apply(r *Resource, m *Method, params DefinitionParameters) (*Trait, error) {
+	if t.node == nil {
+		return t, nil
+	}
+	n, err := substituteDecl(t.node, params, initTraitDicts(r, m, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	applied := &Trait{}
+	if err := yaml.UnmarshalNode(n, applied); err != nil {
+		return nil, newParseError(n.File, err)
+	}
+	applied.postProcess(t.Name)
+	applied.node = t.node
+	return applied, nil
+}
+
 // init trait dicts
 // trait dicts contain current trait parameters that is currently applied to a method
 func initTraitDicts(r *Resource, m *Method, dicts map[string]interface{}) map[string]interface{} {
@@ -0,0 +1,346 @@
+package raml
+
+// This file contains the tokenizer and parser of RAML type expressions,
+// see http://docs.raml.org/specs/1.0/#raml-10-spec-type-expressions
+//
+// grammar:
+//   union   = array { "|" array }
+//   array   = primary { "[]" | "?" }
+//   primary = name | "(" union ")"
+//
+// `T?` is a shorthand of the nullable type `T | nil`.
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// TypeExprKind is the kind of a type expression node
+type TypeExprKind int
+
+const (
+	// TypeExprName is a type name, e.g. `string`, `Cat` or `lib.City`
+	TypeExprName TypeExprKind = iota
+
+	// TypeExprArray is an array of items, e.g. `Cat[]`
+	TypeExprArray
+
+	// TypeExprUnion is an union of types, e.g. `Cat | Dog`
+	TypeExprUnion
+)
+
+// TypeExpr is a node of the syntax tree of a type expression
+type TypeExpr struct {
+	Kind TypeExprKind
+
+	// Name of the type, only for TypeExprName
+	Name string
+
+	// Items of the array, only for TypeExprArray
+	Items *TypeExpr
+
+	// Members of the union, only for TypeExprUnion
+	Members []*TypeExpr
+
+	// Column of this node in the expression, starting from 1
+	Column int
+}
+
+// TypeExprError is a syntax error in a type expression
+type TypeExprError struct {
+	Expr    string
+	Column  int // column of the error in the expression, starting from 1
+	Message string
+}
+
+func (e *TypeExprError) Error() string {
+	return fmt.Sprintf("invalid type expression `%v` at column %v: %v", e.Expr, e.Column, e.Message)
+}
+
+// IsName returns true if this expression is a type name
+func (te *TypeExpr) IsName() bool {
+	return te.Kind == TypeExprName
+}
+
+// IsArray returns true if this expression is an array
+func (te *TypeExpr) IsArray() bool {
+	return te.Kind == TypeExprArray
+}
+
+// IsUnion returns true if this expression is an union
+func (te *TypeExpr) IsUnion() bool {
+	return te.Kind == TypeExprUnion
+}
+
+// NonNil returns the type of a nullable type expression,
+// e.g. `string` for `string?` or `string | nil`.
+// It returns false if the expression isn't an union of a type and nil.
+func (te *TypeExpr) NonNil() (*TypeExpr, bool) {
+	if te.Kind != TypeExprUnion {
+		return nil, false
+	}
+	var nonNil []*TypeExpr
+	for _, m := range te.Members {
+		if !m.IsName() || m.Name != "nil" {
+			nonNil = append(nonNil, m)
+		}
+	}
+	if len(nonNil) != 1 || len(nonNil) == len(te.Members) {
+		return nil, false
+	}
+	return nonNil[0], true
+}
+
+// Names returns the type names used in this expression,
+// in the order of their appearance.
+func (te *TypeExpr) Names() []*TypeExpr {
+	switch te.Kind {
+	case TypeExprArray:
+		return te.Items.Names()
+	case TypeExprUnion:
+		var names []*TypeExpr
+		for _, m := range te.Members {
+			names = append(names, m.Names()...)
+		}
+		return names
+	}
+	return []*TypeExpr{te}
+}
+
+// String returns the canonical form of this expression
+func (te *TypeExpr) String() string {
+	switch te.Kind {
+	case TypeExprArray:
+		if te.Items.Kind == TypeExprUnion {
+			return "(" + te.Items.String() + ")[]"
+		}
+		return te.Items.String() + "[]"
+	case TypeExprUnion:
+		members := make([]string, 0, len(te.Members))
+		for _, m := range te.Members {
+			members = append(members, m.String())
+		}
+		return strings.Join(members, " | ")
+	}
+	return te.Name
+}
+
+// token types of a type expression
+const (
+	tokenEOF = iota
+	tokenName
+	tokenPipe
+	tokenLParen
+	tokenRParen
+	tokenBrackets
+	tokenQuestion
+)
+
+type typeExprToken struct {
+	typ    int
+	val    string
+	column int
+}
+
+// tokenizeTypeExpr splits a type expression into tokens
+func tokenizeTypeExpr(expr string) ([]typeExprToken, error) {
+	var tokens []typeExprToken
+	runes := []rune(expr)
+	for i := 0; i < len(runes); {
+		c := runes[i]
+		column := i + 1
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '|':
+			tokens = append(tokens, typeExprToken{tokenPipe, "|", column})
+			i++
+		case c == '(':
+			tokens = append(tokens, typeExprToken{tokenLParen, "(", column})
+			i++
+		case c == ')':
+			tokens = append(tokens, typeExprToken{tokenRParen, ")", column})
+			i++
+		case c == '[':
+			if i+1 >= len(runes) || runes[i+1] != ']' {
+				return nil, &TypeExprError{Expr: expr, Column: column, Message: "expected `[]`"}
+			}
+			tokens = append(tokens, typeExprToken{tokenBrackets, "[]", column})
+			i += 2
+		case c == ']':
+			return nil, &TypeExprError{Expr: expr, Column: column, Message: "unexpected `]`"}
+		case c == '?':
+			tokens = append(tokens, typeExprToken{tokenQuestion, "?", column})
+			i++
+		default:
+			start := i
+			for i < len(runes) && !strings.ContainsRune(" \t|()[]?", runes[i]) {
+				i++
+			}
+			tokens = append(tokens, typeExprToken{tokenName, string(runes[start:i]), column})
+		}
+	}
+	return append(tokens, typeExprToken{tokenEOF, "", len(runes) + 1}), nil
+}
+
+// typeExprParser is a recursive descent parser of type expressions
+type typeExprParser struct {
+	expr   string
+	tokens []typeExprToken
+	pos    int
+}
+
+// ParseTypeExpr parses a RAML type expression
+func ParseTypeExpr(expr string) (*TypeExpr, error) {
+	tokens, err := tokenizeTypeExpr(expr)
+	if err != nil {
+		return nil, err
+	}
+	p := &typeExprParser{
+		expr:   expr,
+		tokens: tokens,
+	}
+	te, err := p.parseUnion()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.typ != tokenEOF {
+		return nil, p.unexpected(tok)
+	}
+	return te, nil
+}
+
+func (p *typeExprParser) peek() typeExprToken {
+	return p.tokens[p.pos]
+}
+
+func (p *typeExprParser) next() typeExprToken {
+	tok := p.tokens[p.pos]
+	if tok.typ != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *typeExprParser) unexpected(tok typeExprToken) error {
+	msg := fmt.Sprintf("unexpected `%v`", tok.val)
+	if tok.typ == tokenEOF {
+		msg = "unexpected end of expression"
+	}
+	return &TypeExprError{Expr: p.expr, Column: tok.column, Message: msg}
+}
+
+// union = array { "|" array }
+func (p *typeExprParser) parseUnion() (*TypeExpr, error) {
+	first, err := p.parseArray()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().typ != tokenPipe {
+		return first, nil
+	}
+	union := &TypeExpr{
+		Kind:    TypeExprUnion,
+		Members: []*TypeExpr{first},
+		Column:  first.Column,
+	}
+	for p.peek().typ == tokenPipe {
+		p.next()
+		member, err := p.parseArray()
+		if err != nil {
+			return nil, err
+		}
+		union.Members = append(union.Members, member)
+	}
+	return union, nil
+}
+
+// array = primary { "[]" | "?" }
+func (p *typeExprParser) parseArray() (*TypeExpr, error) {
+	te, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		switch tok := p.peek(); tok.typ {
+		case tokenBrackets:
+			p.next()
+			te = &TypeExpr{
+				Kind:   TypeExprArray,
+				Items:  te,
+				Column: te.Column,
+			}
+		case tokenQuestion:
+			p.next()
+			te = &TypeExpr{
+				Kind: TypeExprUnion,
+				Members: []*TypeExpr{te, &TypeExpr{
+					Kind:   TypeExprName,
+					Name:   "nil",
+					Column: tok.column,
+				}},
+				Column: te.Column,
+			}
+		default:
+			return te, nil
+		}
+	}
+}
+
+// primary = name | "(" union ")"
+func (p *typeExprParser) parsePrimary() (*TypeExpr, error) {
+	tok := p.next()
+	switch tok.typ {
+	case tokenName:
+		return &TypeExpr{
+			Kind:   TypeExprName,
+			Name:   tok.val,
+			Column: tok.column,
+		}, nil
+	case tokenLParen:
+		te, err := p.parseUnion()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.typ != tokenRParen {
+			return nil, &TypeExprError{Expr: p.expr, Column: closing.column,
+				Message: fmt.Sprintf("expected `)` to close `(` at column %v", tok.column)}
+		}
+		return te, nil
+	}
+	return nil, p.unexpected(tok)
+}
+
+// renameTypeNames renames the types of a type expression,
+// the inline schemas and the invalid expressions are not changed
+func renameTypeNames(expr string, rename func(string) string) string {
+	if isSchemaExpr(expr) {
+		return expr
+	}
+	tokens, err := tokenizeTypeExpr(expr)
+	if err != nil {
+		return expr
+	}
+
+	runes := []rune(expr)
+	var buf bytes.Buffer
+	last := 0
+	for _, tok := range tokens {
+		if tok.typ != tokenName {
+			continue
+		}
+		start := tok.column - 1
+		buf.WriteString(string(runes[last:start]))
+		buf.WriteString(rename(tok.val))
+		last = start + len([]rune(tok.val))
+	}
+	buf.WriteString(string(runes[last:]))
+	return buf.String()
+}
+
+// isSchemaExpr returns true if a type expression is an inline JSON or XML schema
+func isSchemaExpr(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	return strings.HasPrefix(expr, "{") || strings.HasPrefix(expr, "<")
+}
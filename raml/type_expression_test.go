@@ -0,0 +1,114 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTypeExpression(t *testing.T) {
+	Convey("Type expression", t, func() {
+		Convey("type name", func() {
+			te, err := ParseTypeExpr("lib.City")
+			So(err, ShouldBeNil)
+			So(te.IsName(), ShouldBeTrue)
+			So(te.Name, ShouldEqual, "lib.City")
+		})
+
+		Convey("array of union", func() {
+			te, err := ParseTypeExpr("(Cat | Dog)[]")
+			So(err, ShouldBeNil)
+			So(te.IsArray(), ShouldBeTrue)
+			So(te.Items.IsUnion(), ShouldBeTrue)
+			So(te.Items.Members, ShouldHaveLength, 2)
+			So(te.Items.Members[1].Name, ShouldEqual, "Dog")
+			So(te.Items.Members[1].Column, ShouldEqual, 8)
+			So(te.String(), ShouldEqual, "(Cat | Dog)[]")
+		})
+
+		Convey("union of arrays", func() {
+			te, err := ParseTypeExpr("string[][] | lib.Type | nil")
+			So(err, ShouldBeNil)
+			So(te.IsUnion(), ShouldBeTrue)
+			So(te.Members, ShouldHaveLength, 3)
+			So(te.Members[0].IsArray(), ShouldBeTrue)
+			So(te.Members[0].Items.IsArray(), ShouldBeTrue)
+			So(te.Members[0].Items.Items.Name, ShouldEqual, "string")
+			So(te.Members[2].Name, ShouldEqual, "nil")
+
+			var names []string
+			for _, n := range te.Names() {
+				names = append(names, n.Name)
+			}
+			So(names, ShouldResemble, []string{"string", "lib.Type", "nil"})
+		})
+
+		Convey("nested parentheses", func() {
+			te, err := ParseTypeExpr("((A | B)[] | C)[]")
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "((A | B)[] | C)[]")
+		})
+
+		Convey("nullable types", func() {
+			te, err := ParseTypeExpr("string?")
+			So(err, ShouldBeNil)
+			So(te.IsUnion(), ShouldBeTrue)
+			So(te.String(), ShouldEqual, "string | nil")
+			nonNil, ok := te.NonNil()
+			So(ok, ShouldBeTrue)
+			So(nonNil.Name, ShouldEqual, "string")
+
+			te, err = ParseTypeExpr("Cat[]?")
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "Cat[] | nil")
+			So(te.Members[1].Column, ShouldEqual, 6)
+
+			te, err = ParseTypeExpr("Cat?[]")
+			So(err, ShouldBeNil)
+			So(te.String(), ShouldEqual, "(Cat | nil)[]")
+			_, ok = te.NonNil()
+			So(ok, ShouldBeFalse)
+
+			te, err = ParseTypeExpr("nil | lib.City")
+			So(err, ShouldBeNil)
+			nonNil, ok = te.NonNil()
+			So(ok, ShouldBeTrue)
+			So(nonNil.Name, ShouldEqual, "lib.City")
+
+			for _, expr := range []string{"Cat | Dog | nil", "nil", "nil | nil"} {
+				te, err = ParseTypeExpr(expr)
+				So(err, ShouldBeNil)
+				_, ok = te.NonNil()
+				So(ok, ShouldBeFalse)
+			}
+		})
+
+		Convey("syntax errors", func() {
+			errors := map[string]string{
+				"":            "invalid type expression `` at column 1: unexpected end of expression",
+				"Cat |":       "invalid type expression `Cat |` at column 6: unexpected end of expression",
+				"(Cat | Dog":  "invalid type expression `(Cat | Dog` at column 11: expected `)` to close `(` at column 1",
+				"Cat)":        "invalid type expression `Cat)` at column 4: unexpected `)`",
+				"Cat[":        "invalid type expression `Cat[` at column 4: expected `[]`",
+				"Cat Dog":     "invalid type expression `Cat Dog` at column 5: unexpected `Dog`",
+				"| Cat":       "invalid type expression `| Cat` at column 1: unexpected `|`",
+				"string[ ]":   "invalid type expression `string[ ]` at column 7: expected `[]`",
+				"(Cat | Dog]": "invalid type expression `(Cat | Dog]` at column 11: unexpected `]`",
+				"?":           "invalid type expression `?` at column 1: unexpected `?`",
+			}
+			for expr, msg := range errors {
+				_, err := ParseTypeExpr(expr)
+				So(err, ShouldNotBeNil)
+				So(err.Error(), ShouldEqual, msg)
+			}
+		})
+
+		Convey("type checks", func() {
+			So(Type{Type: "(Cat | Dog)[]"}.IsArray(), ShouldBeTrue)
+			So(Type{Type: "(Cat | Dog)[]"}.IsUnion(), ShouldBeFalse)
+			So(Type{Type: "Cat[] | Dog"}.IsUnion(), ShouldBeTrue)
+			So(Type{Type: "Cat[] | Dog"}.IsArray(), ShouldBeFalse)
+			So(Type{Type: []interface{}{"Cat", "Dog"}}.IsArray(), ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,867 @@
+package raml
+
+// This file contains the type resolver,
+// which builds the resolved type graph of the types declared in a RAML document.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// TypeKind is the kind of a resolved type
+type TypeKind int
+
+const (
+	// KindScalar is the kind of string, number, integer, boolean, date and file types
+	KindScalar TypeKind = iota
+
+	// KindObject is the kind of object types
+	KindObject
+
+	// KindArray is the kind of array types
+	KindArray
+
+	// KindUnion is the kind of union types
+	KindUnion
+
+	// KindAny is the kind of `any` type and types defined by a JSON or XML schema
+	KindAny
+
+	// KindNil is the kind of `nil` type
+	KindNil
+)
+
+var typeKindNames = map[TypeKind]string{
+	KindScalar: "scalar",
+	KindObject: "object",
+	KindArray:  "array",
+	KindUnion:  "union",
+	KindAny:    "any",
+	KindNil:    "nil",
+}
+
+func (k TypeKind) String() string {
+	return typeKindNames[k]
+}
+
+// Facets are the facets of a resolved type,
+// a nil value means the facet is not set.
+type Facets struct {
+	// string
+	Pattern   *string
+	MinLength *int
+	MaxLength *int
+
+	// number
+	Minimum    *float64
+	Maximum    *float64
+	MultipleOf *float64
+	Format     *string
+
+	// file, its length is in MinLength and MaxLength
+	FileTypes []string
+
+	// array
+	MinItems    *int
+	MaxItems    *int
+	UniqueItems *bool
+
+	// object
+	MinProperties        *int
+	MaxProperties        *int
+	AdditionalProperties *bool
+}
+
+// ResolvedType is a RAML type after the resolution of its type expression,
+// its inheritance and its facets.
+type ResolvedType struct {
+	// Name of the type.
+	// It is the name of the declared type, qualified by the library name
+	// for a type declared in a library (e.g. `lib.City`),
+	// the built-in type name for a built-in type,
+	// or empty for an anonymous type (inline declaration or type expression).
+	Name string
+
+	// Kind of the type
+	Kind TypeKind
+
+	// Builtin is the built-in type this type is based on,
+	// e.g. `string` for a user defined type which inherits from string.
+	// It is `object`, `array`, `union`, `any` or `nil` for the non scalar types.
+	Builtin string
+
+	// Parents are the types this type directly inherits from.
+	// There are more than one parent in case of multiple inheritance.
+	Parents []*ResolvedType
+
+	// Properties declared by this type. Use AllProperties
+	// to get the inherited properties as well.
+	Properties map[string]*ResolvedProperty
+
+	// Items is the type of the array items
+	Items *ResolvedType
+
+	// Members are the types of an union
+	Members []*ResolvedType
+
+	// Facets of the type, including the inherited ones
+	Facets Facets
+
+	// Enum values, including the inherited ones
+	Enum []interface{}
+
+	// Discriminator property name, including the inherited one
+	Discriminator string
+
+	// DiscriminatorValue identifies this type in a discriminated hierarchy.
+	// Default to the type name.
+	DiscriminatorValue string
+
+	// FacetDeclarations are the user-defined facets declared by this type
+	// and its parents, a subtype can give them a value.
+	FacetDeclarations map[string]*ResolvedProperty
+
+	// FacetValues are the values of the user-defined facets, including the inherited ones
+	FacetValues map[string]interface{}
+
+	// Schema is the JSON or XML schema which defines this type
+	Schema string
+
+	// Decl is the declaration of this type,
+	// nil for built-in types and type expressions.
+	Decl *Type
+
+	// position of the declaration in the RAML source
+	Position
+}
+
+// ResolvedProperty is a property of a resolved type
+type ResolvedProperty struct {
+	Name     string
+	Required bool
+	Type     *ResolvedType
+
+	// annotations applied to this property
+	Annotations Annotations
+
+	decl interface{} // declaration of the property in the RAML source
+}
+
+// IsBuiltin returns true if this type is a built-in type
+func (rt *ResolvedType) IsBuiltin() bool {
+	return rt.Decl == nil && rt.Name != ""
+}
+
+// IsAnonymous returns true if this type is an inline declaration or a type expression
+func (rt *ResolvedType) IsAnonymous() bool {
+	return rt.Name == ""
+}
+
+// IsNullable returns true if null is a valid value of this type:
+// the nil and any types and the unions which have a nil member,
+// e.g. `string?` or `string | nil`.
+func (rt *ResolvedType) IsNullable() bool {
+	switch rt.Kind {
+	case KindNil, KindAny:
+		return true
+	case KindUnion:
+		for _, m := range rt.Members {
+			if m.IsNullable() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NonNil returns the type of the values of a nullable type which are not null,
+// e.g. `string` for `string?` or `string | nil`.
+// It returns false if the type isn't an union of a type and nil.
+func (rt *ResolvedType) NonNil() (*ResolvedType, bool) {
+	if rt.Kind != KindUnion {
+		return nil, false
+	}
+	var nonNil []*ResolvedType
+	for _, m := range rt.Members {
+		if m.Kind != KindNil {
+			nonNil = append(nonNil, m)
+		}
+	}
+	if len(nonNil) != 1 || len(nonNil) == len(rt.Members) {
+		return nil, false
+	}
+	return nonNil[0], true
+}
+
+// AllProperties returns the properties of this type including the inherited ones.
+// Properties declared by a type override the properties of its parents.
+func (rt *ResolvedType) AllProperties() map[string]*ResolvedProperty {
+	props := map[string]*ResolvedProperty{}
+	for _, parent := range rt.Parents {
+		for name, p := range parent.AllProperties() {
+			props[name] = p
+		}
+	}
+	for name, p := range rt.Properties {
+		props[name] = p
+	}
+	return props
+}
+
+// PropertyNames returns the sorted names of all properties of this type
+func (rt *ResolvedType) PropertyNames() []string {
+	var names []string
+	for name := range rt.AllProperties() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// InheritsFrom returns true if this type is or inherits
+// directly or indirectly from the named type
+func (rt *ResolvedType) InheritsFrom(name string) bool {
+	if rt.Name == name {
+		return true
+	}
+	for _, parent := range rt.Parents {
+		if parent.InheritsFrom(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// DiscriminatorRoot returns the type of the hierarchy of this type
+// which declares the discriminator, nil if there is no discriminator.
+func (rt *ResolvedType) DiscriminatorRoot() *ResolvedType {
+	if rt.Decl != nil && rt.Decl.Discriminator != "" {
+		return rt
+	}
+	for _, parent := range rt.Parents {
+		if root := parent.DiscriminatorRoot(); root != nil {
+			return root
+		}
+	}
+	return nil
+}
+
+// Subtypes returns the types which inherit directly or indirectly
+// from this type, sorted by name.
+func (rt *ResolvedType) Subtypes(types map[string]*ResolvedType) []*ResolvedType {
+	var names []string
+	for name, t := range types {
+		if t != rt && t.InheritsFrom(rt.Name) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	subtypes := make([]*ResolvedType, 0, len(names))
+	for _, name := range names {
+		subtypes = append(subtypes, types[name])
+	}
+	return subtypes
+}
+
+var (
+	// kinds of the built-in types
+	builtinKinds = map[string]TypeKind{
+		"string":        KindScalar,
+		"number":        KindScalar,
+		"integer":       KindScalar,
+		"boolean":       KindScalar,
+		"date-only":     KindScalar,
+		"time-only":     KindScalar,
+		"datetime-only": KindScalar,
+		"datetime":      KindScalar,
+		"date":          KindScalar,
+		"file":          KindScalar,
+		"object":        KindObject,
+		"array":         KindArray,
+		"any":           KindAny,
+		"nil":           KindNil,
+	}
+)
+
+// TypeResolver resolves the types of a RAML document.
+// Library types are resolved in the scope of their library
+// and named by their qualified name, e.g. `lib.City`.
+type TypeResolver struct {
+	root *typeScope
+
+	// scopes of the libraries by their prefix
+	scopes map[string]*typeScope
+
+	// resolved declared types by their qualified name
+	resolved map[string]*ResolvedType
+
+	// declared types whose base type is being resolved,
+	// used to detect inheritance cycles
+	resolving []string
+}
+
+// typeScope is the scope in which type names are looked up
+type typeScope struct {
+	prefix    string // qualifier of the type names, e.g. `lib.`
+	types     map[string]Type
+	libraries map[string]*Library
+
+	// errors of the types defined by a schema which can't be translated
+	schemaErrors map[string]error
+}
+
+// newTypeScope creates the scope of a document,
+// the types defined by a JSON or XML schema are translated into RAML types.
+func newTypeScope(prefix string, types map[string]Type, libraries map[string]*Library) *typeScope {
+	scope := &typeScope{
+		prefix:    prefix,
+		libraries: libraries,
+	}
+	scope.types, scope.schemaErrors = schemaTypes(types)
+	return scope
+}
+
+// NewTypeResolver creates a type resolver of a RAML document
+// which declares the given types and uses the given libraries.
+func NewTypeResolver(types map[string]Type, libraries map[string]*Library) *TypeResolver {
+	return &TypeResolver{
+		root:     newTypeScope("", types, libraries),
+		scopes:   map[string]*typeScope{},
+		resolved: map[string]*ResolvedType{},
+	}
+}
+
+// TypeResolver returns the type resolver of this API definition
+func (apiDef *APIDefinition) TypeResolver() *TypeResolver {
+	return NewTypeResolver(apiDef.Types, apiDef.Libraries)
+}
+
+// TypeResolver returns the type resolver of this library
+func (l *Library) TypeResolver() *TypeResolver {
+	return NewTypeResolver(l.Types, l.Libraries)
+}
+
+// ResolveAll resolves all types declared in the document.
+// It returns the resolved types by their name.
+func (tr *TypeResolver) ResolveAll() (map[string]*ResolvedType, error) {
+	errs := &Error{}
+	types := map[string]*ResolvedType{}
+	for name := range tr.root.types {
+		rt, err := tr.Resolve(name)
+		if err != nil {
+			addError(errs, err)
+			continue
+		}
+		types[name] = rt
+	}
+	errs.sort()
+	return types, errs.orNil()
+}
+
+// Resolve resolves a declared type by its name,
+// which is qualified by the library name for a library type.
+func (tr *TypeResolver) Resolve(name string) (*ResolvedType, error) {
+	return tr.resolveName(tr.root, name, Position{})
+}
+
+// ResolveExpr resolves a type expression, e.g. `Cat | Dog` or `string[]`
+func (tr *TypeResolver) ResolveExpr(expr string) (*ResolvedType, error) {
+	return tr.resolveExpr(tr.root, expr, Position{})
+}
+
+// ResolveDecl resolves an anonymous (inline) type declaration
+func (tr *TypeResolver) ResolveDecl(t Type) (*ResolvedType, error) {
+	return tr.resolveDecl(tr.root, "", t, t.Position)
+}
+
+// resolveName resolves a declared or built-in type by its name in a scope
+func (tr *TypeResolver) resolveName(scope *typeScope, name string, pos Position) (*ResolvedType, error) {
+	if kind, ok := builtinKinds[name]; ok {
+		return newBuiltinType(name, kind), nil
+	}
+
+	// library type
+	if splitted := strings.SplitN(name, ".", 2); len(splitted) == 2 {
+		lib, ok := scope.libraries[splitted[0]]
+		if !ok {
+			return nil, newError(pos, "unknown library %v of type %v", splitted[0], name)
+		}
+		prefix := scope.prefix + splitted[0] + "."
+		libScope, ok := tr.scopes[prefix]
+		if !ok {
+			libScope = newTypeScope(prefix, lib.Types, lib.Libraries)
+			tr.scopes[prefix] = libScope
+		}
+		return tr.resolveName(libScope, splitted[1], pos)
+	}
+
+	qualifiedName := scope.prefix + name
+	t, ok := scope.types[name]
+	if !ok {
+		return nil, newError(pos, "unknown type %v", qualifiedName)
+	}
+	if err := scope.schemaErrors[name]; err != nil {
+		return nil, newError(t.Position, "%v: %v", qualifiedName, err)
+	}
+
+	for i, resolving := range tr.resolving {
+		if resolving == qualifiedName {
+			cycle := append(append([]string{}, tr.resolving[i:]...), qualifiedName)
+			return nil, newError(t.Position, "cyclic type inheritance: %v", strings.Join(cycle, " -> "))
+		}
+	}
+
+	// the type could be referenced by a property while it is being resolved,
+	// in which case it is returned before being completely resolved.
+	if rt, ok := tr.resolved[qualifiedName]; ok {
+		return rt, nil
+	}
+
+	return tr.resolveDecl(scope, qualifiedName, t, t.Position)
+}
+
+// resolveDecl resolves a type declaration.
+// name is the qualified name of a declared type, empty for an inline declaration.
+func (tr *TypeResolver) resolveDecl(scope *typeScope, name string, t Type, pos Position) (*ResolvedType, error) {
+	if t.Line > 0 {
+		pos = t.Position
+	}
+	decl := t
+	rt := &ResolvedType{
+		Name:       name,
+		Decl:       &decl,
+		Properties: map[string]*ResolvedProperty{},
+		Position:   pos,
+	}
+
+	// register the type before resolving it,
+	// a type can have properties of its own type.
+	if name != "" {
+		tr.resolved[name] = rt
+	}
+	if err := tr.resolveDeclTypes(scope, name, rt, t, pos); err != nil {
+		if name != "" {
+			delete(tr.resolved, name)
+		}
+		return nil, err
+	}
+	return rt, nil
+}
+
+// resolveDeclTypes resolves the base types, items, facets and properties of a type declaration
+func (tr *TypeResolver) resolveDeclTypes(scope *typeScope, name string, rt *ResolvedType, t Type, pos Position) error {
+	// resolve the base types and items, an inheritance cycle
+	// can only happen while resolving them.
+	if name != "" {
+		tr.resolving = append(tr.resolving, name)
+	}
+	err := tr.resolveBase(scope, rt, t, pos)
+	if err == nil && t.Items != nil {
+		rt.Items, err = tr.resolveTypeValue(scope, t.Items, pos)
+	}
+	if name != "" {
+		tr.resolving = tr.resolving[:len(tr.resolving)-1]
+	}
+	if err != nil {
+		return err
+	}
+
+	rt.Facets = mergeFacets(rt.Facets, typeFacets(t))
+
+	if t.Enum != nil {
+		rt.Enum = enumValues(t.Enum)
+	}
+
+	if t.Discriminator != "" {
+		rt.Discriminator = t.Discriminator
+	}
+	rt.DiscriminatorValue = t.DiscriminatorValue
+	if rt.DiscriminatorValue == "" && name != "" {
+		rt.DiscriminatorValue = name[len(scope.prefix):]
+	}
+
+	// a property type is not inherited, it can refer to any type
+	resolving := tr.resolving
+	tr.resolving = nil
+	defer func() {
+		tr.resolving = resolving
+	}()
+
+	names := make([]string, 0, len(t.Properties))
+	for propName := range t.Properties {
+		names = append(names, propName)
+	}
+	sort.Strings(names)
+	for _, propName := range names {
+		prop, err := tr.resolveProperty(scope, propName, t.Properties[propName], pos)
+		if err != nil {
+			return err
+		}
+		rt.Properties[prop.Name] = prop
+	}
+
+	// user-defined facets
+	for name, val := range t.FacetValues {
+		if rt.FacetValues == nil {
+			rt.FacetValues = map[string]interface{}{}
+		}
+		rt.FacetValues[name] = val
+	}
+	for facetName, decl := range t.Facets {
+		facet, err := tr.resolveProperty(scope, facetName, decl, pos)
+		if err != nil {
+			return err
+		}
+		if rt.FacetDeclarations == nil {
+			rt.FacetDeclarations = map[string]*ResolvedProperty{}
+		}
+		rt.FacetDeclarations[facet.Name] = facet
+	}
+	return nil
+}
+
+// resolveBase resolves the base types of a type declaration
+// and initializes the type from them
+func (tr *TypeResolver) resolveBase(scope *typeScope, rt *ResolvedType, t Type, pos Position) error {
+	base := t.Type
+	if base == nil {
+		if schema, ok := t.Schema.(string); ok && schema != "" {
+			base = schema
+		}
+	}
+
+	var parents []*ResolvedType
+	switch b := base.(type) {
+	case nil: // default type
+		name := "string"
+		switch {
+		case len(t.Properties) > 0:
+			name = "object"
+		case t.Items != nil:
+			name = "array"
+		}
+		parents = append(parents, newBuiltinType(name, builtinKinds[name]))
+	case string:
+		parent, err := tr.resolveExpr(scope, b, pos)
+		if err != nil {
+			return err
+		}
+		parents = append(parents, parent)
+	case []interface{}: // multiple inheritance
+		for _, v := range b {
+			expr, ok := v.(string)
+			if !ok {
+				return newError(pos, "invalid parent type:%v", v)
+			}
+			parent, err := tr.resolveExpr(scope, expr, pos)
+			if err != nil {
+				return err
+			}
+			if len(b) > 1 && parent.Kind != KindObject {
+				return newError(pos, "%v: multiple inheritance of non object type %v", rt.Name, expr)
+			}
+			parents = append(parents, parent)
+		}
+	case map[interface{}]interface{}: // inline type declaration
+		parent, err := tr.resolveDecl(scope, "", toType(b), pos)
+		if err != nil {
+			return err
+		}
+		parents = append(parents, parent)
+	default:
+		return newError(pos, "invalid type:%v", base)
+	}
+
+	rt.Kind = parents[0].Kind
+	rt.Builtin = parents[0].Builtin
+	for _, parent := range parents {
+		// an anonymous or built-in base is merged into the type itself
+		if !parent.IsAnonymous() && !parent.IsBuiltin() {
+			rt.Parents = append(rt.Parents, parent)
+		}
+		rt.Items = parent.Items
+		rt.Members = parent.Members
+		rt.Schema = parent.Schema
+		rt.Facets = mergeFacets(rt.Facets, parent.Facets)
+		if parent.Enum != nil {
+			rt.Enum = parent.Enum
+		}
+		if parent.Discriminator != "" {
+			rt.Discriminator = parent.Discriminator
+		}
+		for name, facet := range parent.FacetDeclarations {
+			if rt.FacetDeclarations == nil {
+				rt.FacetDeclarations = map[string]*ResolvedProperty{}
+			}
+			rt.FacetDeclarations[name] = facet
+		}
+		for name, val := range parent.FacetValues {
+			if rt.FacetValues == nil {
+				rt.FacetValues = map[string]interface{}{}
+			}
+			rt.FacetValues[name] = val
+		}
+		if parent.IsAnonymous() {
+			for name, p := range parent.Properties {
+				rt.Properties[name] = p
+			}
+		}
+	}
+	return nil
+}
+
+// resolveTypeValue resolves the value of a `type` or `items` node,
+// which is either a type expression or an inline type declaration
+func (tr *TypeResolver) resolveTypeValue(scope *typeScope, val interface{}, pos Position) (*ResolvedType, error) {
+	switch v := val.(type) {
+	case string:
+		return tr.resolveExpr(scope, v, pos)
+	case map[interface{}]interface{}:
+		return tr.resolveDecl(scope, "", toType(v), pos)
+	default:
+		return nil, newError(pos, "invalid type:%v", val)
+	}
+}
+
+// resolveProperty resolves a property declaration
+func (tr *TypeResolver) resolveProperty(scope *typeScope, name string, val interface{}, pos Position) (*ResolvedProperty, error) {
+	prop := &ResolvedProperty{
+		Name:     name,
+		Required: true,
+		decl:     val,
+	}
+
+	var t Type
+	if m, ok := val.(map[interface{}]interface{}); ok {
+		t = toType(m)
+		if required, ok := m["required"].(bool); ok {
+			prop.Required = required
+		}
+		for k, v := range m {
+			if key, ok := k.(string); ok && isAnnotationKey(key) {
+				if prop.Annotations == nil {
+					prop.Annotations = Annotations{}
+				}
+				prop.Annotations[key] = v
+			}
+		}
+	} else {
+		t = toType(val)
+	}
+
+	// if has "?" suffix, remove the "?" and set required=false
+	if strings.HasSuffix(prop.Name, "?") {
+		prop.Required = false
+		prop.Name = prop.Name[:len(prop.Name)-1]
+	}
+
+	var err error
+	if _, isDecl := val.(map[interface{}]interface{}); isDecl {
+		prop.Type, err = tr.resolveDecl(scope, "", t, pos)
+		// a property which only references a type is that type
+		if err == nil && len(prop.Type.Parents) == 1 && isTypeReference(val) {
+			prop.Type = prop.Type.Parents[0]
+		}
+	} else {
+		prop.Type, err = tr.resolveTypeValue(scope, t.Type, pos)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return prop, nil
+}
+
+// resolveExpr resolves a type expression
+func (tr *TypeResolver) resolveExpr(scope *typeScope, expr string, pos Position) (*ResolvedType, error) {
+	// inline JSON or XML schema
+	if isSchemaExpr(expr) {
+		return &ResolvedType{
+			Kind:     KindAny,
+			Builtin:  "any",
+			Schema:   strings.TrimSpace(expr),
+			Position: pos,
+		}, nil
+	}
+
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		return nil, newError(pos, "%v", err)
+	}
+	return tr.resolveTypeExpr(scope, te, pos)
+}
+
+// resolveTypeExpr resolves a parsed type expression
+func (tr *TypeResolver) resolveTypeExpr(scope *typeScope, te *TypeExpr, pos Position) (*ResolvedType, error) {
+	switch te.Kind {
+	case TypeExprUnion:
+		rt := &ResolvedType{
+			Kind:     KindUnion,
+			Builtin:  "union",
+			Position: pos,
+		}
+		for _, member := range te.Members {
+			mt, err := tr.resolveTypeExpr(scope, member, pos)
+			if err != nil {
+				return nil, err
+			}
+			rt.Members = append(rt.Members, mt)
+		}
+		return rt, nil
+	case TypeExprArray:
+		items, err := tr.resolveTypeExpr(scope, te.Items, pos)
+		if err != nil {
+			return nil, err
+		}
+		return &ResolvedType{
+			Kind:     KindArray,
+			Builtin:  "array",
+			Items:    items,
+			Position: pos,
+		}, nil
+	}
+	return tr.resolveName(scope, te.Name, pos)
+}
+
+// isTypeReference returns true if a property declaration
+// only has `type` and the property facets.
+func isTypeReference(val interface{}) bool {
+	m, ok := val.(map[interface{}]interface{})
+	if !ok {
+		return true
+	}
+	if _, ok := m["type"].(string); !ok {
+		return false
+	}
+	for k := range m {
+		switch k {
+		case "type", "required", "description", "displayName", "example", "examples", "default":
+		default:
+			if key, ok := k.(string); !ok || !isAnnotationKey(key) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func newBuiltinType(name string, kind TypeKind) *ResolvedType {
+	rt := &ResolvedType{
+		Name:    name,
+		Kind:    kind,
+		Builtin: name,
+	}
+	if kind == KindArray {
+		rt.Items = newBuiltinType("any", KindAny)
+	}
+	return rt
+}
+
+// typeFacets returns the facets set in a type declaration
+func typeFacets(t Type) Facets {
+	return Facets{
+		Pattern:              t.Pattern,
+		MinLength:            t.MinLength,
+		MaxLength:            t.MaxLength,
+		Minimum:              t.Minimum,
+		Maximum:              t.Maximum,
+		MultipleOf:           t.MultipleOf,
+		Format:               t.Format,
+		FileTypes:            t.FileTypes,
+		MinItems:             t.MinItems,
+		MaxItems:             t.MaxItems,
+		UniqueItems:          t.UniqueItems,
+		MinProperties:        t.MinProperties,
+		MaxProperties:        t.MaxProperties,
+		AdditionalProperties: t.AdditionalProperties,
+	}
+}
+
+// mergeFacets returns the parent facets overridden by the child facets
+func mergeFacets(parent, child Facets) Facets {
+	f := parent
+	if child.Pattern != nil {
+		f.Pattern = child.Pattern
+	}
+	if child.MinLength != nil {
+		f.MinLength = child.MinLength
+	}
+	if child.MaxLength != nil {
+		f.MaxLength = child.MaxLength
+	}
+	if child.Minimum != nil {
+		f.Minimum = child.Minimum
+	}
+	if child.Maximum != nil {
+		f.Maximum = child.Maximum
+	}
+	if child.MultipleOf != nil {
+		f.MultipleOf = child.MultipleOf
+	}
+	if child.Format != nil {
+		f.Format = child.Format
+	}
+	if child.FileTypes != nil {
+		f.FileTypes = child.FileTypes
+	}
+	if child.MinItems != nil {
+		f.MinItems = child.MinItems
+	}
+	if child.MaxItems != nil {
+		f.MaxItems = child.MaxItems
+	}
+	if child.UniqueItems != nil {
+		f.UniqueItems = child.UniqueItems
+	}
+	if child.MinProperties != nil {
+		f.MinProperties = child.MinProperties
+	}
+	if child.MaxProperties != nil {
+		f.MaxProperties = child.MaxProperties
+	}
+	if child.AdditionalProperties != nil {
+		f.AdditionalProperties = child.AdditionalProperties
+	}
+	return f
+}
+
+// enumValues returns the values of an enum facet
+func enumValues(enum interface{}) []interface{} {
+	if values, ok := enum.([]interface{}); ok {
+		return values
+	}
+	return []interface{}{enum}
+}
+
+// addError adds the details of an error to errs
+func addError(errs *Error, err error) {
+	if ramlErr, ok := err.(*Error); ok {
+		errs.Errors = append(errs.Errors, ramlErr.Errors...)
+		return
+	}
+	errs.add(Position{}, "%v", err)
+}
+
+// String returns the type expression of a resolved type
+func (rt *ResolvedType) String() string {
+	switch {
+	case rt.Name != "":
+		return rt.Name
+	case rt.Kind == KindArray && rt.Items != nil:
+		items := rt.Items.String()
+		if rt.Items.Kind == KindUnion && rt.Items.IsAnonymous() {
+			items = "(" + items + ")"
+		}
+		return items + "[]"
+	case rt.Kind == KindUnion:
+		var members []string
+		for _, m := range rt.Members {
+			members = append(members, m.String())
+		}
+		return strings.Join(members, " | ")
+	case rt.Schema != "":
+		return "schema"
+	}
+	return fmt.Sprintf("anonymous %v", rt.Kind)
+}
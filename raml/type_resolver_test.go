@@ -0,0 +1,393 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestTypeResolver(t *testing.T) {
+	Convey("Type resolver", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/resolver.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		types, err := apiDef.TypeResolver().ResolveAll()
+		So(err, ShouldBeNil)
+		So(types, ShouldHaveLength, 7)
+
+		Convey("built-in and user types", func() {
+			name := types["Name"]
+			So(name.Kind, ShouldEqual, KindScalar)
+			So(name.Builtin, ShouldEqual, "string")
+			So(name.IsBuiltin(), ShouldBeFalse)
+			So(name.Parents, ShouldBeEmpty)
+
+			kind := types["Animal"].Properties["kind"].Type
+			So(kind.IsBuiltin(), ShouldBeTrue)
+			So(kind.Name, ShouldEqual, "string")
+		})
+
+		Convey("inherited facets", func() {
+			shortName := types["ShortName"]
+			So(shortName.Parents, ShouldHaveLength, 1)
+			So(shortName.Parents[0], ShouldEqual, types["Name"])
+			So(*shortName.Facets.MinLength, ShouldEqual, 2)
+			So(*shortName.Facets.MaxLength, ShouldEqual, 8)
+			So(*types["Name"].Facets.MaxLength, ShouldEqual, 32)
+		})
+
+		Convey("multiple inheritance", func() {
+			cat := types["Cat"]
+			So(cat.Kind, ShouldEqual, KindObject)
+			So(cat.Parents, ShouldHaveLength, 2)
+			So(cat.InheritsFrom("Animal"), ShouldBeTrue)
+			So(cat.InheritsFrom("Pet"), ShouldBeTrue)
+			So(cat.PropertyNames(), ShouldResemble, []string{"kind", "lives", "name", "owner", "vaccinated"})
+
+			props := cat.AllProperties()
+			So(props["name"].Type, ShouldEqual, types["ShortName"])
+			So(props["owner"].Required, ShouldBeFalse)
+			So(props["owner"].Type, ShouldEqual, types["Person"])
+			So(*props["lives"].Type.Facets.Maximum, ShouldEqual, 9)
+
+			So(cat.Discriminator, ShouldEqual, "kind")
+			So(cat.DiscriminatorValue, ShouldEqual, "Cat")
+		})
+
+		Convey("arrays and unions", func() {
+			person := types["Person"]
+			pets := person.Properties["pets"].Type
+			So(pets.Kind, ShouldEqual, KindArray)
+			So(pets.Items.Kind, ShouldEqual, KindUnion)
+			So(pets.Items.Members, ShouldHaveLength, 2)
+			So(pets.Items.Members[0], ShouldEqual, types["Cat"])
+			So(pets.String(), ShouldEqual, "(Cat | Animal)[]")
+
+			friends := person.Properties["friends"].Type
+			So(friends.Items, ShouldEqual, person)
+			So(*friends.Facets.UniqueItems, ShouldBeTrue)
+
+			grid := types["Grid"]
+			So(grid.Kind, ShouldEqual, KindArray)
+			So(grid.Items.Kind, ShouldEqual, KindArray)
+			So(grid.Items.Items.Name, ShouldEqual, "integer")
+			So(*grid.Facets.MinItems, ShouldEqual, 1)
+		})
+
+		Convey("library types", func() {
+			city := types["Person"].Properties["city"].Type
+			So(city.Name, ShouldEqual, "geo.City")
+			country := city.Properties["country"].Type
+			So(country.Name, ShouldEqual, "geo.Country")
+			So(country.Enum, ShouldResemble, []interface{}{"ID", "NL"})
+
+			rt, err := apiDef.TypeResolver().ResolveExpr("geo.City | nil")
+			So(err, ShouldBeNil)
+			So(rt.Kind, ShouldEqual, KindUnion)
+			So(rt.Members[1].Kind, ShouldEqual, KindNil)
+
+			_, err = apiDef.TypeResolver().ResolveExpr("geo.Town")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "unknown type geo.Town")
+		})
+	})
+
+	Convey("Type resolver user-defined facets", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/facets.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		types, err := apiDef.TypeResolver().ResolveAll()
+		So(err, ShouldBeNil)
+
+		date := types["FutureMeetingDate"]
+		So(date.Builtin, ShouldEqual, "date-only")
+		So(date.FacetDeclarations, ShouldContainKey, "noHolidays")
+		So(date.FacetDeclarations["noHolidays"].Required, ShouldBeTrue)
+		So(date.FacetDeclarations["onlyFutureDates"].Required, ShouldBeFalse)
+		So(date.FacetDeclarations["onlyFutureDates"].Type.Name, ShouldEqual, "boolean")
+		So(date.FacetValues, ShouldResemble, map[string]interface{}{
+			"noHolidays":      true,
+			"onlyFutureDates": true,
+		})
+		So(types["CustomDate"].FacetValues, ShouldBeEmpty)
+
+		price := types["Meeting"].Properties["price"].Type
+		So(price.Parents[0], ShouldEqual, types["Currency"])
+		So(price.FacetValues["codes"], ShouldResemble, []interface{}{"EUR", "USD"})
+	})
+
+	Convey("Type resolver nil and nullable types", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/nullable.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		types, err := apiDef.TypeResolver().ResolveAll()
+		So(err, ShouldBeNil)
+
+		comment := types["NilValue"].Properties["comment"].Type
+		So(comment.Kind, ShouldEqual, KindNil)
+		So(comment.IsNullable(), ShouldBeTrue)
+		_, ok := comment.NonNil()
+		So(ok, ShouldBeFalse)
+
+		props := types["Person"].Properties
+		So(props["name"].Type.IsNullable(), ShouldBeFalse)
+		So(props["data"].Type.IsNullable(), ShouldBeTrue)
+
+		nickname, ok := props["nickname"].Type.NonNil()
+		So(ok, ShouldBeTrue)
+		So(nickname.Name, ShouldEqual, "string")
+		So(props["nickname"].Required, ShouldBeTrue)
+
+		age, ok := props["age"].Type.NonNil()
+		So(ok, ShouldBeTrue)
+		So(age.Name, ShouldEqual, "integer")
+		So(*props["age"].Type.Facets.Minimum, ShouldEqual, 0)
+
+		// a declared nullable type
+		So(props["comment"].Type, ShouldEqual, types["Comment"])
+		text, ok := types["Comment"].NonNil()
+		So(ok, ShouldBeTrue)
+		So(text.Name, ShouldEqual, "string")
+		So(*types["Comment"].Facets.MaxLength, ShouldEqual, 140)
+
+		friends, ok := props["friends"].Type.NonNil()
+		So(ok, ShouldBeTrue)
+		So(friends.Kind, ShouldEqual, KindArray)
+		So(friends.Items, ShouldEqual, types["Person"])
+
+		parent, ok := props["parent"].Type.NonNil()
+		So(ok, ShouldBeTrue)
+		So(parent, ShouldEqual, types["Person"])
+		So(props["parent"].Required, ShouldBeFalse)
+	})
+
+	Convey("Type resolver built-in facets", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/builtin_facets.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		Convey("declarations", func() {
+			weight := apiDef.Types["Weight"]
+			So(*weight.Minimum, ShouldEqual, 0)
+			So(*weight.Maximum, ShouldEqual, 0.5)
+			So(*weight.MultipleOf, ShouldEqual, 0.1)
+			So(*weight.Format, ShouldEqual, "float")
+			So(weight.Default, ShouldEqual, 0)
+
+			email := apiDef.Types["EmailAddress"]
+			So(*email.MinLength, ShouldEqual, 0)
+			So(email.MinItems, ShouldBeNil)
+
+			picture := apiDef.Types["userPicture"]
+			So(picture.FileTypes, ShouldResemble, []string{"image/jpeg", "image/png"})
+			So(*picture.MaxLength, ShouldEqual, 307200)
+			So(picture.MinLength, ShouldBeNil)
+
+			person := apiDef.Types["Person"]
+			So(*person.AdditionalProperties, ShouldBeFalse)
+			So(apiDef.Types["Notes"].AdditionalProperties, ShouldBeNil)
+
+			name := toType(person.Properties["name"])
+			So(*name.XML.Attribute, ShouldBeTrue)
+			So(name.XML.Name, ShouldEqual, "fullname")
+			So(name.XML.Wrapped, ShouldBeNil)
+
+			addresses := toType(person.Properties["addresses"])
+			So(*addresses.XML.Wrapped, ShouldBeTrue)
+			So(addresses.XML.Namespace, ShouldEqual, "http://example.com/addresses")
+			So(addresses.XML.Prefix, ShouldEqual, "addr")
+		})
+
+		Convey("resolved facets", func() {
+			types, err := apiDef.TypeResolver().ResolveAll()
+			So(err, ShouldBeNil)
+
+			quantity := types["Quantity"].Facets
+			So(*quantity.Minimum, ShouldEqual, 0)
+			So(*quantity.Maximum, ShouldEqual, 0.25)
+			So(*quantity.MultipleOf, ShouldEqual, 0.1)
+
+			emails := types["Emails"].Facets
+			So(*emails.MinItems, ShouldEqual, 1)
+			So(*emails.MaxItems, ShouldEqual, 10)
+			So(*emails.UniqueItems, ShouldBeTrue)
+			So(*types["Emails"].Items.Facets.MinLength, ShouldEqual, 0)
+
+			custom := types["customFile"]
+			So(custom.Builtin, ShouldEqual, "file")
+			So(custom.Facets.FileTypes, ShouldResemble, []string{"*/*"})
+			So(*custom.Facets.MaxLength, ShouldEqual, 1048576)
+
+			So(*types["Updated"].Facets.Format, ShouldEqual, "rfc2616")
+
+			person := types["Person"].Facets
+			So(*person.MinProperties, ShouldEqual, 1)
+			So(*person.MaxProperties, ShouldEqual, 2)
+			So(*person.AdditionalProperties, ShouldBeFalse)
+			So(*types["Employee"].Facets.AdditionalProperties, ShouldBeTrue)
+			So(types["Notes"].PropertyNames(), ShouldResemble, []string{"/^note\\d+$/", "name"})
+		})
+	})
+
+	Convey("Type resolver JSON and XML schemas", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/schemas.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		types, err := apiDef.TypeResolver().ResolveAll()
+		So(err, ShouldBeNil)
+
+		Convey("JSON schema", func() {
+			person := types["Person"]
+			So(person.Kind, ShouldEqual, KindObject)
+			So(person.Decl.Description, ShouldEqual, "a person")
+			So(person.PropertyNames(), ShouldResemble, []string{"address", "age", "birthday", "email",
+				"manager", "name", "nickname", "phones", "status", "tags"})
+
+			props := person.Properties
+			So(props["name"].Required, ShouldBeTrue)
+			So(*props["name"].Type.Facets.MinLength, ShouldEqual, 1)
+			So(props["age"].Required, ShouldBeFalse)
+			So(props["age"].Type.Builtin, ShouldEqual, "integer")
+			So(*props["age"].Type.Facets.Maximum, ShouldEqual, 150)
+			So(*props["email"].Type.Facets.Pattern, ShouldEqual, "^.+@.+$")
+			So(props["birthday"].Type.Name, ShouldEqual, "date-only")
+			So(props["tags"].Type.Items.Name, ShouldEqual, "string")
+			So(*props["tags"].Type.Facets.UniqueItems, ShouldBeTrue)
+			So(props["status"].Type.Enum, ShouldResemble, []interface{}{"active", "inactive"})
+			So(props["nickname"].Type.Kind, ShouldEqual, KindUnion)
+			So(props["manager"].Type, ShouldEqual, person)
+
+			// $ref to a definition
+			So(props["phones"].Type.Items, ShouldEqual, types["PersonPhone"])
+			So(types["PersonPhone"].Properties["number"].Required, ShouldBeTrue)
+
+			// $ref to a sibling file, which has its own definitions
+			So(props["address"].Required, ShouldBeTrue)
+			So(props["address"].Type, ShouldEqual, types["PersonAddress"])
+			country := types["PersonAddress"].Properties["country"].Type
+			So(country, ShouldEqual, types["PersonCountry"])
+			So(*country.Facets.MinLength, ShouldEqual, 2)
+			So(*country.Facets.MaxLength, ShouldEqual, 2)
+		})
+
+		Convey("type declaration including a schema", func() {
+			employee := types["Employee"]
+			So(employee.Decl.Description, ShouldEqual, "an employee")
+			So(employee.Properties["address"].Type, ShouldEqual, types["EmployeeAddress"])
+			So(employee.Properties["manager"].Type, ShouldEqual, employee)
+			So(types["Team"].Properties["members"].Type.Items, ShouldEqual, types["Person"])
+		})
+
+		Convey("XML schema", func() {
+			order := types["Order"]
+			So(order.Kind, ShouldEqual, KindObject)
+			So(order.PropertyNames(), ShouldResemble, []string{"date", "id", "item", "note", "status"})
+			So(order.Properties["id"].Type.Name, ShouldEqual, "integer")
+			So(order.Properties["date"].Type.Name, ShouldEqual, "date-only")
+			So(order.Properties["note"].Required, ShouldBeFalse)
+			So(order.Properties["status"].Required, ShouldBeTrue)
+			So(order.Properties["status"].Type.Enum, ShouldResemble, []interface{}{"open", "closed"})
+
+			item := order.Properties["item"].Type
+			So(item.Kind, ShouldEqual, KindArray)
+			So(item.Items, ShouldEqual, types["OrderItemType"])
+			So(types["OrderItemType"].PropertyNames(), ShouldResemble, []string{"price", "quantity", "sku"})
+			So(types["OrderItemType"].Properties["price"].Type.Name, ShouldEqual, "number")
+		})
+
+		Convey("object schema with other types", func() {
+			tr := NewTypeResolver(map[string]Type{
+				"Nullable": {Type: `{"type": ["object", "null"], "properties": {
+					"a": {"type": ["object", "null"], "properties": {"b": {"type": "string"}}}}}`},
+			}, nil)
+			nullable, err := tr.Resolve("Nullable")
+			So(err, ShouldBeNil)
+			So(nullable.Kind, ShouldEqual, KindObject)
+
+			a := nullable.Properties["a"].Type
+			So(a.Kind, ShouldEqual, KindUnion)
+			nonNil, ok := a.NonNil()
+			So(ok, ShouldBeTrue)
+			So(nonNil.Kind, ShouldEqual, KindObject)
+			So(nonNil.PropertyNames(), ShouldResemble, []string{"b"})
+		})
+
+		Convey("invalid schema", func() {
+			tr := NewTypeResolver(map[string]Type{
+				"Broken": {Type: `{"type": "object", "properties": {"a": {"$ref": "#/definitions/missing"}}}`},
+			}, nil)
+			_, err := tr.Resolve("Broken")
+			So(err, ShouldNotBeNil)
+			So(err.Error(), ShouldContainSubstring, "Broken: ")
+			So(err.Error(), ShouldContainSubstring, "#/definitions/missing")
+		})
+	})
+
+	Convey("Type resolver cycle detection", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/types/cycle.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		_, err = apiDef.TypeResolver().Resolve("A")
+		So(err, ShouldNotBeNil)
+		So(err.Error(), ShouldContainSubstring, "cyclic type inheritance: A -> B -> C -> A")
+	})
+}
+
+func TestResolveParam(t *testing.T) {
+	Convey("Type resolver of the parameters", t, func() {
+		apiDef := new(APIDefinition)
+		err := ParseFile("./samples/validation/params.raml", apiDef)
+		So(err, ShouldBeNil)
+
+		tr := apiDef.TypeResolver()
+		cars := apiDef.Resources["/cars"]
+
+		Convey("user-defined type", func() {
+			rt, err := tr.ResolveParam(cars.Get.QueryParameters["color"])
+			So(err, ShouldBeNil)
+			So(rt.Kind, ShouldEqual, KindScalar)
+			So(rt.Parents[0].Name, ShouldEqual, "Color")
+			So(rt.Enum, ShouldResemble, []interface{}{"red", "green", "blue"})
+		})
+
+		Convey("inline enum", func() {
+			rt, err := tr.ResolveParam(cars.Get.QueryParameters["sort"])
+			So(err, ShouldBeNil)
+			So(rt.Builtin, ShouldEqual, "string")
+			So(rt.Enum, ShouldResemble, []interface{}{"price", "year"})
+		})
+
+		Convey("array", func() {
+			rt, err := tr.ResolveParam(cars.Get.QueryParameters["tags"])
+			So(err, ShouldBeNil)
+			So(rt.Kind, ShouldEqual, KindArray)
+			So(rt.Items.Name, ShouldEqual, "string")
+			So(*rt.Facets.UniqueItems, ShouldBeTrue)
+		})
+
+		Convey("RAML 0.8 repeat", func() {
+			repeat := true
+			rt, err := tr.ResolveParam(NamedParameter{Type: "integer", Repeat: &repeat})
+			So(err, ShouldBeNil)
+			So(rt.Kind, ShouldEqual, KindArray)
+			So(rt.Items.Name, ShouldEqual, "integer")
+		})
+
+		Convey("facets and query string", func() {
+			carID := cars.Nested["/{carId}"]
+			rt, err := tr.ResolveParam(carID.URIParameters["carId"])
+			So(err, ShouldBeNil)
+			So(rt.Builtin, ShouldEqual, "integer")
+			So(*rt.Facets.Minimum, ShouldEqual, 1)
+
+			qs, err := tr.ResolveDecl(*carID.Get.QueryString)
+			So(err, ShouldBeNil)
+			So(qs.PropertyNames(), ShouldResemble, []string{"limit", "offset"})
+		})
+	})
+}
@@ -34,9 +34,6 @@ package raml
 
 import "strings"
 
-// TODO: We don't support !include of non-text files. RAML supports including
-//       of many file types.
-
 // Any type, for our convenience
 type Any interface{}
 
@@ -53,6 +50,11 @@ type Header NamedParameter
 type Documentation struct {
 	Title   string `yaml:"title"`
 	Content string `yaml:"content"`
+
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
 
 // DefinitionParameters defines a map of parameter name at it's value.
@@ -121,22 +123,14 @@ type Property struct {
 	MinItems    *int
 	MaxItems    *int
 	UniqueItems bool
+
+	// annotations applied to this property
+	Annotations Annotations
 }
 
 // ToProperty creates a property from an interface
 // we use `interface{}` as property type to support syntactic sugar & shortcut
 func ToProperty(name string, p interface{}) Property {
-	// convert number(int/float) to float
-	toFloat64 := func(number interface{}) float64 {
-		switch v := number.(type) {
-		case int:
-			return float64(v)
-		case float64:
-			return v
-		default:
-			return v.(float64)
-		}
-	}
 	// convert from map of interface to property
 	mapToProperty := func(val map[interface{}]interface{}) Property {
 		var p Property
@@ -150,31 +144,30 @@ func ToProperty(name string, p interface{}) Property {
 			case "enum":
 				p.Enum = v
 			case "minLength":
-				p.MinLength = new(int)
-				*p.MinLength = v.(int)
+				p.MinLength = intFacet(v)
 			case "maxLength":
-				p.MaxLength = new(int)
-				*p.MaxLength = v.(int)
+				p.MaxLength = intFacet(v)
 			case "pattern":
-				p.Pattern = new(string)
-				*p.Pattern = v.(string)
+				p.Pattern = stringFacet(v)
 			case "minimum":
-				p.Minimum = new(float64)
-				*p.Minimum = toFloat64(v)
+				p.Minimum = numberFacet(v)
 			case "maximum":
-				p.Maximum = new(float64)
-				*p.Maximum = toFloat64(v)
+				p.Maximum = numberFacet(v)
 			case "multipleOf":
-				p.MultipleOf = new(float64)
-				*p.MultipleOf = toFloat64(v)
+				p.MultipleOf = numberFacet(v)
 			case "minItems":
-				p.MinItems = new(int)
-				*p.MinItems = v.(int)
+				p.MinItems = intFacet(v)
 			case "maxItems":
-				p.MaxItems = new(int)
-				*p.MaxItems = v.(int)
+				p.MaxItems = intFacet(v)
 			case "uniqueItems":
-				p.UniqueItems = v.(bool)
+				p.UniqueItems, _ = v.(bool)
+			default:
+				if key, ok := k.(string); ok && isAnnotationKey(key) {
+					if p.Annotations == nil {
+						p.Annotations = Annotations{}
+					}
+					p.Annotations[key] = v
+				}
 			}
 		}
 		return p
@@ -248,9 +241,18 @@ type Type struct {
 	// Its value is a string and MAY be formatted using markdown.
 	Description string `yaml:"description"`
 
-	// TODO : annotation names
+	// Annotations applied to this type.
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
 
-	// TODO : facets
+	// A map of additional, user-defined restrictions that will be inherited
+	// and applied by any extending subtype.
+	// The value of each facet is its type declaration.
+	// The name of an optional facet ends with a question mark.
+	Facets map[string]interface{} `yaml:"facets"`
+
+	// Values of the user-defined facets declared by the parent types.
+	// It must be declared after Annotations to not capture the annotations.
+	FacetValues map[string]interface{} `yaml:",regexp:.*"`
 
 	// The properties that instances of this type may or must have.
 	// we use `interface{}` as property type to support syntactic sugar & shortcut
@@ -259,14 +261,14 @@ type Type struct {
 	// -------- Below facets are available for object type --------------//
 
 	// The minimum number of properties allowed for instances of this type.
-	MinProperties int `yaml:"minProperties"`
+	MinProperties *int `yaml:"minProperties"`
 
 	// The maximum number of properties allowed for instances of this type.
-	MaxProperties int `yaml:"maxProperties"`
+	MaxProperties *int `yaml:"maxProperties"`
 
 	// A Boolean that indicates if an object instance has additional properties.
-	// TODO: Default : true
-	AdditionalProperties string `yaml:"additionalProperties"`
+	// An unset value means true.
+	AdditionalProperties *bool `yaml:"additionalProperties"`
 
 	// Determines the concrete type of an individual object at runtime when,
 	// for example, payloads contain ambiguous types due to unions or inheritance.
@@ -288,13 +290,13 @@ type Type struct {
 	Items interface{} `yaml:"items"`
 
 	// Minimum amount of items in array. Value MUST be equal to or greater than 0.
-	MinItems int `yaml:"minItems" validate:"min=0"`
+	MinItems *int `yaml:"minItems"`
 
 	// Maximum amount of items in array. Value MUST be equal to or greater than 0.
-	MaxItems int `yaml:"maxItems" validate:"min=0"`
+	MaxItems *int `yaml:"maxItems"`
 
 	// Boolean value that indicates if items in the array MUST be unique.
-	UniqueItems bool `yaml:"uniqueItems"`
+	UniqueItems *bool `yaml:"uniqueItems"`
 
 	// ---------- facets for scalar type --------------------------//
 	// Enumeration of possible values for this built-in scalar type.
@@ -304,38 +306,89 @@ type Type struct {
 
 	// ---------- facets for string type ------------------------//
 	// Regular expression that this string should match.
-	Pattern string `yaml:"pattern"`
+	Pattern *string `yaml:"pattern"`
 
-	// Minimum length of the string. Value MUST be equal to or greater than 0.
-	MinLength int `yaml:"minLength" validate:"min=0"`
+	// Minimum length of the string, or minimum size in bytes of the file.
+	// Value MUST be equal to or greater than 0.
+	MinLength *int `yaml:"minLength"`
 
-	// Maximum length of the string. Value MUST be equal to or greater than 0.
-	MaxLength int `yaml:"maxLength" validate:"max=0"`
+	// Maximum length of the string, or maximum size in bytes of the file.
+	// Value MUST be equal to or greater than 0.
+	MaxLength *int `yaml:"maxLength"`
 
 	// ----------- facets for Number -------------------------- //
 	// The minimum value of the parameter. Applicable only to parameters of type number or integer.
-	Minimum int `yaml:"minimum"`
+	Minimum *float64 `yaml:"minimum"`
 
 	// The maximum value of the parameter. Applicable only to parameters of type number or integer.
-	Maximum int `yaml:"maximum"`
+	Maximum *float64 `yaml:"maximum"`
 
 	// The format of the value. The value MUST be one of the following:
 	// int32, int64, int, long, float, double, int16, int8
-	Format string `yaml:"format"`
+	// The format of a datetime is rfc3339 or rfc2616.
+	Format *string `yaml:"format"`
 
 	// A numeric instance is valid against "multipleOf"
 	// if the result of dividing the instance by this keyword's value is an integer.
-	MultipleOf int `yaml:"multipleOf"`
+	MultipleOf *float64 `yaml:"multipleOf"`
 
 	// ---------- facets for file --------------------------------//
 	// A list of valid content-type strings for the file. The file type */* MUST be a valid value.
-	FileTypes string `yaml:"fileTypes"`
+	FileTypes []string `yaml:"fileTypes"`
+
+	// The serialization of the instances of this type in XML.
+	XML *XMLFacets `yaml:"xml"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
+
+	// positions of the examples in the RAML source
+	examplePositions examplePositions
+}
+
+// XMLFacets are the facets of the serialization of a type in XML
+type XMLFacets struct {
+	// true if the instance is serialized as an attribute instead of an element
+	Attribute *bool `yaml:"attribute"`
+
+	// true if the items of an array are wrapped in an element
+	Wrapped *bool `yaml:"wrapped"`
+
+	// The name of the element or attribute, the property name by default
+	Name string `yaml:"name"`
+
+	// The namespace of the element or attribute
+	Namespace string `yaml:"namespace"`
+
+	// The prefix of the namespace
+	Prefix string `yaml:"prefix"`
+}
+
+// UnmarshalYAML unmarshals a type declaration which is either a map
+// or a type expression, e.g. `Person: string` or an included JSON schema
+func (t *Type) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var expr string
+	if err := unmarshal(&expr); err == nil {
+		t.Type = expr
+		return nil
+	}
+
+	// the type without UnmarshalYAML method
+	type typeDecl Type
+	var decl typeDecl
+	if err := unmarshal(&decl); err != nil {
+		return err
+	}
+	*t = Type(decl)
+	t.examplePositions = unmarshalExamplePositions(unmarshal)
+	return nil
 }
 
 // IsArray checks if this type is an Array
 // see specs at http://docs.raml.org/specs/1.0/#raml-10-spec-array-types
 func (t Type) IsArray() bool {
-	return strings.HasSuffix(t.Type.(string), "[]")
+	te := t.typeExpr()
+	return te != nil && te.IsArray()
 }
 
 // IsEnum type check if this type is an enum
@@ -347,13 +400,42 @@ func (t Type) IsEnum() bool {
 // IsUnion checks if a type is Union type
 // see http://docs.raml.org/specs/1.0/#raml-10-spec-union-types
 func (t Type) IsUnion() bool {
-	return strings.Index(t.Type.(string), "|") > 0
+	te := t.typeExpr()
+	return te != nil && te.IsUnion()
+}
+
+// typeExpr returns the parsed type expression of this type,
+// nil if the type is not a valid type expression.
+func (t Type) typeExpr() *TypeExpr {
+	expr, ok := t.Type.(string)
+	if !ok || isSchemaExpr(expr) {
+		return nil
+	}
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		return nil
+	}
+	return te
 }
 
 // BodiesProperty defines a Body's property
+//
+// Deprecated: the bodies of every media type are Body,
+// use Bodies.ForMediaType.
 type BodiesProperty struct {
 	// we use `interface{}` as property type to support syntactic sugar & shortcut
 	Properties map[string]interface{} `yaml:"properties"`
 
 	Type string
+
+	// An example of the body
+	Example interface{} `yaml:"example"`
+
+	// Named examples of the body
+	Examples map[string]interface{} `yaml:"examples"`
+
+	Annotations Annotations `yaml:",regexp:^[(].*[)]$"`
+
+	// position of the node in the RAML source
+	Position `yaml:"-"`
 }
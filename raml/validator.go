@@ -0,0 +1,868 @@
+package raml
+
+// This file contains the semantic validator of RAML documents.
+// The parser only checks the shape of the document,
+// the validator checks the rules of the RAML specification
+// which need the whole document, e.g. references to the declared types.
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Severity is the severity of a validation issue
+type Severity int
+
+const (
+	// SeverityError is a violation of the RAML specification
+	SeverityError Severity = iota
+
+	// SeverityWarning is a valid but discouraged usage
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// ValidationIssue is a problem found by the validator
+type ValidationIssue struct {
+	Position
+
+	Severity Severity
+	Message  string
+}
+
+// String returns `file:line:col: severity: message` representation of the issue
+func (vi ValidationIssue) String() string {
+	if pos := vi.Position.String(); pos != "" {
+		return fmt.Sprintf("%v: %v: %v", pos, vi.Severity, vi.Message)
+	}
+	return fmt.Sprintf("%v: %v", vi.Severity, vi.Message)
+}
+
+// ValidationIssues is a list of validation issues
+type ValidationIssues []ValidationIssue
+
+// HasErrors returns true if there is at least one issue with error severity
+func (vis ValidationIssues) HasErrors() bool {
+	for _, vi := range vis {
+		if vi.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func (vis ValidationIssues) Len() int      { return len(vis) }
+func (vis ValidationIssues) Swap(i, j int) { vis[i], vis[j] = vis[j], vis[i] }
+func (vis ValidationIssues) Less(i, j int) bool {
+	a, b := vis[i], vis[j]
+	if a.File != b.File {
+		return a.File < b.File
+	}
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+	if a.Column != b.Column {
+		return a.Column < b.Column
+	}
+	return a.Message < b.Message
+}
+
+var (
+	// RAML built-in types
+	builtinTypes = map[string]bool{
+		"any":           true,
+		"object":        true,
+		"array":         true,
+		"string":        true,
+		"number":        true,
+		"integer":       true,
+		"boolean":       true,
+		"date-only":     true,
+		"time-only":     true,
+		"datetime-only": true,
+		"datetime":      true,
+		"date":          true,
+		"file":          true,
+		"nil":           true,
+	}
+
+	// facets and nodes of a type declaration defined by the specification,
+	// they can't be declared as user-defined facets
+	builtinFacets = map[string]bool{
+		"type":                 true,
+		"schema":               true,
+		"default":              true,
+		"example":              true,
+		"examples":             true,
+		"displayName":          true,
+		"description":          true,
+		"facets":               true,
+		"xml":                  true,
+		"required":             true,
+		"properties":           true,
+		"minProperties":        true,
+		"maxProperties":        true,
+		"additionalProperties": true,
+		"discriminator":        true,
+		"discriminatorValue":   true,
+		"items":                true,
+		"minItems":             true,
+		"maxItems":             true,
+		"uniqueItems":          true,
+		"enum":                 true,
+		"pattern":              true,
+		"minLength":            true,
+		"maxLength":            true,
+		"minimum":              true,
+		"maximum":              true,
+		"format":               true,
+		"multipleOf":           true,
+		"fileTypes":            true,
+	}
+
+	// values of the format facet of the number and datetime types
+	formats = map[string]bool{
+		"int":     true,
+		"int8":    true,
+		"int16":   true,
+		"int32":   true,
+		"int64":   true,
+		"long":    true,
+		"float":   true,
+		"double":  true,
+		"rfc3339": true,
+		"rfc2616": true,
+	}
+)
+
+// Validate validates an API definition and the libraries it uses
+// against the rules of the RAML specification.
+// It returns all issues found, sorted by position.
+func Validate(apiDef *APIDefinition) ValidationIssues {
+	issues := map[string]ValidationIssue{}
+
+	v := newValidator(apiDef.Types, apiDef.Libraries, issues)
+	for _, schemas := range apiDef.Schemas {
+		for name := range schemas {
+			v.schemas[name] = true
+		}
+	}
+	if len(apiDef.Schemas) > 0 {
+		v.warn(apiDef.Position, "schemas is deprecated, use types")
+	}
+
+	v.validateNamedParams(apiDef.BaseURIParameters, "baseUriParameters")
+	v.validateDeclarations(apiDef.Types, apiDef.Traits, apiDef.ResourceTypes, apiDef.SecuritySchemes)
+	for _, r := range apiDef.Resources {
+		v.validateResource(&r, "")
+	}
+
+	validateLibraries(apiDef.Libraries, issues, map[*Library]bool{})
+
+	result := make(ValidationIssues, 0, len(issues))
+	for _, vi := range issues {
+		result = append(result, vi)
+	}
+	sort.Sort(result)
+	return result
+}
+
+// validate all libraries used by a document
+func validateLibraries(libraries map[string]*Library, issues map[string]ValidationIssue, visited map[*Library]bool) {
+	for _, lib := range libraries {
+		if visited[lib] {
+			continue
+		}
+		visited[lib] = true
+
+		v := newValidator(lib.Types, lib.Libraries, issues)
+		v.validateDeclarations(lib.Types, lib.Traits, lib.ResourceTypes, lib.SecuritySchemes)
+
+		validateLibraries(lib.Libraries, issues, visited)
+	}
+}
+
+// validator validates a single RAML document
+type validator struct {
+	types     map[string]Type
+	libraries map[string]*Library
+	schemas   map[string]bool
+
+	// issues keyed by their string representation,
+	// to not report twice the same issue of a library used many times
+	issues map[string]ValidationIssue
+
+	resolver *TypeResolver
+}
+
+func newValidator(types map[string]Type, libraries map[string]*Library, issues map[string]ValidationIssue) *validator {
+	return &validator{
+		resolver:  NewTypeResolver(types, libraries),
+		types:     types,
+		libraries: libraries,
+		schemas:   map[string]bool{},
+		issues:    issues,
+	}
+}
+
+func (v *validator) add(pos Position, severity Severity, format string, args ...interface{}) {
+	vi := ValidationIssue{
+		Position: pos,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	}
+	v.issues[vi.String()] = vi
+}
+
+func (v *validator) error(pos Position, format string, args ...interface{}) {
+	v.add(pos, SeverityError, format, args...)
+}
+
+func (v *validator) warn(pos Position, format string, args ...interface{}) {
+	v.add(pos, SeverityWarning, format, args...)
+}
+
+// validate declarations shared by API definition and library
+func (v *validator) validateDeclarations(types map[string]Type, traits map[string]Trait,
+	resourceTypes map[string]ResourceType, schemes map[string]SecurityScheme) {
+
+	for name, t := range types {
+		v.validateType(t, "types."+name, t.Position)
+		v.validateRequiredFacets(t, "types."+name, t.Position)
+	}
+	v.validateDiscriminators(types)
+	for name, t := range traits {
+		loc := "traits." + name
+		v.validateNamedParams(t.QueryParameters, loc+".queryParameters")
+		v.validateHeaders(t.Headers, loc+".headers")
+		v.validateBodies(t.Bodies, loc+".body", t.Position)
+		v.validateResponses(t.Responses, loc, t.Position)
+	}
+	for name, rt := range resourceTypes {
+		loc := "resourceTypes." + name
+		v.validateNamedParams(rt.URIParameters, loc+".uriParameters")
+		for _, m := range rt.methods {
+			v.validateMethod(m, loc, rt.Position)
+		}
+	}
+	for name, ss := range schemes {
+		loc := "securitySchemes." + name + ".describedBy"
+		v.validateNamedParams(ss.DescribedBy.QueryParameters, loc+".queryParameters")
+		v.validateHeaders(ss.DescribedBy.Headers, loc+".headers")
+		v.validateResponses(ss.DescribedBy.Responses, loc, ss.Position)
+	}
+}
+
+func (v *validator) validateResource(r *Resource, location string) {
+	location = location + r.URI
+	v.validateNamedParams(r.URIParameters, location+".uriParameters")
+	for _, m := range r.Methods {
+		v.validateMethod(m, location, r.Position)
+	}
+	for _, n := range r.Nested {
+		v.validateResource(n, location)
+	}
+}
+
+func (v *validator) validateMethod(m *Method, location string, pos Position) {
+	if m == nil {
+		return
+	}
+	location = location + "." + strings.ToLower(m.Name)
+	pos = orPosition(m.Position, pos)
+	v.validateNamedParams(m.QueryParameters, location+".queryParameters")
+	v.validateQueryString(m, location, pos)
+	v.validateHeaders(m.Headers, location+".headers")
+	v.validateBodies(m.Bodies, location+".body", pos)
+	v.validateResponses(m.Responses, location, pos)
+}
+
+// validateQueryString checks that the query string of a method
+// is an object type and that the method has no query parameters
+func (v *validator) validateQueryString(m *Method, location string, pos Position) {
+	if m.QueryString == nil {
+		return
+	}
+	location += ".queryString"
+	pos = orPosition(m.QueryString.Position, pos)
+	if len(m.QueryParameters) > 0 {
+		v.error(pos, "%v: queryString and queryParameters are mutually exclusive", location)
+	}
+	v.validateType(*m.QueryString, location, pos)
+
+	rt, err := v.resolver.ResolveDecl(*m.QueryString)
+	if err != nil {
+		return
+	}
+	if rt.Kind != KindObject && rt.Kind != KindUnion {
+		name := rt.String()
+		if rt.IsAnonymous() {
+			name = rt.Builtin
+		}
+		v.error(pos, "%v: the query string must be an object type, not %v", location, name)
+	}
+}
+
+// pos is the position of the parent node, used when the responses
+// are inherited from a trait or a resource type
+func (v *validator) validateResponses(responses map[HTTPCode]Response, location string, pos Position) {
+	for code, resp := range responses {
+		loc := fmt.Sprintf("%v.responses.%v", location, code)
+		v.validateHeaders(resp.Headers, loc+".headers")
+		v.validateBodies(resp.Bodies, loc+".body", orPosition(resp.Position, pos))
+	}
+}
+
+func (v *validator) validateBodies(b Bodies, location string, pos Position) {
+	pos = orPosition(b.Position, pos)
+	if b.Type != "" && v.checkTypeNames(b.Type, location, pos) {
+		v.validateBodyExample(b.Example, b.Type, location+".example", b.examplePositions.position("", pos))
+	}
+	v.validateNamedParams(b.FormParameters, location+".formParameters")
+	for mt, body := range b.ForMIMEType {
+		loc := location + "." + mt
+		v.validateNamedParams(body.FormParameters, loc+".formParameters")
+		v.validateHeaders(body.Headers, loc+".headers")
+		if !body.HasType() {
+			continue
+		}
+		t := Type{
+			Properties: body.Properties,
+		}
+		if body.Type != "" {
+			t.Type = body.Type
+		}
+		// the examples of the other media types, e.g. XML documents,
+		// can't be checked against the type
+		if IsJSONMediaType(mt) {
+			t.Example, t.Examples = body.Example, body.Examples
+			t.examplePositions = body.examplePositions
+		}
+		v.validateType(t, loc, orPosition(body.Position, pos))
+	}
+}
+
+func (v *validator) validateNamedParams(params map[string]NamedParameter, location string) {
+	for name, np := range params {
+		v.validateNamedParam(np, location+"."+name)
+	}
+}
+
+func (v *validator) validateHeaders(headers map[HTTPHeader]Header, location string) {
+	for name, h := range headers {
+		v.validateNamedParam(NamedParameter(h), location+"."+string(name))
+	}
+}
+
+func (v *validator) validateNamedParam(np NamedParameter, location string) {
+	if np.Type != "" && !v.checkTypeNames(np.Type, location, np.Position) {
+		return
+	}
+	v.validateParamExample(np, location)
+}
+
+// validateType validates a type declaration and its properties.
+// pos is the position of the closest node which has position,
+// it is used for inline declarations.
+func (v *validator) validateType(t Type, location string, pos Position) {
+	pos = orPosition(t.Position, pos)
+
+	known := true
+	switch typ := t.Type.(type) {
+	case string:
+		known = v.checkTypeNames(typ, location, pos)
+	case []interface{}: // multiple inheritance
+		for _, parent := range typ {
+			if name, ok := parent.(string); ok {
+				known = v.checkTypeNames(name, location, pos) && known
+			}
+		}
+	case map[interface{}]interface{}: // inline type declaration
+		v.validateType(toType(typ), location, pos)
+	}
+
+	if schema, ok := t.Schema.(string); ok && schema != "" {
+		v.warn(pos, "%v: schema is deprecated, use type", location)
+		v.checkTypeNames(schema, location, pos)
+	}
+
+	switch items := t.Items.(type) {
+	case string:
+		v.checkTypeNames(items, location+".items", pos)
+	case map[interface{}]interface{}:
+		v.validateType(toType(items), location+".items", pos)
+	}
+
+	if t.Example != nil && len(t.Examples) > 0 {
+		v.error(pos, "%v: example and examples can't be defined together", location)
+	}
+
+	if t.Enum != nil && known {
+		v.validateEnum(t, location, pos)
+	}
+
+	v.validateBuiltinFacets(t, location, pos)
+	if known {
+		v.validateFacets(t, location, pos)
+		v.validateExamples(t, location, pos)
+	}
+
+	for name, p := range t.Properties {
+		v.validateType(toType(p), location+"."+strings.TrimSuffix(name, "?"), pos)
+	}
+}
+
+// validateEnum checks that the enum values are valid values of the type
+func (v *validator) validateEnum(t Type, location string, pos Position) {
+	values, ok := t.Enum.([]interface{})
+	if !ok {
+		values = []interface{}{t.Enum}
+	}
+	if len(values) == 0 {
+		v.error(pos, "%v: enum must have at least one value", location)
+		return
+	}
+
+	rt, err := v.resolver.ResolveDecl(t)
+	if err != nil {
+		// unknown types are reported by checkTypeNames
+		return
+	}
+
+	seen := map[string]bool{}
+	for _, val := range values {
+		key := fmt.Sprintf("%v", val)
+		if seen[key] {
+			v.warn(pos, "%v: duplicate enum value %v", location, val)
+		}
+		seen[key] = true
+
+		for _, m := range checkInstance(val, rt, "") {
+			if m.Path != "" {
+				m.Message = strings.TrimPrefix(m.Path, ".") + ": " + m.Message
+			}
+			v.error(pos, "%v: invalid enum value: %v", location, m.Message)
+		}
+	}
+}
+
+// validateExamples checks that the example and the named examples
+// of a type declaration are valid instances of the type
+func (v *validator) validateExamples(t Type, location string, pos Position) {
+	if t.Example == nil && len(t.Examples) == 0 {
+		return
+	}
+	rt, err := v.resolver.ResolveDecl(t)
+	if err != nil {
+		return
+	}
+	if t.Example != nil {
+		v.checkExample(t.Example, rt, location+".example", t.examplePositions.position("", pos))
+	}
+	for name, example := range t.Examples {
+		v.checkExample(example, rt, location+".examples."+name, t.examplePositions.position(name, pos))
+	}
+}
+
+// validateParamExample checks that the example of a named parameter
+// is a valid value of the parameter
+func (v *validator) validateParamExample(np NamedParameter, location string) {
+	if np.Example == nil || strings.Contains(np.Type, "<<") {
+		return
+	}
+	rt, err := v.resolver.ResolveParam(np)
+	if err != nil {
+		return
+	}
+	v.checkExample(np.Example, rt, location+".example", np.examplePositions.position("", np.Position))
+}
+
+// validateBodyExample checks the example of a body of type typ
+func (v *validator) validateBodyExample(example interface{}, typ, location string, pos Position) {
+	if example == nil || strings.Contains(typ, "<<") || isSchemaExpr(typ) {
+		return
+	}
+	if rt, err := v.resolver.ResolveExpr(typ); err == nil {
+		v.checkExample(example, rt, location, pos)
+	}
+}
+
+// checkExample checks an example against its resolved type,
+// an example declared with `strict: false` is not checked.
+func (v *validator) checkExample(example interface{}, rt *ResolvedType, location string, pos Position) {
+	val, strict := unwrapExample(example)
+	if !strict || hasParams(val) {
+		return
+	}
+	val, err := decodeExample(val, rt)
+	if err != nil {
+		v.error(pos, "%v: invalid example: %v", location, err)
+		return
+	}
+	for _, m := range checkInstance(val, rt, "") {
+		v.error(pos, "%v%v: %v", location, m.Path, m.Message)
+	}
+}
+
+// hasParams returns true if an example of a resource type
+// or a trait declaration has parameters, e.g. `<<item>>`.
+// The example is checked once the declaration is applied.
+func hasParams(example interface{}) bool {
+	switch e := example.(type) {
+	case string:
+		return strings.Contains(e, "<<")
+	case []interface{}:
+		for _, item := range e {
+			if hasParams(item) {
+				return true
+			}
+		}
+	case map[interface{}]interface{}:
+		for k, item := range e {
+			if hasParams(k) || hasParams(item) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validateDiscriminators checks the discriminator declarations of the types
+// and that the discriminator values are unique in a hierarchy
+func (v *validator) validateDiscriminators(types map[string]Type) {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	// discriminator values by the type which declares the discriminator
+	values := map[*ResolvedType]map[string]string{}
+
+	for _, name := range names {
+		t := types[name]
+		location := "types." + name
+		rt, err := v.resolver.Resolve(name)
+		if err != nil || (rt.DiscriminatorRoot() == nil && t.DiscriminatorValue == "") {
+			continue
+		}
+
+		if t.Discriminator != "" {
+			prop, ok := rt.AllProperties()[t.Discriminator]
+			switch {
+			case rt.Kind != KindObject:
+				v.error(t.Position, "%v: discriminator can only be declared by an object type", location)
+			case !ok:
+				v.error(t.Position, "%v: discriminator property %v is not declared", location, t.Discriminator)
+			case prop.Type.Kind != KindScalar:
+				v.error(t.Position, "%v: discriminator property %v must be a scalar", location, t.Discriminator)
+			}
+		}
+
+		root := rt.DiscriminatorRoot()
+		if root == nil {
+			v.error(t.Position, "%v: discriminatorValue requires a discriminator", location)
+			continue
+		}
+		if values[root] == nil {
+			values[root] = map[string]string{}
+		}
+		if other, ok := values[root][rt.DiscriminatorValue]; ok {
+			v.error(t.Position, "%v: duplicate discriminator value %v, it is already the value of %v",
+				location, rt.DiscriminatorValue, other)
+			continue
+		}
+		values[root][rt.DiscriminatorValue] = name
+	}
+}
+
+// validateFacets checks the user-defined facets declared by a type
+// and the values it gives to the facets declared by its parents
+func (v *validator) validateFacets(t Type, location string, pos Position) {
+	if len(t.Facets) == 0 && len(t.FacetValues) == 0 {
+		return
+	}
+	inherited, ok := v.inheritedFacets(t)
+	if !ok {
+		return
+	}
+
+	for name := range t.Facets {
+		name = strings.TrimSuffix(name, "?")
+		if builtinFacets[name] {
+			v.error(pos, "%v: facet %v conflicts with a built-in facet", location, name)
+		} else if _, ok := inherited[name]; ok {
+			v.error(pos, "%v: facet %v is already declared by a parent type", location, name)
+		}
+	}
+
+	for name, val := range t.FacetValues {
+		facet, ok := inherited[name]
+		if !ok {
+			v.error(pos, "%v: unknown facet %v", location, name)
+			continue
+		}
+		for _, m := range checkInstance(val, facet.Type, "") {
+			v.error(pos, "%v: invalid value of facet %v%v: %v", location, name, m.Path, m.Message)
+		}
+	}
+}
+
+// validateBuiltinFacets checks the values of the built-in facets of a type:
+// the lengths and the numbers of items or properties can't be negative
+// and a minimum can't be greater than its maximum.
+func (v *validator) validateBuiltinFacets(t Type, location string, pos Position) {
+	var bounds = []struct {
+		min, max         *int
+		minName, maxName string
+	}{
+		{t.MinLength, t.MaxLength, "minLength", "maxLength"},
+		{t.MinItems, t.MaxItems, "minItems", "maxItems"},
+		{t.MinProperties, t.MaxProperties, "minProperties", "maxProperties"},
+	}
+	for _, b := range bounds {
+		if b.min != nil && *b.min < 0 {
+			v.error(pos, "%v: %v must be equal to or greater than 0", location, b.minName)
+		}
+		if b.max != nil && *b.max < 0 {
+			v.error(pos, "%v: %v must be equal to or greater than 0", location, b.maxName)
+		}
+		if b.min != nil && b.max != nil && *b.min > *b.max {
+			v.error(pos, "%v: %v is greater than %v", location, b.minName, b.maxName)
+		}
+	}
+	if t.Minimum != nil && t.Maximum != nil && *t.Minimum > *t.Maximum {
+		v.error(pos, "%v: minimum is greater than maximum", location)
+	}
+	if t.MultipleOf != nil && *t.MultipleOf <= 0 {
+		v.error(pos, "%v: multipleOf must be greater than 0", location)
+	}
+	if t.Format != nil && !formats[*t.Format] {
+		v.error(pos, "%v: unknown format %v", location, *t.Format)
+	}
+}
+
+// validateRequiredFacets checks that a type gives a value to
+// all required facets declared by its parents
+func (v *validator) validateRequiredFacets(t Type, location string, pos Position) {
+	inherited, ok := v.inheritedFacets(t)
+	if !ok || len(inherited) == 0 {
+		return
+	}
+	rt, err := v.resolver.ResolveDecl(t)
+	if err != nil {
+		return
+	}
+	var missing []string
+	for name, facet := range inherited {
+		if builtinFacets[name] { // already reported by the declaring type
+			continue
+		}
+		if _, ok := rt.FacetValues[name]; facet.Required && !ok {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		v.error(orPosition(t.Position, pos), "%v: missing value of required facet %v", location, name)
+	}
+}
+
+// inheritedFacets returns the facets declared by the parents of a type.
+// It returns false if the type can't be resolved.
+func (v *validator) inheritedFacets(t Type) (map[string]*ResolvedProperty, bool) {
+	rt, err := v.resolver.ResolveDecl(t)
+	if err != nil {
+		return nil, false
+	}
+	inherited := map[string]*ResolvedProperty{}
+	for name, facet := range rt.FacetDeclarations {
+		inherited[name] = facet
+	}
+	for name := range t.Facets {
+		delete(inherited, strings.TrimSuffix(name, "?"))
+	}
+	for _, parent := range rt.Parents {
+		for name, facet := range parent.FacetDeclarations {
+			inherited[name] = facet
+		}
+	}
+	return inherited, true
+}
+
+// checkTypeNames checks that all type names in a type expression
+// are declared. It returns false if there is an unknown type.
+func (v *validator) checkTypeNames(expr, location string, pos Position) bool {
+	if isSchemaExpr(expr) || strings.Contains(expr, "<<") {
+		// inline JSON or XML schema, or a resource type or trait parameter
+		return true
+	}
+
+	te, err := ParseTypeExpr(expr)
+	if err != nil {
+		v.error(pos, "%v: %v", location, err)
+		return false
+	}
+
+	known := true
+	for _, name := range te.Names() {
+		if !v.checkTypeName(name.Name, location, pos) {
+			known = false
+		}
+	}
+	return known
+}
+
+// checkTypeName checks that a type name is declared
+func (v *validator) checkTypeName(name, location string, pos Position) bool {
+	if builtinTypes[name] || strings.Contains(name, "<<") {
+		return true
+	}
+	if _, ok := v.types[name]; ok {
+		return true
+	}
+	if v.schemas[name] {
+		return true
+	}
+
+	if !strings.Contains(name, ".") {
+		v.error(pos, "%v: unknown type %v", location, name)
+		return false
+	}
+
+	if err := findLibraryDecl(declType, name, "", v.libraries); err != nil {
+		v.error(pos, "%v: %v", location, err)
+		return false
+	}
+	return true
+}
+
+// orPosition returns pos if it is a known position, otherwise the fallback
+func orPosition(pos, fallback Position) Position {
+	if pos.Line > 0 {
+		return pos
+	}
+	return fallback
+}
+
+// stringFacet returns the value of a string facet, nil if it isn't set
+func stringFacet(val interface{}) *string {
+	if s, ok := val.(string); ok {
+		return &s
+	}
+	return nil
+}
+
+// intFacet returns the value of an integer facet, nil if it isn't set
+func intFacet(val interface{}) *int {
+	if i, ok := val.(int); ok {
+		return &i
+	}
+	return nil
+}
+
+// numberFacet returns the value of a number facet, nil if it isn't set
+func numberFacet(val interface{}) *float64 {
+	if f, ok := toFloat64(val); ok {
+		return &f
+	}
+	return nil
+}
+
+// boolFacet returns the value of a boolean facet, nil if it isn't set
+func boolFacet(val interface{}) *bool {
+	if b, ok := val.(bool); ok {
+		return &b
+	}
+	return nil
+}
+
+// toType creates a type from an inline type declaration or
+// a property declaration.
+func toType(decl interface{}) Type {
+	var t Type
+	switch d := decl.(type) {
+	case string:
+		t.Type = d
+	case map[interface{}]interface{}:
+		t.Type = d["type"]
+		t.Schema = d["schema"]
+		t.Items = d["items"]
+		t.Example = d["example"]
+		t.Enum = d["enum"]
+		t.Description, _ = d["description"].(string)
+		t.Discriminator, _ = d["discriminator"].(string)
+		t.DiscriminatorValue, _ = d["discriminatorValue"].(string)
+		t.Default = d["default"]
+		t.Pattern = stringFacet(d["pattern"])
+		t.Format = stringFacet(d["format"])
+		t.MinLength = intFacet(d["minLength"])
+		t.MaxLength = intFacet(d["maxLength"])
+		t.Minimum = numberFacet(d["minimum"])
+		t.Maximum = numberFacet(d["maximum"])
+		t.MultipleOf = numberFacet(d["multipleOf"])
+		t.MinItems = intFacet(d["minItems"])
+		t.MaxItems = intFacet(d["maxItems"])
+		t.UniqueItems = boolFacet(d["uniqueItems"])
+		t.MinProperties = intFacet(d["minProperties"])
+		t.MaxProperties = intFacet(d["maxProperties"])
+		t.AdditionalProperties = boolFacet(d["additionalProperties"])
+		if xml, ok := d["xml"].(map[interface{}]interface{}); ok {
+			t.XML = &XMLFacets{
+				Attribute: boolFacet(xml["attribute"]),
+				Wrapped:   boolFacet(xml["wrapped"]),
+			}
+			t.XML.Name, _ = xml["name"].(string)
+			t.XML.Namespace, _ = xml["namespace"].(string)
+			t.XML.Prefix, _ = xml["prefix"].(string)
+		}
+		if fileTypes, ok := d["fileTypes"].([]interface{}); ok {
+			for _, ft := range fileTypes {
+				t.FileTypes = append(t.FileTypes, fmt.Sprintf("%v", ft))
+			}
+		}
+		if props, ok := d["properties"].(map[interface{}]interface{}); ok {
+			t.Properties = map[string]interface{}{}
+			for k, v := range props {
+				t.Properties[fmt.Sprintf("%v", k)] = v
+			}
+		}
+		if examples, ok := d["examples"].(map[interface{}]interface{}); ok {
+			t.Examples = map[string]interface{}{}
+			for k, v := range examples {
+				t.Examples[fmt.Sprintf("%v", k)] = v
+			}
+		}
+		if facets, ok := d["facets"].(map[interface{}]interface{}); ok {
+			t.Facets = map[string]interface{}{}
+			for k, v := range facets {
+				t.Facets[fmt.Sprintf("%v", k)] = v
+			}
+		}
+		for k, v := range d {
+			key := fmt.Sprintf("%v", k)
+			if builtinFacets[key] || isAnnotationKey(key) {
+				continue
+			}
+			if t.FacetValues == nil {
+				t.FacetValues = map[string]interface{}{}
+			}
+			t.FacetValues[key] = v
+		}
+		if t.Type == nil && t.Items != nil {
+			t.Type = "array"
+		} else if t.Type == nil && t.Properties == nil && t.Schema == nil {
+			t.Type = "string" // default type of a property
+		}
+	case nil:
+		t.Type = "string"
+	}
+	return t
+}
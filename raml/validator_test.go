@@ -0,0 +1,172 @@
+package raml
+
+import (
+	"testing"
+
+	. "github.com/smartystreets/goconvey/convey"
+)
+
+func TestValidator(t *testing.T) {
+	Convey("Validator", t, func() {
+		Convey("valid API", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/valid.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			issues := Validate(apiDef)
+			So(issues, ShouldBeEmpty)
+			So(issues.HasErrors(), ShouldBeFalse)
+		})
+
+		Convey("invalid API", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/api.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			issues := Validate(apiDef)
+			So(issues.HasErrors(), ShouldBeTrue)
+
+			var messages []string
+			for _, vi := range issues {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/api.raml:8:5: error: types.User.address: unknown type Address",
+				"./samples/validation/api.raml:8:5: error: types.User.country: library lib has no type Country",
+				"./samples/validation/api.raml:8:5: error: types.User.tags: unknown type Tag",
+				"./samples/validation/api.raml:15:5: warning: types.Color: duplicate enum value red",
+				"./samples/validation/api.raml:18:5: error: types.Age: invalid enum value: three is not a valid integer",
+				"./samples/validation/api.raml:21:5: error: types.Size: example and examples can't be defined together",
+				"./samples/validation/api.raml:26:5: error: types.Pet: unknown library unknown of type unknown.Pet",
+				"./samples/validation/api.raml:34:5: error: types.Member: invalid enum value: age: old is not a valid integer",
+				"./samples/validation/api.raml:42:9: error: /users.get.queryParameters.sort: unknown type Order",
+				"samples/validation/bad_lib.raml:4:5: error: types.Street.zip: unknown type Zip",
+				"samples/validation/bad_lib.raml:8:5: error: types.Streets: invalid type expression `(Street | string[]` at column 19: expected `)` to close `(` at column 1",
+			})
+		})
+
+		Convey("user-defined facets", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/facets.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/facets.raml:5:5: error: types.CustomDate: facet pattern conflicts with a built-in facet",
+				"./samples/validation/facets.raml:11:5: error: types.PossibleMeetingDate: invalid value of facet noHolidays: maybe is not a valid boolean",
+				"./samples/validation/facets.raml:14:5: error: types.FutureMeetingDate: missing value of required facet noHolidays",
+				"./samples/validation/facets.raml:17:5: error: types.HolidayDate: facet noHolidays is already declared by a parent type",
+				"./samples/validation/facets.raml:17:5: error: types.HolidayDate: unknown facet noWeekends",
+				"./samples/validation/facets.raml:23:5: error: types.Meeting.date: invalid value of facet onlyFutureDates: 1 is not a valid boolean",
+			})
+		})
+
+		Convey("built-in facets", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/builtin_facets.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/builtin_facets.raml:5:5: error: types.Name: minLength is greater than maxLength",
+				"./samples/validation/builtin_facets.raml:9:5: error: types.Tags: minItems must be equal to or greater than 0",
+				"./samples/validation/builtin_facets.raml:12:5: error: types.Ratio: minimum is greater than maximum",
+				"./samples/validation/builtin_facets.raml:12:5: error: types.Ratio: multipleOf must be greater than 0",
+				"./samples/validation/builtin_facets.raml:17:5: error: types.Size: unknown format int128",
+				"./samples/validation/builtin_facets.raml:26:9: error: types.Person.examples.notes.note2: 2 is not a valid string",
+				"./samples/validation/builtin_facets.raml:30:9: error: types.Person.examples.unknown: unknown property age",
+			})
+		})
+
+		Convey("nullable examples", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/nullable.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/nullable.raml:33:9: error: types.Person.examples.invalid.age: -1 is less than minimum 0",
+				`./samples/validation/nullable.raml:33:9: error: types.Person.examples.invalid.comment: "a long comment" is longer than 10 characters`,
+				"./samples/validation/nullable.raml:33:9: error: types.Person.examples.invalid.friends[0].none: nope is not nil",
+				"./samples/validation/nullable.raml:33:9: error: types.Person.examples.invalid.name: <nil> is not a valid string",
+				"./samples/validation/nullable.raml:33:9: error: types.Person.examples.invalid.nickname: 1 is not a valid string",
+			})
+		})
+
+		Convey("examples", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/examples.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				`./samples/validation/examples.raml:24:7: error: types.Person.example.addresses[1].zip: "abc" doesn't match pattern ^[0-9]{5}$`,
+				"./samples/validation/examples.raml:24:7: error: types.Person.example.addresses[1]: missing required property city",
+				"./samples/validation/examples.raml:24:7: error: types.Person.example.age: 200 is greater than maximum 150",
+				"./samples/validation/examples.raml:24:7: error: types.Person.example.birthday: 2017-13-01 is not a valid date-only",
+				"./samples/validation/examples.raml:24:7: error: types.Person.example.gender: unknown is not one of [male female]",
+				`./samples/validation/examples.raml:24:7: error: types.Person.example.name: "J" is shorter than 2 characters`,
+				"./samples/validation/examples.raml:39:9: error: types.Team.examples.empty.members: must have at least 1 items",
+				"./samples/validation/examples.raml:55:13: error: types.Id.examples.bool: true doesn't match any member of integer | string",
+				"./samples/validation/examples.raml:62:18: error: /persons.get.queryParameters.limit.example: 0 is less than minimum 1",
+				"./samples/validation/examples.raml:66:18: error: /persons.get.headers.X-Version.example: 2 is not a valid string",
+				"./samples/validation/examples.raml:72:22: error: /persons.get.responses.200.body.application/json.example[0].age: thirty is not a valid integer",
+			})
+		})
+
+		Convey("discriminators", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/discriminator.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/discriminator.raml:11:5: error: types.Tiger: duplicate discriminator value Cat, it is already the value of Cat",
+				"./samples/validation/discriminator.raml:17:5: error: types.Wolf: duplicate discriminator value dog, it is already the value of Dog",
+				"./samples/validation/discriminator.raml:20:5: error: types.Shape: discriminator property name is not declared",
+				"./samples/validation/discriminator.raml:24:5: error: types.Point: discriminator property coordinates must be a scalar",
+				"./samples/validation/discriminator.raml:28:5: error: types.Color: discriminator can only be declared by an object type",
+				"./samples/validation/discriminator.raml:31:5: error: types.Size: discriminatorValue requires a discriminator",
+			})
+		})
+
+		Convey("typed parameters", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/validation/params.raml", apiDef)
+			So(err, ShouldBeNil)
+
+			var messages []string
+			for _, vi := range Validate(apiDef) {
+				messages = append(messages, vi.String())
+			}
+			So(messages, ShouldResemble, []string{
+				"./samples/validation/params.raml:15:18: error: /cars.get.queryParameters.color.example: pink is not one of [red green blue]",
+				"./samples/validation/params.raml:26:18: error: /cars.get.headers.X-Version.example: abc is not a valid integer",
+				"./samples/validation/params.raml:29:7: error: /cars.post.queryString: the query string must be an object type, not string",
+				"./samples/validation/params.raml:38:20: error: /cars/{carId}.delete.queryString: queryString and queryParameters are mutually exclusive",
+			})
+		})
+
+		Convey("valid user-defined facets", func() {
+			apiDef := new(APIDefinition)
+			err := ParseFile("./samples/types/facets.raml", apiDef)
+			So(err, ShouldBeNil)
+			So(Validate(apiDef), ShouldBeEmpty)
+		})
+	})
+}
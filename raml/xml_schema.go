@@ -0,0 +1,388 @@
+package raml
+
+// This file contains the translation of XML schemas (XSD) into RAML types.
+// The supported subset is the global elements, complex types made of
+// sequence, all, choice, attributes and extensions, and simple types
+// restricting a built-in type.
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	xsdNamespace = "http://www.w3.org/2001/XMLSchema"
+)
+
+var (
+	// RAML types of the XSD built-in types
+	xsdBuiltinTypes = map[string]string{
+		"string":             "string",
+		"normalizedString":   "string",
+		"token":              "string",
+		"anyURI":             "string",
+		"ID":                 "string",
+		"IDREF":              "string",
+		"Name":               "string",
+		"NCName":             "string",
+		"QName":              "string",
+		"language":           "string",
+		"base64Binary":       "string",
+		"hexBinary":          "string",
+		"duration":           "string",
+		"int":                "integer",
+		"integer":            "integer",
+		"long":               "integer",
+		"short":              "integer",
+		"byte":               "integer",
+		"unsignedInt":        "integer",
+		"unsignedLong":       "integer",
+		"unsignedShort":      "integer",
+		"unsignedByte":       "integer",
+		"positiveInteger":    "integer",
+		"negativeInteger":    "integer",
+		"nonPositiveInteger": "integer",
+		"nonNegativeInteger": "integer",
+		"decimal":            "number",
+		"float":              "number",
+		"double":             "number",
+		"boolean":            "boolean",
+		"date":               "date-only",
+		"time":               "time-only",
+		"dateTime":           "datetime",
+		"anyType":            "any",
+		"anySimpleType":      "any",
+	}
+
+	// RAML facets of the XSD facets which have a value
+	xsdFacets = map[string]string{
+		"pattern":      "pattern",
+		"minLength":    "minLength",
+		"maxLength":    "maxLength",
+		"minInclusive": "minimum",
+		"maxInclusive": "maximum",
+	}
+)
+
+// xsdNode is a node of an XML schema
+type xsdNode struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Nodes   []*xsdNode `xml:",any"`
+}
+
+// attr returns the value of an attribute of the node
+func (n *xsdNode) attr(name string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// children returns the child nodes with the given local name
+func (n *xsdNode) children(name string) []*xsdNode {
+	var nodes []*xsdNode
+	for _, c := range n.Nodes {
+		if c.XMLName.Local == name {
+			nodes = append(nodes, c)
+		}
+	}
+	return nodes
+}
+
+// child returns the first child node with the given local name
+func (n *xsdNode) child(name string) *xsdNode {
+	if nodes := n.children(name); len(nodes) > 0 {
+		return nodes[0]
+	}
+	return nil
+}
+
+// xmlSchemaConverter translates an XML schema into RAML types
+type xmlSchemaConverter struct {
+	namer *schemaTypeNamer
+
+	// global declarations of the schema by their name
+	elements     map[string]*xsdNode
+	complexTypes map[string]*xsdNode
+	simpleTypes  map[string]*xsdNode
+
+	// prefixes of the XSD namespace, e.g. `xs`
+	xsdPrefixes map[string]bool
+
+	// RAML type names of the named XSD types
+	names map[string]string
+
+	// translated types by their name
+	types map[string]Type
+}
+
+// xmlSchemaToTypes translates the first global element of an XML schema
+// into the RAML type `name` and the named types of the schema it uses.
+func xmlSchemaToTypes(name, schema string, namer *schemaTypeNamer) (map[string]Type, error) {
+	var root xsdNode
+	if err := xml.Unmarshal([]byte(schema), &root); err != nil {
+		return nil, fmt.Errorf("invalid XML schema: %v", err)
+	}
+	if root.XMLName.Local != "schema" || root.XMLName.Space != xsdNamespace {
+		return nil, fmt.Errorf("invalid XML schema: root element must be %v schema", xsdNamespace)
+	}
+
+	c := &xmlSchemaConverter{
+		namer:        namer,
+		elements:     map[string]*xsdNode{},
+		complexTypes: map[string]*xsdNode{},
+		simpleTypes:  map[string]*xsdNode{},
+		xsdPrefixes:  map[string]bool{},
+		names:        map[string]string{},
+		types:        map[string]Type{},
+	}
+	for _, a := range root.Attrs {
+		if a.Value == xsdNamespace && a.Name.Space == "xmlns" {
+			c.xsdPrefixes[a.Name.Local] = true
+		}
+	}
+	var rootElem *xsdNode
+	for _, n := range root.Nodes {
+		switch n.XMLName.Local {
+		case "element":
+			c.elements[n.attr("name")] = n
+			if rootElem == nil {
+				rootElem = n
+			}
+		case "complexType":
+			c.complexTypes[n.attr("name")] = n
+		case "simpleType":
+			c.simpleTypes[n.attr("name")] = n
+		}
+	}
+	if rootElem == nil {
+		return nil, fmt.Errorf("invalid XML schema: no global element")
+	}
+
+	// the named type of the root element is the type itself
+	var t Type
+	var err error
+	if typeName := rootElem.attr("type"); typeName != "" && c.complexTypes[localName(typeName)] != nil {
+		c.names[localName(typeName)] = name
+		t, err = c.complexType(c.complexTypes[localName(typeName)], "")
+	} else {
+		var decl interface{}
+		if decl, err = c.elementDecl(rootElem, ""); err == nil {
+			t = toType(decl)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.types[name] = t
+	return c.types, nil
+}
+
+// complexType translates a complex type into an object type
+func (c *xmlSchemaConverter) complexType(node *xsdNode, hint string) (Type, error) {
+	t := Type{
+		Type:       "object",
+		Properties: map[string]interface{}{},
+	}
+	content := node
+	if cc := node.child("complexContent"); cc != nil {
+		ext := cc.child("extension")
+		if ext == nil {
+			return Type{}, fmt.Errorf("unsupported complex content of %v", node.attr("name"))
+		}
+		parent, err := c.typeRef(ext.attr("base"))
+		if err != nil {
+			return Type{}, err
+		}
+		t.Type = parent
+		content = ext
+	}
+	if err := c.addParticles(&t, content, hint, false); err != nil {
+		return Type{}, err
+	}
+	return t, nil
+}
+
+// addParticles adds the elements and attributes of a complex type to an object type.
+// The elements of a choice are optional.
+func (c *xmlSchemaConverter) addParticles(t *Type, node *xsdNode, hint string, optional bool) error {
+	for _, n := range node.Nodes {
+		switch n.XMLName.Local {
+		case "sequence", "all":
+			if err := c.addParticles(t, n, hint, optional || n.attr("minOccurs") == "0"); err != nil {
+				return err
+			}
+		case "choice":
+			if err := c.addParticles(t, n, hint, true); err != nil {
+				return err
+			}
+		case "element":
+			elem := n
+			if ref := n.attr("ref"); ref != "" {
+				if elem = c.elements[localName(ref)]; elem == nil {
+					return fmt.Errorf("unknown element %v", ref)
+				}
+			}
+			name := elem.attr("name")
+			decl, err := c.elementDecl(elem, hint+" "+name)
+			if err != nil {
+				return fmt.Errorf("element %v: %v", name, err)
+			}
+			if max := n.attr("maxOccurs"); max == "unbounded" || (max != "" && max != "0" && max != "1") {
+				decl = c.arrayDecl(decl, hint+" "+name)
+			}
+			c.addProperty(t, name, decl, optional || n.attr("minOccurs") == "0")
+		case "attribute":
+			name := n.attr("name")
+			decl, err := c.elementDecl(n, hint+" "+name)
+			if err != nil {
+				return fmt.Errorf("attribute %v: %v", name, err)
+			}
+			c.addProperty(t, name, decl, n.attr("use") != "required")
+		}
+	}
+	return nil
+}
+
+// addProperty adds a property to an object type
+func (c *xmlSchemaConverter) addProperty(t *Type, name string, decl interface{}, optional bool) {
+	if optional {
+		name += "?"
+	}
+	t.Properties[name] = decl
+}
+
+// arrayDecl returns the declaration of an array of the given items
+func (c *xmlSchemaConverter) arrayDecl(items interface{}, hint string) interface{} {
+	expr, ok := items.(string)
+	if !ok { // items with facets are a named type
+		expr = c.namer.name(hint)
+		c.types[expr] = toType(items)
+	}
+	return expr + "[]"
+}
+
+// elementDecl translates the type of an element or an attribute
+// into a type expression or an inline type declaration
+func (c *xmlSchemaConverter) elementDecl(node *xsdNode, hint string) (interface{}, error) {
+	if typeName := node.attr("type"); typeName != "" {
+		return c.typeRef(typeName)
+	}
+	if ct := node.child("complexType"); ct != nil {
+		name := c.namer.name(hint)
+		t, err := c.complexType(ct, hint)
+		if err != nil {
+			return nil, err
+		}
+		c.types[name] = t
+		return name, nil
+	}
+	if st := node.child("simpleType"); st != nil {
+		return c.simpleTypeDecl(st)
+	}
+	return "string", nil
+}
+
+// typeRef returns the type expression of a reference to a built-in or a named type
+func (c *xmlSchemaConverter) typeRef(qname string) (string, error) {
+	local := localName(qname)
+	prefix := ""
+	if i := strings.Index(qname, ":"); i >= 0 {
+		prefix = qname[:i]
+	}
+	if c.xsdPrefixes[prefix] || (c.complexTypes[local] == nil && c.simpleTypes[local] == nil) {
+		if t, ok := xsdBuiltinTypes[local]; ok {
+			return t, nil
+		}
+		return "", fmt.Errorf("unknown type %v", qname)
+	}
+
+	if name, ok := c.names[local]; ok {
+		return name, nil
+	}
+	name := c.namer.name(local)
+	c.names[local] = name // registered before the translation, the type can reference itself
+
+	var t Type
+	if node := c.complexTypes[local]; node != nil {
+		var err error
+		if t, err = c.complexType(node, local); err != nil {
+			return "", err
+		}
+	} else {
+		decl, err := c.simpleTypeDecl(c.simpleTypes[local])
+		if err != nil {
+			return "", err
+		}
+		t = toType(decl)
+	}
+	c.types[name] = t
+	return name, nil
+}
+
+// simpleTypeDecl translates a simple type into a type expression or an inline type declaration
+func (c *xmlSchemaConverter) simpleTypeDecl(node *xsdNode) (interface{}, error) {
+	restriction := node.child("restriction")
+	if restriction == nil { // list or union
+		return "string", nil
+	}
+	base, err := c.typeRef(restriction.attr("base"))
+	if err != nil {
+		return nil, err
+	}
+
+	decl := map[interface{}]interface{}{}
+	var enum []interface{}
+	for _, n := range restriction.Nodes {
+		val := n.attr("value")
+		switch facet := n.XMLName.Local; facet {
+		case "enumeration":
+			enum = append(enum, xsdValue(val, base))
+		case "length":
+			decl["minLength"] = xsdValue(val, "integer")
+			decl["maxLength"] = xsdValue(val, "integer")
+		case "pattern":
+			decl["pattern"] = val
+		default:
+			if name, ok := xsdFacets[facet]; ok {
+				decl[name] = xsdValue(val, "number")
+			}
+		}
+	}
+	if enum != nil {
+		decl["enum"] = enum
+	}
+	if len(decl) == 0 {
+		return base, nil
+	}
+	decl["type"] = base
+	return decl, nil
+}
+
+// xsdValue converts the value of an XSD facet into a value of the given type
+func xsdValue(val, typ string) interface{} {
+	switch typ {
+	case "integer", "number":
+		if i, err := strconv.Atoi(val); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(val, 64); err == nil {
+			return f
+		}
+	case "boolean":
+		if b, err := strconv.ParseBool(val); err == nil {
+			return b
+		}
+	}
+	return val
+}
+
+// localName returns the local part of a qualified name, e.g. `string` of `xs:string`
+func localName(qname string) string {
+	return qname[strings.Index(qname, ":")+1:]
+}